@@ -11,6 +11,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/jumptown-skydiving/manifest-server/internal/fixtures"
 	"github.com/jumptown-skydiving/manifest-server/pkg/core"
 	"github.com/jumptown-skydiving/manifest-server/pkg/server"
 	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
@@ -21,43 +22,144 @@ import (
 func newWebServer(app *core.Controller) (*server.WebServer, error) {
 	settings := app.Settings()
 
-	httpAddress := settings.WebServerAddress()
-	httpsAddress := settings.WebServerSecureAddress()
-	grpcAddress := settings.WebServerGRPCAddress()
+	httpAddresses := settings.WebServerAddresses()
+	httpsAddresses := settings.WebServerSecureAddresses()
+	grpcAddresses := settings.WebServerGRPCAddresses()
 	certFile := settings.ServerCertFile()
 	keyFile := settings.ServerKeyFile()
-	webServer, err := server.NewWebServer(app, httpAddress, httpsAddress,
-		grpcAddress, certFile, keyFile)
+	webServer, err := server.NewWebServer(app, httpAddresses, httpsAddresses,
+		grpcAddresses, certFile, keyFile)
 	if err != nil {
 		return nil, err
 	}
 
-	webServer.SetContentFunc("/settings.html", settings.HTML)
-	webServer.SetContentFunc("/setconfig", settings.FormHandler)
+	for _, zone := range []string{server.AdminZone, server.DisplayZone, server.PublicZone} {
+		allowed, err := settings.ZoneAllowedNetworks(zone)
+		if err != nil {
+			return nil, err
+		}
+		webServer.ConfigureZone(zone, settings.ZoneAddresses(zone), allowed)
+	}
+
+	webServer.RegisterProducer("settings", server.AdminZone, settings.HTML)
+	webServer.RegisterProducer("setconfig", server.AdminZone, settings.FormHandler)
+	webServer.RegisterProducer("setconfig_batch", server.AdminZone, settings.BatchHandler)
+	webServer.RegisterProducer("siwa", server.PublicZone, app.AppleEventHandler)
+	webServer.RegisterProducer("health", server.PublicZone, app.HealthHandler)
+	webServer.RegisterProducer("version", server.PublicZone, app.VersionHandler)
+	webServer.RegisterProducer("metrics", server.AdminZone, app.MetricsHandler)
+	webServer.RegisterProducer("deliveries", server.AdminZone, app.DeliveriesHandler)
+	webServer.RegisterProducer("public_status", server.PublicZone, app.ServeWhenWarm(app.PublicStatusHandler))
+	webServer.RegisterProducer("loads_csv", server.AdminZone, app.BurbleSource().CSVHandler)
+	webServer.RegisterProducer("map", server.DisplayZone, app.ServeWhenWarm(app.MapHandler))
+	routes := map[string]string{
+		"/settings.html":      "settings",
+		"/setconfig":          "setconfig",
+		"/settings/batch":     "setconfig_batch",
+		"/siwa":               "siwa",
+		"/health.json":        "health",
+		"/version":            "version",
+		"/metrics":            "metrics",
+		"/deliveries":         "deliveries",
+		"/loads.csv":          "loads_csv",
+		"/public/status.json": "public_status",
+		"/api/v1/map.json":    "map",
+	}
+
+	if app.METARSource() != nil {
+		webServer.RegisterProducer("metar_history", server.AdminZone, app.METARHistoryHandler)
+		routes["/metar/history.txt"] = "metar_history"
+		webServer.RegisterProducer("wind_history", server.DisplayZone, app.WindHistoryHandler)
+		routes["/winds/history.json"] = "wind_history"
+	}
+
+	if winds := app.WindsAloftSource(); winds != nil {
+		webServer.RegisterProducer("winds_raw", server.DisplayZone, winds.RawHandler)
+		routes["/winds/raw"] = "winds_raw"
+		webServer.RegisterProducer("winds_text", server.DisplayZone, winds.TextHandler)
+		routes["/winds"] = "winds_text"
+		webServer.RegisterProducer("winds_at", server.DisplayZone, winds.AtHandler)
+		routes["/winds/at"] = "winds_at"
+	}
 
 	if jumprun := app.Jumprun(); jumprun != nil {
-		webServer.SetContentFunc("/jumprun.html", jumprun.HTML)
-		webServer.SetContentFunc("/setjumprun", jumprun.FormHandler)
+		webServer.RegisterProducer("jumprun", server.AdminZone, jumprun.HTML)
+		webServer.RegisterProducer("setjumprun", server.AdminZone, jumprun.FormHandler)
+		routes["/jumprun.html"] = "jumprun"
+		webServer.RegisterProducer("jumprun_json", server.DisplayZone, jumprun.JSONHandler)
+		routes["/setjumprun"] = "setjumprun"
+		routes["/jumprun.json"] = "jumprun_json"
+		webServer.RegisterProducer("jumprun_history", server.AdminZone, jumprun.HistoryHandler)
+		webServer.RegisterProducer("restore_jumprun", server.AdminZone, jumprun.RestoreHandler)
+		routes["/jumprun/history.json"] = "jumprun_history"
+		routes["/jumprun/restore"] = "restore_jumprun"
+		webServer.RegisterProducer("suggest_jumprun", server.AdminZone, jumprun.SuggestionHandler)
+		routes["/jumprun/suggest.json"] = "suggest_jumprun"
+		webServer.RegisterProducer("jumprun_api", server.AdminZone, jumprun.APIHandler)
+		routes["/api/jumprun"] = "jumprun_api"
 	}
 
-	webServer.SetContentFunc("/siwa", app.AppleEventHandler)
+	if settings.ExitOrderEnabled() {
+		webServer.RegisterProducer("exit_order", server.AdminZone, app.ServeWhenWarm(app.ExitOrderHandler))
+		routes["/exitorder"] = "exit_order"
+	}
+
+	for path, name := range routes {
+		if err = webServer.SetRoute(path, name); err != nil {
+			return nil, err
+		}
+	}
+	for _, route := range settings.Routes() {
+		if route.Disabled {
+			webServer.DisableRoute(route.Path)
+		} else if err = webServer.SetRoute(route.Path, route.Content); err != nil {
+			return nil, err
+		}
+	}
 
 	return webServer, nil
 }
 
-func newSettings(configFilename string) (*settings.Settings, error) {
+func newSettings(configFilename string, demo bool) (*settings.Settings, error) {
+	if demo && configFilename == "" {
+		return settings.NewDemoSettings(), nil
+	}
+
+	var (
+		s   *settings.Settings
+		err error
+	)
 	if configFilename != "" {
-		return settings.NewSettingsWithFilename(configFilename)
+		s, err = settings.NewSettingsWithFilename(configFilename)
+	} else {
+		s, err = settings.NewSettings()
 	}
-	return settings.NewSettings()
+	if err == nil && demo {
+		s.EnableDemoMode()
+	}
+	return s, err
+}
+
+// offlineTransport refuses all requests. It is used in demo mode to
+// guarantee that no outbound network requests are made.
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("network access is disabled in demo mode: %s", req.URL)
 }
 
 func main() {
-	var configFilename string
+	var (
+		configFilename string
+		demo           bool
+		useFixtures    bool
+	)
 	flag.StringVar(&configFilename, "config", "", "specify config filename to use")
+	flag.BoolVar(&demo, "demo", false, "run with canned data and no network access")
+	flag.BoolVar(&useFixtures, "fixtures", false, "refresh Burble, METAR, and winds aloft from canned responses served locally")
 	flag.Parse()
 
-	settings, err := newSettings(configFilename)
+	settings, err := newSettings(configFilename, demo)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
@@ -73,8 +175,21 @@ func main() {
 		os.Exit(1)
 	}
 	http.DefaultClient.Jar = jar
+	if settings.DemoMode() {
+		http.DefaultClient.Transport = offlineTransport{}
+		http.DefaultTransport = offlineTransport{}
+	}
+
+	// Fixtures exercise the same refresh pipeline as the live data
+	// sources, unlike demo mode, which replaces it.
+	var fetchers core.Fetchers
+	if useFixtures {
+		harness := fixtures.NewHarness()
+		defer harness.Close()
+		fetchers = harness.Fetchers()
+	}
 
-	app, err := core.NewController(settings)
+	app, err := core.NewControllerWithFetchers(settings, core.SystemClock, fetchers)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		os.Exit(1)
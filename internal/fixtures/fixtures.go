@@ -0,0 +1,55 @@
+// (c) Copyright 2026 Matt Messier
+
+// Package fixtures provides canned responses from the upstream APIs that the
+// server depends on (Burble, aviationweather.gov METARs, and markschulze.net
+// winds aloft) and a harness that serves them over HTTP, so that the whole
+// refresh, update, diff, and stream pipeline can be exercised offline.
+package fixtures
+
+import (
+	_ "embed"
+)
+
+// Burble is a Burble public manifest response with three loads: a load on
+// call with a tandem, an AFF student and sport jumpers, a fueling load with
+// a turning jumper, and an empty load with no call time.
+//
+//go:embed data/burble.json
+var Burble []byte
+
+// METAR is an aviationweather.gov CSV response with one observation for
+// KORE: VFR, few clouds, and winds from 240° gusting.
+//
+//go:embed data/metar.csv
+var METAR []byte
+
+// WindsAloft is a markschulze.net winds aloft response from the surface to
+// 14,000 ft, veering and strengthening with altitude.
+//
+//go:embed data/winds.json
+var WindsAloft []byte
+
+// StaticBurble is a burble.Fetcher that always returns the same data.
+type StaticBurble []byte
+
+func (f StaticBurble) Fetch(columns int) ([]byte, error) {
+	return f, nil
+}
+
+func (f StaticBurble) Reset() error {
+	return nil
+}
+
+// StaticMETAR is a metar.Fetcher that always returns the same data.
+type StaticMETAR []byte
+
+func (f StaticMETAR) Fetch(station string) ([]byte, error) {
+	return f, nil
+}
+
+// StaticWindsAloft is a winds.Fetcher that always returns the same data.
+type StaticWindsAloft []byte
+
+func (f StaticWindsAloft) Fetch(hour int) ([]byte, error) {
+	return f, nil
+}
@@ -0,0 +1,183 @@
+// (c) Copyright 2026 Matt Messier
+
+package fixtures
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/core"
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// Paths at which the harness serves each upstream API.
+const (
+	BurblePath     = "/burble"
+	METARPath      = "/metar"
+	WindsAloftPath = "/winds"
+)
+
+// Harness serves upstream API responses from a local HTTP server. The
+// responses start out as the canned fixtures and may be replaced while the
+// harness is running to drive changes through the pipeline.
+type Harness struct {
+	Server *httptest.Server
+
+	lock      sync.Mutex
+	responses map[string][]byte
+	requests  map[string]int
+	dir       string
+}
+
+// NewHarness starts a harness serving the canned fixtures. Close must be
+// called to shut it down.
+func NewHarness() *Harness {
+	h := &Harness{
+		responses: map[string][]byte{
+			BurblePath:     Burble,
+			METARPath:      METAR,
+			WindsAloftPath: WindsAloft,
+		},
+		requests: make(map[string]int),
+	}
+	h.Server = httptest.NewServer(http.HandlerFunc(h.serveHTTP))
+	return h
+}
+
+func (h *Harness) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	h.lock.Lock()
+	data, ok := h.responses[req.URL.Path]
+	h.requests[req.URL.Path]++
+	h.lock.Unlock()
+
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// Close shuts down the server and removes any state created by Settings.
+func (h *Harness) Close() {
+	h.Server.Close()
+	if h.dir != "" {
+		os.RemoveAll(h.dir)
+	}
+}
+
+// SetResponse replaces the response served at path.
+func (h *Harness) SetResponse(path string, data []byte) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.responses[path] = data
+}
+
+// Requests returns the number of requests made for path.
+func (h *Harness) Requests(path string) int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.requests[path]
+}
+
+func (h *Harness) get(path string) ([]byte, error) {
+	resp, err := h.Server.Client().Get(h.Server.URL + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", path, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+type burbleFetcher struct{ h *Harness }
+
+func (f burbleFetcher) Fetch(columns int) ([]byte, error) {
+	return f.h.get(BurblePath)
+}
+
+func (f burbleFetcher) Reset() error {
+	return nil
+}
+
+type metarFetcher struct{ h *Harness }
+
+func (f metarFetcher) Fetch(station string) ([]byte, error) {
+	return f.h.get(METARPath)
+}
+
+type windsAloftFetcher struct{ h *Harness }
+
+func (f windsAloftFetcher) Fetch(hour int) ([]byte, error) {
+	return f.h.get(WindsAloftPath)
+}
+
+// Fetchers returns fetchers that retrieve data from the harness.
+func (h *Harness) Fetchers() core.Fetchers {
+	return core.Fetchers{
+		Burble:     burbleFetcher{h},
+		METAR:      metarFetcher{h},
+		WindsAloft: windsAloftFetcher{h},
+	}
+}
+
+const harnessConfig = `
+database:
+  driver: sqlite3
+  filename: %[1]s/database.sqlite3
+options_file: %[1]s/options.json
+burble:
+  refresh:
+    interval: 1s
+metar:
+  enabled: true
+  state_file: %[1]s/metar.json
+  refresh:
+    interval: 1s
+winds:
+  enabled: true
+  state_file: %[1]s/winds.json
+  refresh:
+    interval: 1s
+jumprun:
+  enabled: true
+  state_file: %[1]s/jumprun.json
+  history_file: %[1]s/jumprun_history.json
+operating_hours:
+  start: ""
+  days: []
+`
+
+// Settings returns settings for running against the harness. State is kept
+// in a temporary directory that is removed by Close, data sources refresh
+// every second, and the DZ is always open.
+func (h *Harness) Settings() (*settings.Settings, error) {
+	dir, err := ioutil.TempDir("", "manifest-fixtures")
+	if err != nil {
+		return nil, err
+	}
+	h.dir = dir
+
+	filename := filepath.Join(dir, "config.yaml")
+	config := fmt.Sprintf(harnessConfig, dir)
+	if err = ioutil.WriteFile(filename, []byte(config), 0600); err != nil {
+		return nil, err
+	}
+	return settings.NewSettingsWithFilename(filename)
+}
+
+// NewController returns a controller that refreshes its data from the
+// harness using the given clock.
+func (h *Harness) NewController(clock core.Clock) (*core.Controller, error) {
+	s, err := h.Settings()
+	if err != nil {
+		return nil, err
+	}
+	return core.NewControllerWithFetchers(s, clock, h.Fetchers())
+}
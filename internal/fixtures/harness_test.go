@@ -0,0 +1,120 @@
+// (c) Copyright 2026 Matt Messier
+
+package fixtures
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+	"github.com/jumptown-skydiving/manifest-server/pkg/core"
+)
+
+// waitFor polls cond until it returns true or the timeout expires.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func loadIDs(loads []*burble.Load) []int64 {
+	ids := make([]int64, 0, len(loads))
+	for _, l := range loads {
+		ids = append(ids, l.ID)
+	}
+	return ids
+}
+
+func TestHarnessServesFixtures(t *testing.T) {
+	h := NewHarness()
+	defer h.Close()
+
+	c, err := h.NewController(core.SystemClock)
+	if err != nil {
+		t.Fatalf("NewController: %v", err)
+	}
+	defer c.Close()
+
+	b := c.BurbleSource()
+	waitFor(t, "loads", func() bool {
+		return len(b.AllLoads()) == 3
+	})
+	if ids := loadIDs(b.AllLoads()); ids[0] != 101 || ids[1] != 102 || ids[2] != 103 {
+		t.Errorf("loads = %v; want [101 102 103]", ids)
+	}
+	waitFor(t, "METAR", func() bool {
+		return c.METARSource().Station() != ""
+	})
+	waitFor(t, "winds aloft", func() bool {
+		return len(c.WindsAloftSource().Samples()) > 0
+	})
+
+	for _, path := range []string{BurblePath, METARPath, WindsAloftPath} {
+		if n := h.Requests(path); n == 0 {
+			t.Errorf("no requests for %s", path)
+		}
+	}
+}
+
+func TestHarnessSetResponse(t *testing.T) {
+	h := NewHarness()
+	defer h.Close()
+
+	c, err := h.NewController(core.SystemClock)
+	if err != nil {
+		t.Fatalf("NewController: %v", err)
+	}
+	defer c.Close()
+
+	b := c.BurbleSource()
+	waitFor(t, "loads", func() bool {
+		return len(b.AllLoads()) == 3
+	})
+
+	// Drop the first load as though it departed.
+	var manifest map[string]json.RawMessage
+	if err = json.Unmarshal(Burble, &manifest); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	var loads []json.RawMessage
+	if err = json.Unmarshal(manifest["loads"], &loads); err != nil {
+		t.Fatalf("Unmarshal loads: %v", err)
+	}
+	if manifest["loads"], err = json.Marshal(loads[1:]); err != nil {
+		t.Fatalf("Marshal loads: %v", err)
+	}
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	requests := h.Requests(BurblePath)
+	h.SetResponse(BurblePath, data)
+	waitFor(t, "departed load", func() bool {
+		return len(b.AllLoads()) == 2
+	})
+	if ids := loadIDs(b.AllLoads()); ids[0] != 102 || ids[1] != 103 {
+		t.Errorf("loads = %v; want [102 103]", ids)
+	}
+	if n := h.Requests(BurblePath); n <= requests {
+		t.Errorf("Requests(%s) = %d; want more than %d", BurblePath, n, requests)
+	}
+}
+
+func TestHarnessNotFound(t *testing.T) {
+	h := NewHarness()
+	defer h.Close()
+
+	if _, err := h.get("/missing"); err == nil {
+		t.Error("get of an unknown path succeeded")
+	}
+	if n := h.Requests("/missing"); n != 1 {
+		t.Errorf("Requests(/missing) = %d; want 1", n)
+	}
+}
@@ -0,0 +1,89 @@
+// (c) Copyright 2026 Matt Messier
+
+package burble
+
+import "time"
+
+// Anomalies counts unexpected data encountered while decoding Burble data.
+// Burble changes its data format from time to time without notice, so these
+// are the first indication that something upstream has changed. Burble is
+// polled every few seconds, so the running totals count each kind of anomaly
+// in a load only once, however many polls it is seen in.
+type Anomalies struct {
+	UnknownJumpTypes     int64     `json:"unknown_jump_types"`
+	UnparseableFields    int64     `json:"unparseable_fields"`
+	DroppedSlots         int64     `json:"dropped_slots"`
+	LastUnknownJumpType  string    `json:"last_unknown_jump_type,omitempty"`
+	LastUnparseableField string    `json:"last_unparseable_field,omitempty"`
+	LastAnomalyTime      time.Time `json:"last_anomaly_time,omitempty"`
+}
+
+func (a *Anomalies) unknownJumpType(jumpType string) {
+	a.UnknownJumpTypes++
+	a.LastUnknownJumpType = jumpType
+}
+
+func (a *Anomalies) unparseableField(name string) {
+	a.UnparseableFields++
+	a.LastUnparseableField = name
+}
+
+// Count returns the total number of anomalies.
+func (a *Anomalies) Count() int64 {
+	return a.UnknownJumpTypes + a.UnparseableFields + a.DroppedSlots
+}
+
+func (a *Anomalies) add(other *Anomalies, now time.Time) {
+	if other.Count() == 0 {
+		return
+	}
+	a.UnknownJumpTypes += other.UnknownJumpTypes
+	a.UnparseableFields += other.UnparseableFields
+	a.DroppedSlots += other.DroppedSlots
+	if other.LastUnknownJumpType != "" {
+		a.LastUnknownJumpType = other.LastUnknownJumpType
+	}
+	if other.LastUnparseableField != "" {
+		a.LastUnparseableField = other.LastUnparseableField
+	}
+	a.LastAnomalyTime = now
+}
+
+// since returns the anomalies counted in a after those in earlier were.
+func (a Anomalies) since(earlier Anomalies) Anomalies {
+	d := Anomalies{
+		UnknownJumpTypes:  a.UnknownJumpTypes - earlier.UnknownJumpTypes,
+		UnparseableFields: a.UnparseableFields - earlier.UnparseableFields,
+		DroppedSlots:      a.DroppedSlots - earlier.DroppedSlots,
+	}
+	if d.UnknownJumpTypes > 0 {
+		d.LastUnknownJumpType = a.LastUnknownJumpType
+	}
+	if d.UnparseableFields > 0 {
+		d.LastUnparseableField = a.LastUnparseableField
+	}
+	return d
+}
+
+// beyond returns the anomalies of each kind in a that exceed those of the
+// same kind already counted.
+func (a Anomalies) beyond(counted Anomalies) Anomalies {
+	extra := func(n, m int64) int64 {
+		if n > m {
+			return n - m
+		}
+		return 0
+	}
+	d := Anomalies{
+		UnknownJumpTypes:  extra(a.UnknownJumpTypes, counted.UnknownJumpTypes),
+		UnparseableFields: extra(a.UnparseableFields, counted.UnparseableFields),
+		DroppedSlots:      extra(a.DroppedSlots, counted.DroppedSlots),
+	}
+	if d.UnknownJumpTypes > 0 {
+		d.LastUnknownJumpType = a.LastUnknownJumpType
+	}
+	if d.UnparseableFields > 0 {
+		d.LastUnparseableField = a.LastUnparseableField
+	}
+	return d
+}
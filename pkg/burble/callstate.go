@@ -0,0 +1,61 @@
+// (c) Copyright 2026 Matt Messier
+
+package burble
+
+// CallState describes where a load is in its lifecycle.
+type CallState int
+
+const (
+	Manifesting CallState = iota
+	Call
+	Boarding
+	Airborne
+	JumpersAway
+	Landed
+)
+
+// Burble only tells us how many minutes remain until a load's call time,
+// which goes negative once the load has departed. Without any better
+// information, the later states are estimated from typical climb and
+// descent times.
+const (
+	callMinutes     = 20  // the first call is 20 minutes out
+	boardingMinutes = 5   // jumpers are aboard at 5 minutes
+	climbMinutes    = -20 // time from departure to jump run
+	descentMinutes  = -30 // time from departure to the last canopy landing
+)
+
+func (s CallState) String() string {
+	switch s {
+	case Manifesting:
+		return "manifesting"
+	case Call:
+		return "call"
+	case Boarding:
+		return "boarding"
+	case Airborne:
+		return "airborne"
+	case JumpersAway:
+		return "jumpers-away"
+	case Landed:
+		return "landed"
+	}
+	return "unknown"
+}
+
+// callState computes a load's state from its call minutes.
+func (l *Load) callState() CallState {
+	switch {
+	case l.IsNoTime || l.CallMinutes > callMinutes:
+		return Manifesting
+	case l.CallMinutes > boardingMinutes:
+		return Call
+	case l.CallMinutes >= 0:
+		return Boarding
+	case l.CallMinutes > climbMinutes:
+		return Airborne
+	case l.CallMinutes > descentMinutes:
+		return JumpersAway
+	}
+	return Landed
+}
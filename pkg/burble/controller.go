@@ -9,16 +9,19 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
 	"os"
 	"reflect"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 	"unicode"
 
 	"github.com/jumptown-skydiving/manifest-server/pkg/decode"
 	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+	"golang.org/x/net/publicsuffix"
 )
 
 const (
@@ -44,10 +47,31 @@ func parseGroupName(s string) string {
 	return s
 }
 
-func jumperFromJSON(json map[string]interface{}) *Jumper {
-	name := json["name"].(string)
+// parseTeamName returns the team name given by a group name, or an empty
+// string if the group name is one that Burble generated, which is a letter
+// or a number, e.g. "T" for the tandem group "T-1".
+func parseTeamName(groupName string) string {
+	if len([]rune(groupName)) < 2 {
+		return ""
+	}
+	for _, c := range groupName {
+		if unicode.IsLetter(c) {
+			return groupName
+		}
+	}
+	return ""
+}
+
+func jumperFromJSON(json map[string]interface{}, anomalies *Anomalies) *Jumper {
+	name, ok := json["name"].(string)
+	if !ok {
+		anomalies.unparseableField("name")
+	}
 	id := decode.Int("id", json["id"])
-	shortName := json["jump"].(string)
+	shortName, ok := json["jump"].(string)
+	if !ok {
+		anomalies.unparseableField("jump")
+	}
 	if s, ok := json["handycam_jump"].(string); ok && s != "" {
 		shortName = "Handycam"
 	}
@@ -56,6 +80,11 @@ func jumperFromJSON(json map[string]interface{}) *Jumper {
 	if gn, ok := json["group_number"].(string); ok {
 		jumper.GroupName = parseGroupName(gn)
 	}
+	if tn, ok := json["team_name"].(string); ok && strings.TrimSpace(tn) != "" {
+		jumper.TeamName = strings.TrimSpace(tn)
+	} else {
+		jumper.TeamName = parseTeamName(jumper.GroupName)
+	}
 	if ftn, ok := json["formation_type_name"].(string); ok {
 		jumper.IsPondSwoop = strings.ToLower(ftn) == "pond swoop"
 	}
@@ -75,36 +104,103 @@ func jumperFromJSON(json map[string]interface{}) *Jumper {
 	return jumper
 }
 
+// Fetcher retrieves raw manifest data from Burble.
+type Fetcher interface {
+	// Fetch returns the manifest data for the requested number of columns.
+	Fetch(columns int) ([]byte, error)
+
+	// Reset is called when Fetch returns data that cannot be parsed.
+	Reset() error
+}
+
 type Controller struct {
 	settings    *settings.Settings
+	fetcher     Fetcher
+	now         func() time.Time
 	columnCount int
 	loads       []*Load
+	allLoads    []*Load
+	standby     []*Jumper
+	anomalies   Anomalies
+
+	// lastAnomalies are the anomalies from the most recent refresh
+	lastAnomalies Anomalies
+
+	// loadAnomalies are the anomalies already counted for each load in
+	// the most recent refresh, by load ID. Those outside of any load are
+	// under 0.
+	loadAnomalies map[int64]Anomalies
 
 	lock sync.Mutex
 }
 
 func NewController(settings *settings.Settings) *Controller {
+	return NewControllerWithFetcher(settings, nil, nil, nil)
+}
+
+// NewControllerWithFetcher creates a controller that retrieves its data
+// using fetcher and dates it using the times returned by now. Nil values use
+// Burble and the system clock. The default fetcher makes its requests with
+// client, or a client from settings if client is nil. Burble requires the
+// session cookies it sets, so if the client has no cookie jar, the fetcher
+// uses a copy of it with its own.
+func NewControllerWithFetcher(
+	settings *settings.Settings,
+	fetcher Fetcher,
+	client *http.Client,
+	now func() time.Time,
+) *Controller {
+	if fetcher == nil {
+		if client == nil {
+			client = settings.NewHTTPClient()
+		}
+		if client.Jar == nil {
+			jar, err := cookiejar.New(&cookiejar.Options{
+				PublicSuffixList: publicsuffix.List,
+			})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Could not create cookie jar: %v\n", err)
+			} else {
+				withJar := *client
+				withJar.Jar = jar
+				client = &withJar
+			}
+		}
+		fetcher = &burbleFetcher{settings: settings, client: client}
+	}
+	if now == nil {
+		now = time.Now
+	}
 	return &Controller{
 		settings: settings,
+		fetcher:  fetcher,
+		now:      now,
 	}
 }
 
-// RefreshCookies makes a throw-away request to get cookies from Burble so that
-// data refreshes will work.
-func (c *Controller) RefreshCookies() error {
-	// Create and use our own request rather than use http.DefaultClient.Get
+type burbleFetcher struct {
+	settings *settings.Settings
+	client   *http.Client
+}
+
+// Reset makes a throw-away request to get cookies from Burble so that data
+// refreshes will work.
+func (f *burbleFetcher) Reset() error {
+	// Create and use our own request rather than use the client's Post
 	// so that we can keep up the charade that we're a browser and not a
 	// server app scraping data!
-	dzid := c.settings.BurbleDropzoneID()
+	dzid := f.settings.BurbleDropzoneID()
 	urlWithDZID := fmt.Sprintf("%s?dz_id=%d", burblePublicURL, dzid)
-	request, err := c.settings.NewHTTPRequest(http.MethodPost, urlWithDZID, nil)
+	request, err := f.settings.NewHTTPRequest(http.MethodPost, urlWithDZID, nil)
 	if err != nil {
 		return err
 	}
 
-	if _, err = http.DefaultClient.Do(request); err != nil {
+	resp, err := f.client.Do(request)
+	if err != nil {
 		return err
 	}
+	resp.Body.Close()
 
 	// All we want are the cookies. They've been set in the cookie jar, so
 	// we can throw away the response body.
@@ -112,42 +208,52 @@ func (c *Controller) RefreshCookies() error {
 	return nil
 }
 
-// Refresh retrieves new data from Burble
-func (c *Controller) Refresh() (bool, error) {
+func (f *burbleFetcher) Fetch(columns int) ([]byte, error) {
 	u, err := url.Parse(burbleManifestURL)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	if len(http.DefaultClient.Jar.Cookies(u)) == 0 {
-		if err = c.RefreshCookies(); err != nil {
-			return false, err
+	if f.client.Jar == nil || len(f.client.Jar.Cookies(u)) == 0 {
+		if err = f.Reset(); err != nil {
+			return nil, err
 		}
 	}
 
-	// Ask Burble for the number of columns we want to display + 1
-	// Do this so that we can filter out loads older tha min call minutes,
-	// but still be able to determine which jumpers are turning.
-	burbleNumColumns := c.settings.DisplayColumns() + 1
-
-	dzid := c.settings.BurbleDropzoneID()
-	bodyString := fmt.Sprintf("aircraft=0&columns=%d&display_tandem=1&display_student=1&display_sport=1&display_menu=0&font_size=0&action=getLoads&dz_id=%d&date_format=m%%2Fd%%2FY&acl_application=Burble%%20DZM", burbleNumColumns, dzid)
+	dzid := f.settings.BurbleDropzoneID()
+	bodyString := fmt.Sprintf("aircraft=0&columns=%d&display_tandem=1&display_student=1&display_sport=1&display_menu=0&font_size=0&action=getLoads&dz_id=%d&date_format=m%%2Fd%%2FY&acl_application=Burble%%20DZM", columns, dzid)
 	body := bytes.NewReader([]byte(bodyString))
 
-	request, err := c.settings.NewHTTPRequest(http.MethodPost, burbleManifestURL, body)
+	request, err := f.settings.NewHTTPRequest(http.MethodPost, burbleManifestURL, body)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	request.Header.Set("Origin", burbleBaseURL)
 	request.Header.Set("Referer", burblePublicURL)
 	request.Header.Set("X-Requested-With", "XMLHttpRequest")
 
-	resp, err := http.DefaultClient.Do(request)
+	resp, err := f.client.Do(request)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	data, err := ioutil.ReadAll(resp.Body)
+	return ioutil.ReadAll(resp.Body)
+}
+
+// RefreshCookies makes a throw-away request to get cookies from Burble so that
+// data refreshes will work.
+func (c *Controller) RefreshCookies() error {
+	return c.fetcher.Reset()
+}
+
+// Refresh retrieves new data from Burble
+func (c *Controller) Refresh() (bool, error) {
+	// Ask Burble for the number of columns we want to display + 1
+	// Do this so that we can filter out loads older tha min call minutes,
+	// but still be able to determine which jumpers are turning.
+	burbleNumColumns := c.settings.DisplayColumns() + 1
+
+	data, err := c.fetcher.Fetch(burbleNumColumns)
 	if err != nil {
 		return false, err
 	}
@@ -168,9 +274,56 @@ func (c *Controller) Refresh() (bool, error) {
 		return false, err
 	}
 
-	var loads []*Load
-	burbleData := rawBurbleData.(map[string]interface{})
-	if _, ok := burbleData["loads"]; !ok {
+	var (
+		loads       []*Load
+		anomalies   Anomalies
+		sourceLoads []interface{}
+	)
+	perLoad := make(map[int64]Anomalies)
+	defer func() {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		// Count only the anomalies that weren't already in each load,
+		// so that the totals don't grow on every poll.
+		other := anomalies
+		for _, a := range perLoad {
+			other = other.since(a)
+		}
+		if sourceLoads == nil {
+			// The loads couldn't be decoded, so keep what was
+			// counted for them.
+			for id, a := range c.loadAnomalies {
+				perLoad[id] = a
+			}
+		}
+		perLoad[0] = other
+		now := c.now()
+		for id, a := range perLoad {
+			fresh := a.beyond(c.loadAnomalies[id])
+			c.anomalies.add(&fresh, now)
+		}
+		c.loadAnomalies = perLoad
+
+		// Only log when the anomalies differ from the last refresh
+		// to avoid repeating the same complaint every few seconds.
+		if anomalies == c.lastAnomalies {
+			return
+		}
+		c.lastAnomalies = anomalies
+		if anomalies.Count() == 0 {
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Burble data anomalies: %d unknown jump types (%q), %d unparseable fields (%q), %d dropped slots\n",
+			anomalies.UnknownJumpTypes, anomalies.LastUnknownJumpType,
+			anomalies.UnparseableFields, anomalies.LastUnparseableField,
+			anomalies.DroppedSlots)
+	}()
+
+	burbleData, _ := rawBurbleData.(map[string]interface{})
+	sourceLoads, ok := burbleData["loads"].([]interface{})
+	if !ok {
+		anomalies.unparseableField("loads")
 		// If we get unparseable data, dump it to a file so we can
 		// review it later to see what the problem is.
 		_ = ioutil.WriteFile("burble.json", data, 0644)
@@ -181,14 +334,16 @@ func (c *Controller) Refresh() (bool, error) {
 	}
 
 	definedJumptypeGroups := c.settings.GroupByJumpTypes()
+	pullPolicy := c.settings.PullAltitudePolicy()
 	organizerStrings := c.settings.OrganizerStrings()
-	sourceLoads := burbleData["loads"].([]interface{})
 	columnCount := burbleNumColumns - 1
 	for _, rawLoadData := range sourceLoads {
 		loadData, ok := rawLoadData.(map[string]interface{})
 		if !ok {
+			anomalies.unparseableField("loads")
 			continue
 		}
+		start := anomalies
 
 		// Ignore loads that are not public. The old format had this
 		// field, but the new format does not. Honor it if it comes
@@ -199,9 +354,13 @@ func (c *Controller) Refresh() (bool, error) {
 			}
 		}
 
+		aircraftName, ok := loadData["aircraft_name"].(string)
+		if !ok {
+			anomalies.unparseableField("aircraft_name")
+		}
 		l := Load{
 			ID:           decode.Int("id", loadData["id"]),
-			AircraftName: loadData["aircraft_name"].(string),
+			AircraftName: aircraftName,
 			IsFueling:    decode.Bool("is_fueling", loadData["is_fueling"]),
 			IsTurning:    decode.Bool("is_turning", loadData["is_turning"]),
 			CallMinutes:  decode.Int("time_left", loadData["time_left"]),
@@ -209,15 +368,23 @@ func (c *Controller) Refresh() (bool, error) {
 		if l.CallMinutes >= 120 {
 			l.IsNoTime = true
 		}
+		l.State = l.callState()
 
 		// aircraft_name seems to always be "" in the new format
-		name := loadData["name"].(string)
+		name, ok := loadData["name"].(string)
+		if !ok {
+			anomalies.unparseableField("name")
+		}
 		if l.AircraftName == "" {
 			if x := strings.LastIndex(name, " "); x != -1 {
 				l.AircraftName = name[:x]
 			}
 		}
-		l.LoadNumber = strings.TrimSpace(name[len(l.AircraftName)+1:])
+		if len(name) > len(l.AircraftName) {
+			l.LoadNumber = strings.TrimSpace(name[len(l.AircraftName)+1:])
+		} else {
+			anomalies.unparseableField("name")
+		}
 
 		// Reporting of available slots seems to be something Burble has
 		// had ongoing difficulties with. How it's reported and its own
@@ -232,11 +399,23 @@ func (c *Controller) Refresh() (bool, error) {
 		reserveSlots := decode.Int("reserve_slots", loadData["reserve_slots"])
 
 		jumptypeGroups := make(map[string]*Jumper)
-		groups := loadData["groups"].([]interface{})
+		groups, ok := loadData["groups"].([]interface{})
+		if !ok {
+			anomalies.unparseableField("groups")
+		}
 		for _, rawGroupData := range groups {
-			members := rawGroupData.([]interface{})
-			memberData := members[0].(map[string]interface{})
-			primaryJumper := jumperFromJSON(memberData)
+			members, ok := rawGroupData.([]interface{})
+			if !ok || len(members) == 0 {
+				anomalies.unparseableField("groups")
+				continue
+			}
+			memberData, ok := members[0].(map[string]interface{})
+			if !ok {
+				anomalies.unparseableField("groups")
+				anomalies.DroppedSlots += int64(len(members))
+				continue
+			}
+			primaryJumper := jumperFromJSON(memberData, &anomalies)
 
 			jump := strings.ToLower(primaryJumper.ShortName)
 			for _, o := range organizerStrings {
@@ -263,7 +442,8 @@ func (c *Controller) Refresh() (bool, error) {
 					break
 				}
 			}
-			switch memberData["type"].(string) {
+			jumpType, _ := memberData["type"].(string)
+			switch jumpType {
 			case "Sport Jumper":
 				l.SportJumpers = append(l.SportJumpers, primaryJumper)
 			case "Student":
@@ -272,9 +452,19 @@ func (c *Controller) Refresh() (bool, error) {
 			case "Tandem":
 				primaryJumper.IsTandem = true
 				l.Tandems = append(l.Tandems, primaryJumper)
+			default:
+				// The group still counts against available
+				// slots below, but none of it is displayed.
+				anomalies.unknownJumpType(jumpType)
+				anomalies.DroppedSlots += int64(len(members))
 			}
 			for i, rawMemberData := range members {
-				memberData = rawMemberData.(map[string]interface{})
+				memberData, ok = rawMemberData.(map[string]interface{})
+				if !ok {
+					anomalies.unparseableField("groups")
+					anomalies.DroppedSlots++
+					continue
+				}
 				switch {
 				case decode.Bool("is_public", memberData["is_public"]):
 					publicSlots++
@@ -284,11 +474,13 @@ func (c *Controller) Refresh() (bool, error) {
 				if i < 1 {
 					continue
 				}
-				jumper := jumperFromJSON(memberData)
+				jumper := jumperFromJSON(memberData, &anomalies)
 				primaryJumper.AddGroupMember(jumper)
 			}
 		}
 
+		classifyPullAltitudes(&l, pullPolicy)
+
 		// Group sport jumpers by organizer. Start by building a map of
 		// jumpers that are in the same group.
 		groupNames := make(map[string][]*Jumper)
@@ -304,14 +496,17 @@ func (c *Controller) Refresh() (bool, error) {
 		// unique group to find groups with organizers. Any group that
 		// has no organizer is not treated as a group and all members
 		// are added to the manifest individually.
-		var groupedJumpers, lowPulls, sportJumpers []*Jumper
+		var groupedJumpers, lowPulls, highPulls, sportJumpers []*Jumper
 	outerLoop:
 		for _, members := range groupNames {
-			lowPull := true
+			lowPull, highPull := true, true
 			for _, member := range members {
 				if !member.IsLowPull {
 					lowPull = false
 				}
+				if !member.IsHighPull {
+					highPull = false
+				}
 				if !member.IsOrganizer {
 					continue
 				}
@@ -321,13 +516,19 @@ func (c *Controller) Refresh() (bool, error) {
 					if m != organizer {
 						organizer.AddGroupMember(m)
 					}
+					if organizer.TeamName == "" {
+						organizer.TeamName = m.TeamName
+					}
 				}
 				sort.Sort(JumpersByName(organizer.GroupMembers))
 				continue outerLoop
 			}
-			if lowPull {
+			switch {
+			case lowPull:
 				lowPulls = append(lowPulls, members...)
-			} else {
+			case highPull:
+				highPulls = append(highPulls, members...)
+			default:
 				sportJumpers = append(sportJumpers, members...)
 			}
 		}
@@ -338,14 +539,8 @@ func (c *Controller) Refresh() (bool, error) {
 		// locale aware surname.
 		sort.Sort(JumpersByName(l.Tandems))
 		sort.Sort(JumpersByName(l.Students))
-
-		l.SportJumpers = l.SportJumpers[:0]
-		sort.Sort(JumpersByName(groupedJumpers))
-		l.SportJumpers = append(l.SportJumpers, groupedJumpers...)
-		sort.Sort(JumpersByName(sportJumpers))
-		l.SportJumpers = append(l.SportJumpers, sportJumpers...)
-		sort.Sort(JumpersByName(lowPulls))
-		l.SportJumpers = append(l.SportJumpers, lowPulls...)
+		l.SportJumpers = orderSportJumpers(groupedJumpers, sportJumpers,
+			lowPulls, highPulls, pullPolicy)
 
 		// Make private slots count against reserve slots. It
 		// would seem to be the case that PrivateSlots mean
@@ -361,9 +556,12 @@ func (c *Controller) Refresh() (bool, error) {
 		}
 
 		loads = append(loads, &l)
+		perLoad[l.ID] = anomalies.since(start)
 	}
 
 	c.markTurningJumpers(loads)
+	c.assignStableIDs(loads)
+	standby := standbyFromJSON(burbleData["waitlist"], &anomalies)
 
 	// Delete loads with CallMinutes older than our minimum setting
 	minCallMinutes := c.settings.MinCallMinutes()
@@ -389,10 +587,54 @@ func (c *Controller) Refresh() (bool, error) {
 		c.loads = finalLoads
 		changed = true
 	}
+	c.allLoads = loads
+	if !reflect.DeepEqual(c.standby, standby) {
+		c.standby = standby
+		changed = true
+	}
 
 	return changed, nil
 }
 
+// standbyFromJSON decodes Burble's waitlist, which lists jumpers waiting for
+// a slot in the order that they'll be placed. Burble omits the waitlist
+// entirely when the DZ doesn't use it.
+func standbyFromJSON(raw interface{}, anomalies *Anomalies) []*Jumper {
+	if raw == nil {
+		return nil
+	}
+	entries, ok := raw.([]interface{})
+	if !ok {
+		anomalies.unparseableField("waitlist")
+		return nil
+	}
+	var standby []*Jumper
+	for _, rawEntry := range entries {
+		entry, ok := rawEntry.(map[string]interface{})
+		if !ok {
+			anomalies.unparseableField("waitlist")
+			continue
+		}
+		j := jumperFromJSON(entry, anomalies)
+		switch jumpType, _ := entry["type"].(string); jumpType {
+		case "Student":
+			j.IsStudent = true
+		case "Tandem":
+			j.IsTandem = true
+		}
+		standby = append(standby, j)
+	}
+	return standby
+}
+
+func (c *Controller) assignStableIDs(loads []*Load) {
+	now := c.now()
+	if loc, err := c.settings.Location(); err == nil {
+		now = now.In(loc)
+	}
+	assignStableIDs(loads, now)
+}
+
 func (c *Controller) markTurningJumpers(allLoads []*Load) {
 	loadsByAircraft := make(map[string][]*Load)
 	for _, load := range allLoads {
@@ -421,8 +663,33 @@ func (c *Controller) Loads() []*Load {
 	return c.loads
 }
 
+// AllLoads returns every public load in the latest data from Burble,
+// including those that aren't displayed because they're past the minimum
+// call minutes or beyond the display columns.
+func (c *Controller) AllLoads() []*Load {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.allLoads
+}
+
+// Standby returns the jumpers waiting for a slot, in the order that they'll
+// be placed on loads.
+func (c *Controller) Standby() []*Jumper {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.standby
+}
+
 func (c *Controller) ColumnCount() int {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	return c.columnCount
 }
+
+// Anomalies returns the running totals of unexpected data encountered while
+// decoding Burble data.
+func (c *Controller) Anomalies() Anomalies {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.anomalies
+}
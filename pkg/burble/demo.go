@@ -0,0 +1,132 @@
+// (c) Copyright 2026 Matt Messier
+
+package burble
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type demoAircraft struct {
+	name     string
+	maxSlots int64
+	offset   int64 // minutes past the half hour when the aircraft departs
+}
+
+var demoFleet = []demoAircraft{
+	{name: "Otter", maxSlots: 23, offset: 0},
+	{name: "Caravan", maxSlots: 15, offset: 15},
+}
+
+var demoNames = []string{
+	"Alex Rivera", "Blake Chen", "Casey Morgan", "Dana Brooks",
+	"Eli Foster", "Frankie Hayes", "Gray Patel", "Harper Quinn",
+	"Indy Walsh", "Jordan Price", "Kai Nakamura", "Logan Reyes",
+	"Morgan Ellis", "Noor Haddad", "Oakley James", "Parker Stone",
+	"Quinn Sato", "Riley Novak", "Sage Romero", "Taylor Kim",
+	"Uma Fischer", "Val Duarte", "Wren Adler", "Yael Cohen",
+}
+
+var demoJumps = []string{"Full Alt", "Full Alt", "Belly", "Freefly", "Hop & Pop", "Wingsuit"}
+
+// demoLoad builds a canned load. The same departure always produces the
+// same load so that the demo manifest changes smoothly between refreshes.
+func demoLoad(aircraft demoAircraft, departure, nowMinutes int64) *Load {
+	r := rand.New(rand.NewSource(departure*int64(len(aircraft.name)) + aircraft.maxSlots))
+	l := &Load{
+		ID:           departure*10 + aircraft.offset,
+		AircraftName: aircraft.name,
+		LoadNumber:   strconv.FormatInt(departure%40+1, 10),
+		CallMinutes:  departure*30 + aircraft.offset - nowMinutes,
+	}
+	l.State = l.callState()
+
+	// Slots fill up as the call time approaches
+	filled := aircraft.maxSlots
+	if l.CallMinutes > 5 {
+		filled = aircraft.maxSlots * (65 - l.CallMinutes) / 60
+		if filled < 2 {
+			filled = 2
+		}
+	}
+
+	names := r.Perm(len(demoNames))
+	id := l.ID * 100
+	next := func(jump string) *Jumper {
+		id++
+		name := demoNames[names[int(id)%len(names)]]
+		return NewJumper(id, name, jump)
+	}
+
+	slots := int64(0)
+	if filled >= 3 {
+		tandem := next("Tandem")
+		tandem.IsTandem = true
+		tandem.AddGroupMember(next("Tandem Instructor"))
+		tandem.AddGroupMember(next("vs"))
+		l.Tandems = append(l.Tandems, tandem)
+		slots += 3
+	}
+	if filled-slots >= 2 {
+		student := next("AFF Level 3")
+		student.IsStudent = true
+		student.AddGroupMember(next("AFF Instructor"))
+		l.Students = append(l.Students, student)
+		slots += 2
+	}
+	for ; slots < filled; slots++ {
+		l.SportJumpers = append(l.SportJumpers, next(demoJumps[r.Intn(len(demoJumps))]))
+	}
+
+	sort.Sort(JumpersByName(l.Tandems))
+	sort.Sort(JumpersByName(l.Students))
+	sort.Sort(JumpersByName(l.SportJumpers))
+	l.SlotsAvailable = aircraft.maxSlots - slots
+	return l
+}
+
+// RefreshDemo replaces the manifest with a canned one that cycles through
+// loads on a fixed schedule. It makes no network requests.
+func (c *Controller) RefreshDemo(now time.Time) (bool, error) {
+	columnCount := c.settings.DisplayColumns()
+	minCallMinutes := int64(c.settings.MinCallMinutes())
+	nowMinutes := now.Unix() / 60
+
+	var allLoads []*Load
+	first := (nowMinutes + minCallMinutes) / 30
+	for departure := first; departure < first+int64(columnCount)+1; departure++ {
+		for _, aircraft := range demoFleet {
+			allLoads = append(allLoads, demoLoad(aircraft, departure, nowMinutes))
+		}
+	}
+	sort.SliceStable(allLoads, func(i, j int) bool {
+		return allLoads[i].CallMinutes < allLoads[j].CallMinutes
+	})
+	c.markTurningJumpers(allLoads)
+	c.assignStableIDs(allLoads)
+
+	var loads []*Load
+	for _, l := range allLoads {
+		if l.CallMinutes >= minCallMinutes && len(loads) < columnCount {
+			loads = append(loads, l)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	changed := false
+	if c.columnCount != columnCount {
+		c.columnCount = columnCount
+		changed = true
+	}
+	if !reflect.DeepEqual(c.loads, loads) {
+		c.loads = loads
+		changed = true
+	}
+	c.allLoads = allLoads
+	return changed, nil
+}
@@ -0,0 +1,46 @@
+// (c) Copyright 2026 Matt Messier
+
+package burble
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// CSVHandler exports every slot on the loads currently being displayed,
+// one row per jumper, with the accounting category for its jump type.
+func (c *Controller) CSVHandler(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="loads.csv"`)
+
+	out := csv.NewWriter(w)
+	_ = out.Write([]string{
+		"load_id", "aircraft", "load_number", "jumper_id", "name",
+		"jump_type", "category", "rig",
+	})
+	for _, l := range c.Loads() {
+		l.ForEachJumper(func(j *Jumper) {
+			// Jump type groups are headings, not jumpers
+			if j.JumpType == "" && len(j.GroupMembers) > 0 {
+				return
+			}
+			_ = out.Write([]string{
+				strconv.FormatInt(l.ID, 10),
+				l.AircraftName,
+				l.LoadNumber,
+				strconv.FormatInt(j.ID, 10),
+				j.Name,
+				j.JumpType,
+				c.settings.AccountingCategory(j.JumpType),
+				j.RigName,
+			})
+		})
+	}
+	out.Flush()
+	if err := out.Error(); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot write loads CSV: %v\n", err)
+	}
+}
@@ -2,16 +2,23 @@
 
 package burble
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
 
 type ForEachJumperFunc func(j *Jumper)
 
 type Jumper struct {
 	ID             int64     `json:"id"`
+	StableID       string    `json:"stable_id"`
 	Name           string    `json:"name"`
 	ShortName      string    `json:"short_name"`
+	JumpType       string    `json:"jump_type"`
 	RigName        string    `json:"rig_name"`
 	GroupName      string    `json:"group_name"`
+	TeamName       string    `json:"team_name"` // for competition loads
 	GroupMembers   []*Jumper `json:"group_members"`
 	IsInstructor   bool      `json:"is_instructor"`
 	IsTandem       bool      `json:"is_tandem"`
@@ -21,6 +28,8 @@ type Jumper struct {
 	IsTurning      bool      `json:"is_turning"`
 	IsPondSwoop    bool      `json:"is_pond_swoop"`
 	IsLowPull      bool      `json:"is_low_pull"`
+	IsHighPull     bool      `json:"is_high_pull"`
+	PullAltitude   int       `json:"pull_altitude"` // feet; 0 if unknown
 }
 
 func NewJumper(id int64, name, shortName string) *Jumper {
@@ -28,6 +37,7 @@ func NewJumper(id int64, name, shortName string) *Jumper {
 		ID:        id,
 		Name:      strings.TrimSpace(name),
 		ShortName: strings.TrimSpace(shortName),
+		JumpType:  strings.TrimSpace(shortName),
 	}
 
 	if strings.HasPrefix(strings.ToLower(j.Name), "jm ") {
@@ -39,15 +49,10 @@ func NewJumper(id int64, name, shortName string) *Jumper {
 		j.IsVideographer = true
 	}
 
-	if strings.HasSuffix(j.ShortName, " H/P") {
-		j.IsLowPull = true
-	}
-	if strings.HasPrefix(j.ShortName, "3-5k") {
-		j.IsLowPull = true
-	}
-	if strings.HasPrefix(j.ShortName, "3.5k") {
-		j.IsLowPull = true
-	}
+	// The pull altitude policy is applied once the jumper is placed on a
+	// load; until then, use the default low pull altitude.
+	j.PullAltitude = parsePullAltitude(j.ShortName)
+	classifyPullAltitude(j, settings.DefaultPullAltitudePolicy, false)
 
 	return j
 }
@@ -88,6 +93,7 @@ type Load struct {
 	IsNoTime       bool      `json:"is_no_time"`
 	SlotsAvailable int64     `json:"slots_available"`
 	CallMinutes    int64     `json:"call_minutes"`
+	State          CallState `json:"state"`
 	LoadNumber     string    `json:"load_number"`
 	Tandems        []*Jumper `json:"tandems"`
 	Students       []*Jumper `json:"students"`
@@ -0,0 +1,103 @@
+// (c) Copyright 2026 Matt Messier
+
+package burble
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// pullAltitudeRE matches pull altitudes in jump names such as "3.5k",
+// "3-5k" (Burble's spelling of 3.5k), or "5k".
+var pullAltitudeRE = regexp.MustCompile(`(?i)\b(\d+)(?:[.-](\d))?k\b`)
+
+// parsePullAltitude returns the pull altitude in feet named by a jump, or 0
+// if the jump does not name one.
+func parsePullAltitude(jump string) int {
+	m := pullAltitudeRE.FindStringSubmatch(jump)
+	if m == nil {
+		return 0
+	}
+	thousands, _ := strconv.Atoi(m[1])
+	hundreds, _ := strconv.Atoi(m[2])
+	return thousands*1000 + hundreds*100
+}
+
+func isHopAndPop(jump string) bool {
+	return strings.HasSuffix(jump, " H/P")
+}
+
+// classifyPullAltitude sets IsLowPull and IsHighPull according to the pull
+// altitude policy. Hop & pops always count as low pulls.
+func classifyPullAltitude(j *Jumper, policy settings.PullAltitudePolicy, student bool) {
+	j.IsLowPull, j.IsHighPull = false, false
+	if student && policy.StudentsExempt {
+		return
+	}
+	if isHopAndPop(j.ShortName) {
+		j.IsLowPull = true
+		return
+	}
+	if j.PullAltitude == 0 {
+		return
+	}
+	if policy.LowAltitude > 0 && j.PullAltitude <= policy.LowAltitude {
+		j.IsLowPull = true
+	}
+	if policy.HighAltitude > 0 && j.PullAltitude >= policy.HighAltitude {
+		j.IsHighPull = true
+	}
+}
+
+// classifyPullAltitudes classifies every jumper on a load. Tandem and
+// student groups, including their instructors, count as students.
+func classifyPullAltitudes(l *Load, policy settings.PullAltitudePolicy) {
+	classify := func(jumpers []*Jumper, student bool) {
+		for _, j := range jumpers {
+			classifyPullAltitude(j, policy, student)
+			j.ForEachGroupMember(func(m *Jumper) {
+				classifyPullAltitude(m, policy, student)
+			})
+		}
+	}
+	classify(l.Tandems, true)
+	classify(l.Students, true)
+	classify(l.SportJumpers, false)
+}
+
+// orderSportJumpers arranges the sport jumpers on a load: organized groups
+// first, followed by individual jumpers with low and high pulls placed
+// according to the policy.
+func orderSportJumpers(
+	grouped, sport, lowPulls, highPulls []*Jumper,
+	policy settings.PullAltitudePolicy,
+) []*Jumper {
+	var first, last []*Jumper
+	place := func(jumpers []*Jumper, order string) {
+		sort.Sort(JumpersByName(jumpers))
+		switch order {
+		case settings.PullOrderFirst:
+			first = append(first, jumpers...)
+		case settings.PullOrderLast:
+			last = append(last, jumpers...)
+		default:
+			sport = append(sport, jumpers...)
+		}
+	}
+	place(highPulls, policy.HighOrder)
+	place(lowPulls, policy.LowOrder)
+
+	sort.Sort(JumpersByName(grouped))
+	sort.Sort(JumpersByName(sport))
+
+	result := make([]*Jumper, 0, len(grouped)+len(first)+len(sport)+len(last))
+	result = append(result, grouped...)
+	result = append(result, first...)
+	result = append(result, sport...)
+	result = append(result, last...)
+	return result
+}
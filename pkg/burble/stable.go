@@ -0,0 +1,36 @@
+// (c) Copyright 2026 Matt Messier
+
+package burble
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+)
+
+// stableID returns an identifier for a jumper that does not depend on the
+// slot IDs assigned by Burble, which are sometimes reused or renumbered
+// between refreshes. The same jumper making the same jump on the same day
+// always gets the same identifier.
+func stableID(j *Jumper, day string, occurrence int) string {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s\x00%s\x00%s\x00%d",
+		strings.ToLower(j.Name), strings.ToLower(j.JumpType), day, occurrence)
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// assignStableIDs sets StableID for every jumper on the loads. Jumpers with
+// the same name and jump type on the same load are distinguished by the
+// order in which they appear.
+func assignStableIDs(loads []*Load, now time.Time) {
+	day := now.Format("2006-01-02")
+	for _, l := range loads {
+		seen := make(map[string]int)
+		l.ForEachJumper(func(j *Jumper) {
+			key := strings.ToLower(j.Name) + "\x00" + strings.ToLower(j.JumpType)
+			j.StableID = stableID(j, day, seen[key])
+			seen[key]++
+		})
+	}
+}
@@ -0,0 +1,267 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/db"
+)
+
+// Acknowledgment records that a display rendered a takeover or critical
+// alert.
+type Acknowledgment struct {
+	Display string
+	Channel string
+	Time    time.Time
+}
+
+// Delivery is the acknowledgment state of a takeover or critical alert that
+// is being shown.
+type Delivery struct {
+	Kind         string // "takeover" or "alert"
+	ID           string // the alert ID; empty for the takeover
+	Message      string
+	Posted       time.Time
+	Acknowledged []Acknowledgment // in the order they were received
+	Pending      []string         // registered displays that haven't acknowledged
+}
+
+// deliveryKey identifies a posting of a message, so that acknowledgments of
+// an earlier posting with the same ID don't count.
+func deliveryKey(alertID string, posted time.Time) string {
+	if alertID == "" {
+		alertID = "takeover"
+	}
+	var seconds int64
+	if !posted.IsZero() {
+		seconds = posted.Unix()
+	}
+	return fmt.Sprintf("%s@%d", alertID, seconds)
+}
+
+// currentDeliveries returns the takeover and critical alerts being shown,
+// without acknowledgments, keyed by deliveryKey.
+func (c *Controller) currentDeliveries() map[string]Delivery {
+	deliveries := make(map[string]Delivery)
+	if t, ok := c.Takeover(); ok {
+		deliveries[deliveryKey("", t.Posted)] = Delivery{
+			Kind:    "takeover",
+			Message: t.Message,
+			Posted:  t.Posted,
+		}
+	}
+	now := c.clock.Now()
+	c.mutex.Lock()
+	for _, a := range c.alerts {
+		if a.Priority < AlertCritical || a.expired(now) {
+			continue
+		}
+		deliveries[deliveryKey(a.ID, a.Posted)] = Delivery{
+			Kind:    "alert",
+			ID:      a.ID,
+			Message: a.Message,
+			Posted:  a.Posted,
+		}
+	}
+	c.mutex.Unlock()
+	return deliveries
+}
+
+// Acknowledge records that a display on a channel rendered the takeover, if
+// alertID is empty, or the critical alert with that ID. Posted is the time
+// the message was posted, as sent to the display, so that a late
+// acknowledgment of an earlier posting is rejected. Only registered displays
+// may acknowledge, from the channel they are registered to show, and only
+// messages shown on it. Acknowledgments are kept in the database so that
+// they survive restarts.
+func (c *Controller) Acknowledge(alertID string, posted time.Time, display, channel string) error {
+	if display == "" {
+		return errors.New("display is required")
+	}
+	display = strings.ToLower(display)
+	registered, ok := c.settings.RegisteredDisplays()[display]
+	if !ok {
+		return fmt.Errorf("display %q is not registered", display)
+	}
+	if registered != channel {
+		return fmt.Errorf("display %q is registered to channel %q, not %q",
+			display, registered, channel)
+	}
+
+	current := c.currentDeliveries()
+	key := deliveryKey(alertID, posted)
+	d, ok := current[key]
+	if !ok {
+		return errors.New("message is not being shown")
+	}
+	if d.Kind == "alert" && !c.alertShown(channel, d.ID) {
+		return fmt.Errorf("message is not being shown on channel %q", channel)
+	}
+
+	keep := make([]string, 0, len(current))
+	for k := range current {
+		keep = append(keep, k)
+	}
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err = c.db.DeleteAcknowledgments(tx, keep); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	err = c.db.RecordAcknowledgment(tx, db.Acknowledgment{
+		Delivery: key,
+		Display:  display,
+		Channel:  channel,
+		Time:     c.clock.Now(),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// alertShown returns true if the alert with the given ID is shown on a
+// channel.
+func (c *Controller) alertShown(channel, id string) bool {
+	for _, a := range c.Alerts(channel) {
+		if a.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// acknowledgments returns the acknowledgments of a delivery, oldest first.
+func (c *Controller) acknowledgments(key string) []Acknowledgment {
+	tx, err := c.db.Begin()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error querying acknowledgments: %v\n", err)
+		return nil
+	}
+	records, err := c.db.QueryAcknowledgments(tx, key)
+	_ = tx.Commit()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error querying acknowledgments: %v\n", err)
+		return nil
+	}
+	acks := make([]Acknowledgment, 0, len(records))
+	for _, r := range records {
+		acks = append(acks, Acknowledgment{
+			Display: r.Display,
+			Channel: r.Channel,
+			Time:    r.Time,
+		})
+	}
+	return acks
+}
+
+// Deliveries returns the acknowledgment state of the takeover and critical
+// alerts being shown, most recently posted first. Registered displays are
+// pending until they acknowledge a message that is shown on their channel.
+func (c *Controller) Deliveries() []Delivery {
+	registered := c.settings.RegisteredDisplays()
+	shown := make(map[string]map[string]bool) // channel to alert IDs
+	for _, channel := range registered {
+		if shown[channel] == nil {
+			shown[channel] = make(map[string]bool)
+			for _, a := range c.Alerts(channel) {
+				shown[channel][a.ID] = true
+			}
+		}
+	}
+
+	var deliveries []Delivery
+	for key, d := range c.currentDeliveries() {
+		d.Acknowledged = c.acknowledgments(key)
+
+		acknowledged := make(map[string]bool)
+		for _, a := range d.Acknowledged {
+			acknowledged[a.Display] = true
+		}
+		for display, channel := range registered {
+			if acknowledged[display] || (d.Kind == "alert" && !shown[channel][d.ID]) {
+				continue
+			}
+			d.Pending = append(d.Pending, display)
+		}
+		sort.Strings(d.Pending)
+		deliveries = append(deliveries, d)
+	}
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].Posted.After(deliveries[j].Posted)
+	})
+	return deliveries
+}
+
+var deliveriesTemplate = template.Must(template.New("deliveries").Parse(deliveriesHTML))
+
+// DeliveriesHandler serves a page showing which displays have acknowledged
+// the takeover and critical alerts being shown.
+func (c *Controller) DeliveriesHandler(w http.ResponseWriter, req *http.Request) {
+	type row struct {
+		Delivery
+		Posted string
+		Times  []string
+	}
+	var rows []row
+	for _, d := range c.Deliveries() {
+		r := row{
+			Delivery: d,
+			Posted:   d.Posted.In(c.Location()).Format("3:04:05 PM"),
+		}
+		for _, a := range d.Acknowledged {
+			r.Times = append(r.Times, a.Time.In(c.Location()).Format("3:04:05 PM"))
+		}
+		rows = append(rows, r)
+	}
+
+	b := &bytes.Buffer{}
+	if err := deliveriesTemplate.Execute(b, rows); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write(b.Bytes())
+}
+
+const deliveriesHTML = `<html>
+<head>
+	<title>Manifest - Message Delivery</title>
+	<meta http-equiv="refresh" content="5">
+</head>
+<body>
+	<h3>Message Delivery</h3>
+	<hr>
+{{- range .}}
+	<fieldset>
+		<legend>{{if eq .Kind "takeover"}}Takeover{{else}}Alert {{.ID}}{{end}} posted {{.Posted}}</legend>
+		<p>{{.Message}}</p>
+		<ul>
+{{- $times := .Times}}
+{{- range $i, $a := .Acknowledged}}
+			<li>{{$a.Display}} ({{$a.Channel}}): shown at {{index $times $i}}</li>
+{{- end}}
+{{- range .Pending}}
+			<li><b>{{.}}: not acknowledged</b></li>
+{{- end}}
+		</ul>
+	</fieldset>
+{{- else}}
+	<p>No takeover or critical alerts are being shown.</p>
+{{- end}}
+</body>
+</html>
+`
@@ -0,0 +1,188 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+type AlertPriority int
+
+const (
+	AlertInfo AlertPriority = iota
+	AlertWarning
+	AlertCritical
+)
+
+// Channels on which alerts are shown. Each channel's settings control
+// which alert classes and priorities it shows.
+const (
+	DisplayAlertChannel = "display"
+)
+
+// Alert classes used by the server itself.
+const (
+	MessageAlertClass      = "message" // the operator's message
+	WeatherAlertClass      = "weather"
+	HealthAlertClass       = "health"
+	AnnouncementAlertClass = "announcement"
+)
+
+func (p AlertPriority) String() string {
+	switch p {
+	case AlertWarning:
+		return "warning"
+	case AlertCritical:
+		return "critical"
+	}
+	return "info"
+}
+
+func ParseAlertPriority(s string) (AlertPriority, bool) {
+	switch strings.ToLower(s) {
+	case "info":
+		return AlertInfo, true
+	case "warning":
+		return AlertWarning, true
+	case "critical":
+		return AlertCritical, true
+	}
+	return AlertInfo, false
+}
+
+// Color returns the color used to display an alert of the priority.
+func (p AlertPriority) Color() uint32 {
+	switch p {
+	case AlertWarning:
+		return 0xffff00 // yellow
+	case AlertCritical:
+		return 0xff0000 // red
+	}
+	return 0xffffff // white
+}
+
+type Alert struct {
+	ID       string
+	Class    string
+	Priority AlertPriority
+	Message  string
+	Posted   time.Time
+	Expires  time.Time // zero if the alert does not expire
+}
+
+func (a Alert) expired(now time.Time) bool {
+	return !a.Expires.IsZero() && !now.Before(a.Expires)
+}
+
+// PostAlert adds an alert, replacing any existing alert with the same ID.
+func (c *Controller) PostAlert(a Alert) {
+	now := c.clock.Now()
+	if a.Posted.IsZero() {
+		a.Posted = now
+	}
+	a.Class = strings.ToLower(a.Class)
+
+	c.mutex.Lock()
+	c.alerts[a.ID] = a
+	c.mutex.Unlock()
+
+	if !a.Expires.IsZero() {
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			select {
+			case <-c.Done():
+			case <-c.clock.After(a.Expires.Sub(now)):
+				c.expireAlerts()
+			}
+		}()
+	}
+	c.WakeListeners(AlertsDataSource)
+	c.notify(Notification{
+		Type:     "alert." + a.Class,
+		Priority: a.Priority,
+		Message:  a.Message,
+		Time:     a.Posted,
+	})
+}
+
+// ClearAlert removes the alert with the given ID.
+func (c *Controller) ClearAlert(id string) {
+	c.mutex.Lock()
+	_, ok := c.alerts[id]
+	delete(c.alerts, id)
+	c.mutex.Unlock()
+
+	if ok {
+		c.WakeListeners(AlertsDataSource)
+	}
+}
+
+func (c *Controller) expireAlerts() {
+	now := c.clock.Now()
+	expired := false
+	c.mutex.Lock()
+	for id, a := range c.alerts {
+		if a.expired(now) {
+			delete(c.alerts, id)
+			expired = true
+		}
+	}
+	c.mutex.Unlock()
+
+	if expired {
+		c.WakeListeners(AlertsDataSource)
+	}
+}
+
+// Alerts returns the active alerts shown on the named channel, ordered from
+// highest to lowest priority and most recently posted first. The operator's
+// message is included as an informational alert.
+func (c *Controller) Alerts(channel string) []Alert {
+	minPriority, _ := ParseAlertPriority(c.settings.AlertMinimumPriority(channel))
+	classes := make(map[string]bool)
+	for _, class := range c.settings.AlertClasses(channel) {
+		classes[class] = true
+	}
+
+	now := c.clock.Now()
+	var alerts []Alert
+	c.mutex.Lock()
+	for _, a := range c.alerts {
+		if !a.expired(now) {
+			alerts = append(alerts, a)
+		}
+	}
+	c.mutex.Unlock()
+	if message := c.settings.Message(); message != "" {
+		alerts = append(alerts, Alert{
+			ID:       MessageAlertClass,
+			Class:    MessageAlertClass,
+			Priority: AlertInfo,
+			Message:  message,
+		})
+	}
+
+	filtered := alerts[:0]
+	for _, a := range alerts {
+		if a.Priority < minPriority {
+			continue
+		}
+		if len(classes) > 0 && !classes[a.Class] {
+			continue
+		}
+		filtered = append(filtered, a)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Priority != filtered[j].Priority {
+			return filtered[i].Priority > filtered[j].Priority
+		}
+		if !filtered[i].Posted.Equal(filtered[j].Posted) {
+			return filtered[i].Posted.After(filtered[j].Posted)
+		}
+		return filtered[i].ID < filtered[j].ID
+	})
+	return filtered
+}
@@ -0,0 +1,73 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"time"
+)
+
+// Build information, set when building with e.g.
+// -ldflags "-X github.com/jumptown-skydiving/manifest-server/pkg/core.Version=1.2.3".
+// The commit and build date fall back to the VCS information recorded by
+// the Go toolchain.
+var (
+	Version   = "dev"
+	Commit    = ""
+	BuildDate = ""
+)
+
+// BuildInfo identifies the running build and how long it has been running.
+type BuildInfo struct {
+	Version   string        `json:"version"`
+	Commit    string        `json:"commit,omitempty"`
+	BuildDate string        `json:"build_date,omitempty"`
+	GoVersion string        `json:"go_version"`
+	StartedAt time.Time     `json:"started_at"`
+	Uptime    time.Duration `json:"-"`
+}
+
+// BuildInfo returns the build information and uptime of the server.
+func (c *Controller) BuildInfo() BuildInfo {
+	b := BuildInfo{
+		Version:   Version,
+		Commit:    Commit,
+		BuildDate: BuildDate,
+		GoVersion: runtime.Version(),
+		StartedAt: c.started,
+		Uptime:    c.clock.Now().Sub(c.started),
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range info.Settings {
+			switch {
+			case s.Key == "vcs.revision" && b.Commit == "":
+				b.Commit = s.Value
+			case s.Key == "vcs.time" && b.BuildDate == "":
+				b.BuildDate = s.Value
+			}
+		}
+	}
+	return b
+}
+
+// VersionHandler serves the build information and uptime as JSON.
+func (c *Controller) VersionHandler(w http.ResponseWriter, req *http.Request) {
+	b := c.BuildInfo()
+	data, err := json.MarshalIndent(struct {
+		BuildInfo
+		UptimeSeconds int64 `json:"uptime_seconds"`
+	}{
+		BuildInfo:     b,
+		UptimeSeconds: int64(b.Uptime / time.Second),
+	}, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,66 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/metar"
+)
+
+// CanopyAdvisory returns an advisory for canopy traffic when the winds at
+// pattern altitude differ drastically in direction from the surface winds,
+// meaning that jumpers setting up their patterns will find themselves
+// downwind. It returns an empty string when there's nothing to advise.
+func (c *Controller) CanopyAdvisory() string {
+	threshold := c.settings.CanopyAdvisoryDirection()
+	if threshold <= 0 || c.METARSource() == nil || c.WindsAloftSource() == nil {
+		return ""
+	}
+	minSpeed := float64(c.settings.CanopyAdvisoryMinimumSpeed())
+
+	m := c.METARSource()
+	surfaceSpeed := m.WindSpeedKnots()
+	surfaceDirection := m.WindDirectionTrueDegrees()
+	// Variable winds are reported without a direction
+	if surfaceSpeed < minSpeed || surfaceDirection == 0 {
+		return ""
+	}
+
+	sample, ok := c.WindsAloftSource().SampleAt(c.settings.CanopyAdvisoryAltitude())
+	if !ok || sample.LightAndVariable || float64(sample.Speed) < minSpeed {
+		return ""
+	}
+	delta := math.Abs(math.Mod(float64(sample.Heading)-surfaceDirection+540, 360) - 180)
+	if delta < float64(threshold) {
+		return ""
+	}
+	return c.settings.CanopyAdvisoryMessage()
+}
+
+// CanopyWind returns the average wind from opening altitude to the ground as
+// the direction in degrees true it blows from and its speed in knots. ok is
+// false if there are no winds aloft.
+func (c *Controller) CanopyWind() (heading, speed float64, ok bool) {
+	if c.WindsAloftSource() == nil {
+		return 0, 0, false
+	}
+	return c.WindsAloftSource().CanopyWind()
+}
+
+// CanopyWindsMessage describes the average wind under canopy, e.g. "Canopy
+// winds 8 MPH from 250° (WSW)", or returns an empty string if it is unknown.
+func (c *Controller) CanopyWindsMessage() string {
+	heading, speed, ok := c.CanopyWind()
+	if !ok {
+		return ""
+	}
+	if math.Round(speed) <= 0 {
+		return "Canopy winds light and variable"
+	}
+	direction := c.DisplayHeading(int(math.Round(heading)) % 360)
+	return fmt.Sprintf("Canopy winds %s from %d° (%s)",
+		metar.FormatSpeed(math.Round(speed), c.settings.Units()),
+		direction, metar.CardinalDirection(float64(direction)))
+}
@@ -0,0 +1,72 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import "time"
+
+// trackChurn records the jumpers added to and removed from loads that are
+// within the churn call window. Jumpers on newly created loads don't count.
+func (c *Controller) trackChurn(events []Event, loads map[int64]eventLoad, now time.Time) {
+	callMinutes := int64(c.settings.ChurnCallMinutes())
+	window := c.settings.ChurnWindow()
+
+	created := make(map[int64]bool)
+	for _, e := range events {
+		if e.Type == LoadCreatedEvent {
+			created[e.LoadID] = true
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.churn == nil {
+		c.churn = make(map[int64][]time.Time)
+	}
+	for _, e := range events {
+		if e.Type != JumperAddedEvent && e.Type != JumperRemovedEvent {
+			continue
+		}
+		if created[e.LoadID] || e.CallMinutes > callMinutes {
+			continue
+		}
+		c.churn[e.LoadID] = append(c.churn[e.LoadID], now)
+	}
+	for id, changes := range c.churn {
+		if _, ok := loads[id]; !ok {
+			delete(c.churn, id)
+			continue
+		}
+		for len(changes) > 0 && now.Sub(changes[0]) >= window {
+			changes = changes[1:]
+		}
+		if len(changes) == 0 {
+			delete(c.churn, id)
+		} else {
+			c.churn[id] = changes
+		}
+	}
+}
+
+// IsLoadVolatile returns true if a load's jumpers are changing rapidly
+// close to its call time, e.g. people bumping on and off during the 15
+// minute call. Notifications about the load should wait until it settles.
+func (c *Controller) IsLoadVolatile(id int64) bool {
+	threshold := c.settings.ChurnThreshold()
+	if threshold <= 0 {
+		return false
+	}
+	window := c.settings.ChurnWindow()
+	now := c.clock.Now()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	count := 0
+	for _, t := range c.churn[id] {
+		if now.Sub(t) < window {
+			count++
+		}
+	}
+	return count >= threshold
+}
@@ -0,0 +1,70 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"math"
+	"time"
+)
+
+// Conditions is a compact snapshot of the current weather that is included
+// with notifications, so that whatever receives them doesn't need to ask for
+// the conditions separately. Values that aren't known are omitted.
+type Conditions struct {
+	Station           string     `json:"station,omitempty"`
+	ObservedAt        *time.Time `json:"observed_at,omitempty"`
+	WindDirection     *int       `json:"wind_direction,omitempty"` // degrees true
+	WindSpeed         *int       `json:"wind_speed,omitempty"`     // MPH
+	WindGust          *int       `json:"wind_gust,omitempty"`      // MPH
+	Winds             string     `json:"winds,omitempty"`
+	FlightCategory    string     `json:"flight_category,omitempty"`
+	VisibilityMiles   *float64   `json:"visibility_sm,omitempty"`
+	TemperatureC      *float64   `json:"temperature_c,omitempty"`
+	AltimeterInHg     *float64   `json:"altimeter_in_hg,omitempty"`
+	Stale             bool       `json:"stale,omitempty"`
+	Degraded          bool       `json:"degraded,omitempty"` // the last good report; the weather service is unreachable
+	Simulated         bool       `json:"simulated,omitempty"`
+	WeatherHold       bool       `json:"weather_hold,omitempty"`
+	UpperWindsSpeed   *int       `json:"upper_winds_speed,omitempty"`   // knots at the next aircraft's exit altitude
+	UpperWindsHeading *int       `json:"upper_winds_heading,omitempty"` // degrees true at the same altitude
+}
+
+// Conditions returns a snapshot of the current weather.
+func (c *Controller) Conditions() Conditions {
+	cond := Conditions{
+		WeatherHold: c.settings.WeatherHold(),
+	}
+	if m := c.METARSource(); m != nil {
+		cond.Station = m.Station()
+		if t, ok := m.ObservationTime(); ok {
+			cond.ObservedAt = &t
+		}
+		if cond.ObservedAt != nil || m.IsSimulated() {
+			direction := int(m.WindDirectionTrueDegrees())
+			speed := int(math.Round(m.WindSpeedMPH()))
+			cond.WindDirection, cond.WindSpeed = &direction, &speed
+			if gust := int(math.Round(m.WindGustSpeedMPH())); gust > 0 {
+				cond.WindGust = &gust
+			}
+			cond.Winds = m.WindConditions()
+		}
+		cond.FlightCategory = string(m.FlightCategory())
+		if v, ok := m.VisibilityStatuteMiles(); ok {
+			cond.VisibilityMiles = &v
+		}
+		if v, ok := m.TemperatureCelsius(); ok {
+			cond.TemperatureC = &v
+		}
+		if v, ok := m.AltimeterInHg(); ok {
+			cond.AltimeterInHg = &v
+		}
+		cond.Stale = c.WeatherStale()
+		cond.Degraded = m.Degraded()
+		cond.Simulated = m.IsSimulated()
+	}
+	if s, ok := c.exitSample(c.NextAircraft()); ok {
+		speed, heading := s.Speed, s.Heading
+		cond.UpperWindsSpeed, cond.UpperWindsHeading = &speed, &heading
+	}
+	return cond
+}
@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"math"
 	"net/http"
 	"os"
 	"reflect"
@@ -28,15 +27,25 @@ import (
 type DataSource uint64
 
 const (
-	BurbleDataSource     DataSource = 1 << 0
-	JumprunDataSource               = 1 << 1
-	METARDataSource                 = 1 << 2
-	WindsAloftDataSource            = 1 << 3
-	OptionsDataSource               = 1 << 4
-	PreSunriseDataSource            = 1 << 5 // Fires once per minute for an hour prior to sunrise
-	SunriseDataSource               = 1 << 6
-	PreSunsetDataSource             = 1 << 7 // Fires once per minute for an hour prior to sunset
-	SunsetDataSource                = 1 << 8
+	BurbleDataSource         DataSource = 1 << 0
+	JumprunDataSource                   = 1 << 1
+	METARDataSource                     = 1 << 2
+	WindsAloftDataSource                = 1 << 3
+	OptionsDataSource                   = 1 << 4
+	PreSunriseDataSource                = 1 << 5 // Fires once per minute for an hour prior to sunrise
+	SunriseDataSource                   = 1 << 6
+	PreSunsetDataSource                 = 1 << 7 // Fires once per minute for an hour prior to sunset
+	SunsetDataSource                    = 1 << 8
+	OperatingHoursDataSource            = 1 << 9 // Fires when the DZ opens or closes
+	AlertsDataSource                    = 1 << 10
+	TakeoverDataSource                  = 1 << 11
+	ExitOrderDataSource                 = 1 << 12
+)
+
+const (
+	burbleSourceName     = "Burble"
+	metarSourceName      = "METAR"
+	windsAloftSourceName = "Winds Aloft"
 )
 
 type Controller struct {
@@ -47,22 +56,74 @@ type Controller struct {
 	burbleSource     *burble.Controller
 	jumprun          *jumprun.Controller
 	metarSource      *metar.Controller
+	metarStale       staleWatch
 	windsAloftSource *winds.Controller
+	windsAloftStale  staleWatch
 
 	siwa *siwa.Manager
 
+	clock     Clock
+	scheduler *Scheduler
+
 	settings   *settings.Settings
 	listeners  map[int]chan DataSource
 	listenerID int
+	health     map[string]SourceHealth
+	alerts     map[string]Alert
+	takeover   *Takeover
 	done       chan struct{}
 	wg         sync.WaitGroup
+
+	eventListeners map[int]chan struct{}
+	eventLoads     map[int64]eventLoad   // the last snapshot seen for events
+	churn          map[int64][]time.Time // recent jumper changes by load
+	firstLoadDay   string                // the day the first load call was announced
+	hold           weatherHold
+	latency        map[string]LatencySummary // by pipeline stage
+	notifiers      map[string]Notifier       // by channel
+	exitOrders     map[int64][]string        // accepted exit orders by load
+	recurringLock  sync.Mutex                // serializes recurring message updates
+	started        time.Time
+	launched       []string // names of the data sources launched
+
+	// publicStatus caches the JSON served by PublicStatusHandler
+	publicStatus     []byte
+	publicStatusTime time.Time
+}
+
+// Fetchers replace the network requests made by the data sources so that
+// tests can feed the update pipeline canned data. Nil fields use the network.
+type Fetchers struct {
+	Burble     burble.Fetcher
+	METAR      metar.Fetcher
+	WindsAloft winds.Fetcher
+
+	// HTTPClient makes the network requests of the data sources that aren't
+	// replaced. Nil uses a client with the configured fetch timeout.
+	HTTPClient *http.Client
 }
 
 func NewController(settings *settings.Settings) (*Controller, error) {
+	return NewControllerWithClock(settings, SystemClock)
+}
+
+func NewControllerWithClock(settings *settings.Settings, clock Clock) (*Controller, error) {
+	return NewControllerWithFetchers(settings, clock, Fetchers{})
+}
+
+func NewControllerWithFetchers(
+	settings *settings.Settings,
+	clock Clock,
+	fetchers Fetchers,
+) (*Controller, error) {
 	c := &Controller{
-		settings:  settings,
-		listeners: make(map[int]chan DataSource),
-		done:      make(chan struct{}),
+		clock:          clock,
+		settings:       settings,
+		listeners:      make(map[int]chan DataSource),
+		eventListeners: make(map[int]chan struct{}),
+		health:         make(map[string]SourceHealth),
+		alerts:         make(map[string]Alert),
+		done:           make(chan struct{}),
 	}
 
 	var err error
@@ -78,41 +139,95 @@ func NewController(settings *settings.Settings) (*Controller, error) {
 	if err != nil {
 		return nil, fmt.Errorf("Failed to initialize database: %w", err)
 	}
+	if err = c.restoreExitOrders(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error restoring exit orders: %v\n", err)
+	}
 
 	loc, err := settings.Location()
 	if err != nil {
 		return nil, fmt.Errorf("Invalid timezone: %w", err)
 	}
 	c.location = loc
-
-	c.burbleSource = burble.NewController(c.settings)
+	c.scheduler = NewScheduler(c.clock, c.settings, c.location, c.done)
+	c.started = c.clock.Now()
+
+	demo := c.settings.DemoMode()
+
+	client := fetchers.HTTPClient
+	if client == nil {
+		client = c.settings.NewHTTPClient()
+	}
+	c.registerWebhooks(client)
+	c.burbleSource = burble.NewControllerWithFetcher(c.settings,
+		fetchers.Burble, client, c.clock.Now)
+	burbleRefresh := c.burbleSource.Refresh
+	if demo {
+		burbleRefresh = func() (bool, error) {
+			return c.burbleSource.RefreshDemo(c.clock.Now())
+		}
+	}
 	c.launchDataSource(
-		func() time.Time { return time.Now().Add(10 * time.Second) },
-		"Burble",
-		c.burbleSource.Refresh,
-		func() { c.WakeListeners(BurbleDataSource) })
+		"burble",
+		burbleSourceName,
+		burbleRefresh,
+		func() {
+			c.recordEvents()
+			c.WakeListeners(BurbleDataSource)
+		})
 
 	if c.settings.METAREnabled() {
-		c.metarSource = metar.NewController(c.settings)
-		c.launchDataSource(
-			func() time.Time { return time.Now().Add(5 * time.Minute) },
-			"METAR",
-			c.metarSource.Refresh,
-			func() { c.WakeListeners(METARDataSource) })
+		c.metarSource = metar.NewControllerWithFetcher(c.settings,
+			fetchers.METAR, client, c.clock.Now)
+		if !demo {
+			c.metarStale = c.watchStale(METARDataSource,
+				func() (time.Time, bool) {
+					return c.metarSource.StaleTime(c.settings.METARStaleAfter())
+				})
+			c.launchDataSource(
+				"metar",
+				metarSourceName,
+				c.refreshMETAR,
+				func() {
+					c.archiveMETAR()
+					c.WakeListeners(METARDataSource)
+				})
+		}
 	}
 
 	if c.settings.WindsEnabled() {
-		c.windsAloftSource = winds.NewController(c.settings)
-		c.launchDataSource(
-			func() time.Time { return time.Now().Add(15 * time.Minute) },
-			"Winds Aloft",
-			c.windsAloftSource.Refresh,
-			func() { c.WakeListeners(WindsAloftDataSource) })
+		c.windsAloftSource = winds.NewControllerWithFetcher(c.settings,
+			fetchers.WindsAloft, client, c.clock.Now)
+		if !demo {
+			c.windsAloftStale = c.watchStale(WindsAloftDataSource,
+				func() (time.Time, bool) {
+					return c.windsAloftSource.StaleTime(c.settings.WindsStaleAfter())
+				})
+			c.launchDataSource(
+				"winds",
+				windsAloftSourceName,
+				c.refreshWindsAloft,
+				func() {
+					c.WakeListeners(WindsAloftDataSource)
+					c.updateShearAlert()
+				})
+		}
 	}
 
 	if c.settings.JumprunEnabled() {
 		c.jumprun = jumprun.NewController(c.settings,
-			func() { c.WakeListeners(JumprunDataSource) })
+			func() { c.WakeListeners(JumprunDataSource) },
+			c.SuggestJumprun)
+	}
+
+	if c.settings.SharedState() {
+		c.startSharedState()
+	}
+
+	c.startWeatherHold()
+	c.startRecurringMessages()
+
+	if demo {
+		c.startDemo()
 	}
 
 	c.wg.Add(1)
@@ -121,6 +236,12 @@ func NewController(settings *settings.Settings) (*Controller, error) {
 		c.runAtSunriseSunset()
 	}()
 
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.runAtOpenClose()
+	}()
+
 	return c, nil
 }
 
@@ -131,6 +252,7 @@ func (c *Controller) Done() <-chan struct{} {
 func (c *Controller) Close() {
 	close(c.done)
 	c.wg.Wait()
+	c.scheduler.Wait()
 	c.db.Close()
 }
 
@@ -162,8 +284,16 @@ func (c *Controller) SignInWithAppleManager() *siwa.Manager {
 	return c.siwa
 }
 
+func (c *Controller) Clock() Clock {
+	return c.clock
+}
+
+func (c *Controller) Scheduler() *Scheduler {
+	return c.scheduler
+}
+
 func (c *Controller) CurrentTime() time.Time {
-	return time.Now().In(c.Location())
+	return c.clock.Now().In(c.Location())
 }
 
 func (c *Controller) NewRequestWithContext(
@@ -225,47 +355,52 @@ func (c *Controller) AppleEventHandler(w http.ResponseWriter, req *http.Request)
 	_, _ = w.Write([]byte{'\n'})
 }
 
-func (c *Controller) SeparationDelay(speed int) int {
-	msec := (1852.0 * float64(speed)) / 3600.0
-	ftsec := msec / 0.3048
-	return int(math.Ceil(1000.0 / ftsec))
+// MagneticHeading converts a heading relative to true north into a heading
+// relative to magnetic north.
+func (c *Controller) MagneticHeading(trueHeading int) int {
+	return ((trueHeading-c.settings.MagneticDeclination())%360 + 360) % 360
+}
+
+// DisplayHeading converts a heading relative to true north into a heading
+// relative to the configured heading reference.
+func (c *Controller) DisplayHeading(trueHeading int) int {
+	if c.settings.MagneticHeadings() {
+		return c.MagneticHeading(trueHeading)
+	}
+	return trueHeading
 }
 
+// SeparationStrings returns the separation line for the aircraft flying the
+// next departing load.
 func (c *Controller) SeparationStrings() (uint32, string) {
-	windsAloftSource := c.WindsAloftSource()
+	return c.separationStrings(c.NextAircraft())
+}
 
+// separationStrings returns the color and text of the separation line for
+// the named aircraft.
+func (c *Controller) separationStrings(aircraft string) (uint32, string) {
 	color := uint32(0xffffff)
-	if windsAloftSource == nil {
-		return color, ""
-	}
 
-	// We're only interested in 13000 feet
-	samples := windsAloftSource.Samples()
-	if len(samples) < 14 {
+	// We're only interested in the exit altitude
+	sample, ok := c.exitSample(aircraft)
+	if !ok {
 		return color, ""
 	}
-	sample := samples[13]
 
-	var (
-		str, t string
-		speed  int
-	)
-	if sample.LightAndVariable {
-		speed = 85
-	} else {
-		speed = 85 - sample.Speed
-	}
-	if speed <= 0 {
-		color = 0xff0000
-		str = fmt.Sprintf("Winds are %d knots",
-			sample.Speed)
+	// High uppers replace the separation with the advisory when jump run
+	// is red-lined, and only color it otherwise.
+	var str, t string
+	color, advisory := c.highUppers(aircraft)
+	if color == 0xff0000 {
+		str = advisory
 	} else {
+		r, _ := c.Spot(aircraft)
 		str = fmt.Sprintf("Separation is %d seconds",
-			c.SeparationDelay(speed))
+			r.Separation)
 	}
 
-	t = fmt.Sprintf("(%d℃ / %d℉)", sample.Temperature,
-		int64(metar.FahrenheitFromCelsius(float64(sample.Temperature))))
+	t = "(" + metar.FormatTemperature(int64(sample.Temperature),
+		int64(sample.TemperatureFahrenheit()), c.settings.Units()) + ")"
 
 	if str != "" && t != "" {
 		return color, fmt.Sprintf("%s %s", str, t)
@@ -280,36 +415,73 @@ func (c *Controller) SeparationStrings() (uint32, string) {
 	return color, ""
 }
 
+// NextAircraft returns the name of the aircraft flying the next load to
+// depart, or an empty string if no load is waiting to depart.
+func (c *Controller) NextAircraft() string {
+	for _, l := range c.BurbleSource().Loads() {
+		if l.State < burble.Airborne {
+			return l.AircraftName
+		}
+	}
+	return ""
+}
+
+// AircraftSeparation is the separation line for one aircraft.
+type AircraftSeparation struct {
+	Aircraft     string
+	Color        uint32
+	Separation   string
+	Seconds      int     // 0 if jump run can't make progress
+	GroundSpeed  float64 // knots on jump run at exit altitude
+	ExitAltitude int     // feet AGL
+}
+
+// AircraftSeparations returns the separation line for each aircraft with a
+// load waiting to depart, in the order that their next loads depart.
+func (c *Controller) AircraftSeparations() []AircraftSeparation {
+	var separations []AircraftSeparation
+	seen := make(map[string]bool)
+	for _, l := range c.BurbleSource().Loads() {
+		if l.State >= burble.Airborne || seen[l.AircraftName] {
+			continue
+		}
+		seen[l.AircraftName] = true
+		color, separation := c.separationStrings(l.AircraftName)
+		r, _ := c.Spot(l.AircraftName)
+		separations = append(separations, AircraftSeparation{
+			Aircraft:     l.AircraftName,
+			Color:        color,
+			Separation:   separation,
+			Seconds:      r.Separation,
+			GroundSpeed:  r.GroundSpeed,
+			ExitAltitude: c.settings.AircraftExitAltitude(l.AircraftName),
+		})
+	}
+	return separations
+}
+
 func (c *Controller) launchDataSource(
-	nextRefresh func() time.Time,
+	configName string,
 	sourceName string,
 	refresh func() (bool, error),
 	update func(),
 ) {
-	c.wg.Add(1)
-	go func() {
-		defer c.wg.Done()
-		for {
-			if changed, err := refresh(); err != nil {
-				fmt.Fprintf(os.Stderr, "Error refreshing %s: %v\n", sourceName, err)
-			} else if changed {
-				update()
-			}
-
-			nextTime := nextRefresh()
-			refreshPeriod := time.Until(nextTime)
-			t := time.NewTicker(refreshPeriod)
-
-			select {
-			case <-c.Done():
-				t.Stop()
-				return
-			case <-t.C:
-				t.Stop()
-				break
-			}
-		}
-	}()
+	c.mutex.Lock()
+	c.launched = append(c.launched, sourceName)
+	c.mutex.Unlock()
+
+	c.scheduler.Launch(
+		sourceName,
+		func() settings.RefreshPolicy { return c.settings.RefreshPolicy(configName) },
+		refresh,
+		func() {
+			c.recordChange(sourceName)
+			update()
+		},
+		func(sourceName string, err error) {
+			c.recordRefresh(sourceName, err)
+			c.updateDegradedAlert()
+		})
 }
 
 func (c *Controller) Coordinates() (latitude float64, longitude float64, err error) {
@@ -446,6 +618,9 @@ func (c *Controller) sunrise() {
 			}
 		}
 	}
+	if c.METARSource() != nil {
+		c.METARSource().ResetPeakGust()
+	}
 	c.WakeListeners(SunriseDataSource)
 }
 
@@ -457,7 +632,6 @@ func (c *Controller) runAtSunriseSunset() {
 	lastPre := []int{-1, -1}
 	lastSunrise := []int{0, 0, 0}
 	lastSunset := []int{0, 0, 0}
-	t := time.NewTicker(1 * time.Second)
 	for {
 		sunrise, sunset, err := c.SunriseAndSunsetTimes()
 		if err != nil {
@@ -497,9 +671,8 @@ func (c *Controller) runAtSunriseSunset() {
 
 		select {
 		case <-c.Done():
-			t.Stop()
 			return
-		case <-t.C:
+		case <-c.clock.After(1 * time.Second):
 		}
 	}
 }
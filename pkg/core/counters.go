@@ -0,0 +1,118 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// CounterValue is the current value of a configured counter.
+type CounterValue struct {
+	Name  string
+	Label string
+	Value int
+}
+
+// tallyDepartures adds the jumpers on departing loads to the day's count of
+// departed jumpers by jump type. The counts are kept in the database so that
+// they survive a restart, and start over each day.
+func (c *Controller) tallyDepartures(events []Event, last map[int64]eventLoad, now time.Time) {
+	departed := make(map[string]int)
+	for _, e := range events {
+		if e.Type != LoadDepartedEvent {
+			continue
+		}
+		if l, ok := last[e.LoadID]; ok {
+			for _, j := range l.jumpers {
+				departed[j.JumpType]++
+			}
+		}
+	}
+	if len(departed) == 0 {
+		return
+	}
+
+	day := now.In(c.location).Format("2006-01-02")
+	tx, err := c.db.Begin()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error recording departures: %v\n", err)
+		return
+	}
+	if err = c.db.DeleteDepartures(tx, day); err == nil {
+		err = c.db.AddDepartures(tx, day, departed)
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		fmt.Fprintf(os.Stderr, "Error recording departures: %v\n", err)
+		return
+	}
+	if err = tx.Commit(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error recording departures: %v\n", err)
+	}
+}
+
+// departures returns the number of jumpers by jump type who departed today.
+func (c *Controller) departures() map[string]int {
+	day := c.clock.Now().In(c.location).Format("2006-01-02")
+	tx, err := c.db.Begin()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error querying departures: %v\n", err)
+		return nil
+	}
+	departed, err := c.db.QueryDepartures(tx, day)
+	_ = tx.Commit()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error querying departures: %v\n", err)
+		return nil
+	}
+	return departed
+}
+
+// Counters returns the current value of each configured counter. Jumpers
+// are counted on every load Burble returns, not just those displayed.
+func (c *Controller) Counters() []CounterValue {
+	counters := c.settings.Counters()
+	if len(counters) == 0 {
+		return nil
+	}
+
+	manifested := make(map[string]int)
+	for _, l := range c.burbleSource.AllLoads() {
+		if l.State >= burble.Airborne {
+			continue
+		}
+		l.ForEachJumper(func(j *burble.Jumper) {
+			manifested[j.JumpType]++
+		})
+	}
+
+	departed := c.departures()
+	values := make([]CounterValue, 0, len(counters))
+	for _, counter := range counters {
+		v := CounterValue{
+			Name:  counter.Name,
+			Label: counter.Label,
+		}
+		if counter.Count != settings.CountDeparted {
+			for jumpType, n := range manifested {
+				if counter.Matches(jumpType) {
+					v.Value += n
+				}
+			}
+		}
+		if counter.Count != settings.CountManifested {
+			for jumpType, n := range departed {
+				if counter.Matches(jumpType) {
+					v.Value += n
+				}
+			}
+		}
+		values = append(values, v)
+	}
+	return values
+}
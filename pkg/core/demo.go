@@ -0,0 +1,68 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/metar"
+	"github.com/jumptown-skydiving/manifest-server/pkg/winds"
+)
+
+func demoFloat(f float64) *float64 {
+	return &f
+}
+
+var demoWeather = metar.Simulation{
+	WindSpeed:     demoFloat(8),
+	WindGust:      demoFloat(14),
+	WindDirection: demoFloat(240),
+	Temperature:   demoFloat(22),
+	SkyCover:      "Few clouds at 6500 ft",
+	Weather:       "Clear",
+}
+
+var demoWindsAloft = []winds.Sample{
+	{Altitude: 0, Heading: 240, Speed: 8, Temperature: 22},
+	{Altitude: 1000, Heading: 245, Speed: 10, Temperature: 20},
+	{Altitude: 2000, Heading: 250, Speed: 12, Temperature: 18},
+	{Altitude: 3000, Heading: 255, Speed: 14, Temperature: 16},
+	{Altitude: 4000, Heading: 260, Speed: 15, Temperature: 14},
+	{Altitude: 5000, Heading: 260, Speed: 17, Temperature: 12},
+	{Altitude: 6000, Heading: 265, Speed: 18, Temperature: 10},
+	{Altitude: 7000, Heading: 265, Speed: 20, Temperature: 8},
+	{Altitude: 8000, Heading: 270, Speed: 22, Temperature: 6},
+	{Altitude: 9000, Heading: 270, Speed: 24, Temperature: 4},
+	{Altitude: 10000, Heading: 275, Speed: 26, Temperature: 2},
+	{Altitude: 11000, Heading: 275, Speed: 28, Temperature: 0},
+	{Altitude: 12000, Heading: 280, Speed: 30, Temperature: -2},
+	{Altitude: 13000, Heading: 280, Speed: 32, Temperature: -4},
+	{Altitude: 14000, Heading: 285, Speed: 34, Temperature: -6},
+}
+
+var demoJumprun = url.Values{
+	"main_heading":    []string{"260"},
+	"exit_distance":   []string{"5"},
+	"offset_heading":  []string{"350"},
+	"offset_distance": []string{"2"},
+	"camera_height":   []string{"22000"},
+}
+
+// startDemo loads canned weather and a sample jumprun in place of live
+// data sources.
+func (c *Controller) startDemo() {
+	c.SimulateWeather(&demoWeather, demoWindsAloft)
+	if c.Jumprun() != nil {
+		values := url.Values{
+			"magnetic_declination": []string{fmt.Sprint(c.settings.MagneticDeclination())},
+		}
+		for k, v := range demoJumprun {
+			values[k] = v
+		}
+		if err := c.Jumprun().SetFromURLValues(values); err != nil {
+			fmt.Fprintf(os.Stderr, "cannot set demo jumprun: %v\n", err)
+		}
+	}
+}
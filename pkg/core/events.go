@@ -0,0 +1,286 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+	"github.com/jumptown-skydiving/manifest-server/pkg/db"
+)
+
+// EventType identifies what happened in a manifest event.
+type EventType string
+
+const (
+	LoadCreatedEvent   EventType = "load_created"
+	LoadDepartedEvent  EventType = "load_departed"
+	LoadRemovedEvent   EventType = "load_removed" // removed without departing
+	CallChangedEvent   EventType = "call_changed"
+	HoldStartedEvent   EventType = "hold_started"
+	JumperAddedEvent   EventType = "jumper_added"
+	JumperRemovedEvent EventType = "jumper_removed"
+)
+
+// Event is an entry in the manifest event log. Events are derived by
+// comparing successive Burble snapshots, for integrations that need to know
+// what changed rather than what the manifest looks like now.
+type Event struct {
+	Sequence     int64     `json:"-"`
+	Type         EventType `json:"-"`
+	Time         time.Time `json:"-"`
+	LoadID       int64     `json:"load_id"`
+	AircraftName string    `json:"aircraft_name,omitempty"`
+	LoadNumber   string    `json:"load_number,omitempty"`
+	CallMinutes  int64     `json:"call_minutes"`
+	JumperID     string    `json:"jumper_id,omitempty"` // stable ID
+	JumperName   string    `json:"jumper_name,omitempty"`
+	JumpType     string    `json:"jump_type,omitempty"`
+}
+
+// eventLoad is what's remembered about a load to derive events from the
+// next snapshot.
+type eventLoad struct {
+	load      *burble.Load
+	departure time.Time // estimated from the call minutes
+	jumpers   map[string]*burble.Jumper
+}
+
+func newEventLoad(l *burble.Load, now time.Time) eventLoad {
+	e := eventLoad{
+		load:      l,
+		departure: now.Truncate(time.Minute).Add(time.Duration(l.CallMinutes) * time.Minute),
+		jumpers:   make(map[string]*burble.Jumper),
+	}
+	l.ForEachJumper(func(j *burble.Jumper) {
+		e.jumpers[j.StableID] = j
+	})
+	return e
+}
+
+func loadEvent(t EventType, l *burble.Load) Event {
+	return Event{
+		Type:         t,
+		LoadID:       l.ID,
+		AircraftName: l.AircraftName,
+		LoadNumber:   l.LoadNumber,
+		CallMinutes:  l.CallMinutes,
+	}
+}
+
+func jumperEvent(t EventType, l *burble.Load, j *burble.Jumper) Event {
+	e := loadEvent(t, l)
+	e.JumperID = j.StableID
+	e.JumperName = j.Name
+	e.JumpType = j.JumpType
+	return e
+}
+
+// diffLoads returns the events that account for the differences between two
+// snapshots of the manifest.
+func diffLoads(last map[int64]eventLoad, next map[int64]eventLoad, loads []*burble.Load) []Event {
+	var events []Event
+	for _, l := range loads {
+		n := next[l.ID]
+		p, ok := last[l.ID]
+		if !ok {
+			events = append(events, loadEvent(LoadCreatedEvent, l))
+			for _, j := range orderedJumpers(l) {
+				events = append(events, jumperEvent(JumperAddedEvent, l, j))
+			}
+			continue
+		}
+
+		switch {
+		case l.IsNoTime && !p.load.IsNoTime:
+			events = append(events, loadEvent(HoldStartedEvent, l))
+		case l.IsNoTime:
+		case p.load.IsNoTime:
+			events = append(events, loadEvent(CallChangedEvent, l))
+		default:
+			// Call minutes are whole minutes, so allow a minute of
+			// slop before deciding the call time moved.
+			if d := n.departure.Sub(p.departure); d > time.Minute || d < -time.Minute {
+				events = append(events, loadEvent(CallChangedEvent, l))
+			}
+		}
+		if l.State >= burble.Airborne && p.load.State < burble.Airborne {
+			events = append(events, loadEvent(LoadDepartedEvent, l))
+		}
+
+		for _, j := range orderedJumpers(l) {
+			if _, ok := p.jumpers[j.StableID]; !ok {
+				events = append(events, jumperEvent(JumperAddedEvent, l, j))
+			}
+		}
+		for _, j := range orderedJumpers(p.load) {
+			if _, ok := n.jumpers[j.StableID]; !ok {
+				events = append(events, jumperEvent(JumperRemovedEvent, l, j))
+			}
+		}
+	}
+
+	// Burble drops loads shortly after they depart, so a load that goes
+	// away once it's boarding is assumed to have departed.
+	for _, l := range orderedLoads(last) {
+		if _, ok := next[l.ID]; ok {
+			continue
+		}
+		switch {
+		case l.State >= burble.Airborne:
+		case l.State == burble.Boarding && !l.IsNoTime:
+			events = append(events, loadEvent(LoadDepartedEvent, l))
+		default:
+			events = append(events, loadEvent(LoadRemovedEvent, l))
+		}
+	}
+	return events
+}
+
+func orderedJumpers(l *burble.Load) []*burble.Jumper {
+	var jumpers []*burble.Jumper
+	l.ForEachJumper(func(j *burble.Jumper) {
+		jumpers = append(jumpers, j)
+	})
+	return jumpers
+}
+
+func orderedLoads(m map[int64]eventLoad) []*burble.Load {
+	loads := make([]*burble.Load, 0, len(m))
+	for _, e := range m {
+		loads = append(loads, e.load)
+	}
+	sort.Slice(loads, func(i, j int) bool {
+		return loads[i].ID < loads[j].ID
+	})
+	return loads
+}
+
+// recordEvents derives events from the latest Burble snapshot and appends
+// them to the event log, pruning events older than the configured retention.
+// Every load Burble returns is compared, not just those displayed, so that
+// loads aren't reported as removed when they leave the display. The first
+// snapshot after starting only establishes a baseline, so that restarting
+// the server doesn't replay the manifest.
+func (c *Controller) recordEvents() {
+	now := c.clock.Now()
+	loads := c.burbleSource.AllLoads()
+	next := make(map[int64]eventLoad, len(loads))
+	for _, l := range loads {
+		next[l.ID] = newEventLoad(l, now)
+	}
+
+	c.mutex.Lock()
+	last := c.eventLoads
+	c.eventLoads = next
+	c.mutex.Unlock()
+	if last == nil {
+		return
+	}
+
+	events := diffLoads(last, next, loads)
+	c.trackChurn(events, next, now)
+	c.tallyDepartures(events, last, now)
+	c.announceFirstLoad(last, loads, now)
+	if len(events) == 0 {
+		return
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error recording events: %v\n", err)
+		return
+	}
+	for _, e := range events {
+		data, err := json.Marshal(e)
+		if err != nil {
+			continue
+		}
+		r := db.Event{
+			Type: string(e.Type),
+			Time: now,
+			Data: data,
+		}
+		if err = c.db.RecordEvent(tx, &r); err != nil {
+			_ = tx.Rollback()
+			fmt.Fprintf(os.Stderr, "Error recording events: %v\n", err)
+			return
+		}
+	}
+	if retention := c.settings.EventRetention(); retention > 0 {
+		if err = c.db.DeleteEvents(tx, now.Add(-retention)); err != nil {
+			_ = tx.Rollback()
+			fmt.Fprintf(os.Stderr, "Error pruning events: %v\n", err)
+			return
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error recording events: %v\n", err)
+		return
+	}
+	c.wakeEventListeners()
+	for _, e := range events {
+		c.notifyEvent(e, now)
+	}
+}
+
+// Events returns up to limit events from the event log with sequence
+// numbers greater than after, oldest first.
+func (c *Controller) Events(after int64, limit int) ([]Event, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	records, err := c.db.QueryEvents(tx, after, limit)
+	_ = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]Event, 0, len(records))
+	for _, r := range records {
+		var e Event
+		if err = json.Unmarshal(r.Data, &e); err != nil {
+			return nil, err
+		}
+		e.Sequence = r.Sequence
+		e.Type = EventType(r.Type)
+		e.Time = r.Time
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// AddEventListener registers a channel that is signalled whenever events
+// are appended to the event log. Signals are not queued; listeners should
+// read the log with Events after each one.
+func (c *Controller) AddEventListener(l chan struct{}) int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.listenerID++
+	id := c.listenerID
+	c.eventListeners[id] = l
+	return id
+}
+
+func (c *Controller) RemoveEventListener(id int) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.eventListeners, id)
+}
+
+func (c *Controller) wakeEventListeners() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, l := range c.eventListeners {
+		select {
+		case l <- struct{}{}:
+		default:
+		}
+	}
+}
@@ -0,0 +1,252 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+	"github.com/jumptown-skydiving/manifest-server/pkg/db"
+	"github.com/jumptown-skydiving/manifest-server/pkg/exitorder"
+	"github.com/jumptown-skydiving/manifest-server/pkg/spot"
+)
+
+// errLoadNotOnManifest is returned when accepting the exit order for a load
+// that isn't on the manifest.
+var errLoadNotOnManifest = errors.New("load is not on the manifest")
+
+// exitOrderMinimumSpeed is the lowest ground speed in knots used to compute
+// exit separation, so that strong uppers don't produce absurd separations.
+const exitOrderMinimumSpeed = 10
+
+// exitSeparation returns the seconds needed to cover the group spacing over
+// the ground on jump run at exit altitude in the named aircraft.
+func (c *Controller) exitSeparation(aircraft string) int {
+	p := c.spotParameters(aircraft)
+	p.MinimumGroundSpeed = exitOrderMinimumSpeed
+	r, _ := spot.Calculate(c.windsAloftSamples(), p)
+	return r.Separation
+}
+
+// ExitOrder returns the exit order for a load and whether a load organizer
+// has accepted it. The order is the one accepted for the load if there is
+// one, with any groups added since then following in their suggested order.
+// It returns nil if exit orders aren't enabled.
+func (c *Controller) ExitOrder(l *burble.Load) ([]exitorder.Group, bool) {
+	if !c.settings.ExitOrderEnabled() {
+		return nil, false
+	}
+	var order []exitorder.Discipline
+	for _, d := range c.settings.ExitOrderDisciplines() {
+		order = append(order, exitorder.Discipline(d))
+	}
+	separation, change := c.exitSeparation(l.AircraftName), c.settings.ExitOrderChangeSeconds()
+	plan := exitorder.Plan(l, order, separation, change)
+
+	c.mutex.Lock()
+	ids, accepted := c.exitOrders[l.ID]
+	c.mutex.Unlock()
+	if !accepted {
+		return plan, false
+	}
+	return exitorder.Reorder(plan, ids, separation, change), true
+}
+
+// restoreExitOrders loads the exit orders accepted before the server
+// started from the database.
+func (c *Controller) restoreExitOrders() error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	orders, err := c.db.QueryExitOrders(tx)
+	_ = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.exitOrders = make(map[int64][]string, len(orders))
+	for _, o := range orders {
+		c.exitOrders[o.LoadID] = o.Leaders
+	}
+	return nil
+}
+
+// AcceptExitOrder accepts the exit order for a load, reordered to follow the
+// leader IDs given. If none are given, the suggested order is accepted.
+// Accepted orders are kept in the database so that they survive a restart;
+// those for loads that are no longer on the manifest are discarded.
+func (c *Controller) AcceptExitOrder(loadID int64, ids []string) error {
+	var load *burble.Load
+	for _, l := range c.BurbleSource().Loads() {
+		if l.ID == loadID {
+			load = l
+		}
+	}
+	if load == nil {
+		return fmt.Errorf("load %d: %w", loadID, errLoadNotOnManifest)
+	}
+	if len(ids) == 0 {
+		groups, _ := c.ExitOrder(load)
+		for _, g := range groups {
+			ids = append(ids, g.LeaderID)
+		}
+	}
+
+	present := make(map[int64]bool)
+	for _, l := range c.BurbleSource().AllLoads() {
+		present[l.ID] = true
+	}
+	var gone []int64
+	c.mutex.Lock()
+	for id := range c.exitOrders {
+		if !present[id] {
+			gone = append(gone, id)
+		}
+	}
+	c.mutex.Unlock()
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err = c.db.DeleteExitOrders(tx, gone); err == nil {
+		err = c.db.StoreExitOrder(tx, db.ExitOrder{
+			LoadID:  loadID,
+			Leaders: ids,
+			Time:    c.clock.Now(),
+		})
+	}
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	if c.exitOrders == nil {
+		c.exitOrders = make(map[int64][]string)
+	}
+	for _, id := range gone {
+		delete(c.exitOrders, id)
+	}
+	c.exitOrders[loadID] = ids
+	c.mutex.Unlock()
+
+	c.WakeListeners(ExitOrderDataSource)
+	return nil
+}
+
+// ResetExitOrder discards the exit order accepted for a load, so that the
+// suggested order is published again.
+func (c *Controller) ResetExitOrder(loadID int64) error {
+	c.mutex.Lock()
+	_, ok := c.exitOrders[loadID]
+	c.mutex.Unlock()
+	if !ok {
+		return nil
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err = c.db.DeleteExitOrders(tx, []int64{loadID}); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	c.mutex.Lock()
+	delete(c.exitOrders, loadID)
+	c.mutex.Unlock()
+
+	c.WakeListeners(ExitOrderDataSource)
+	return nil
+}
+
+type exitOrderLoad struct {
+	LoadID     int64             `json:"load_id"`
+	LoadNumber string            `json:"load_number"`
+	Accepted   bool              `json:"accepted"`
+	Groups     []exitorder.Group `json:"groups"`
+}
+
+type exitOrderRequest struct {
+	LoadID int64    `json:"load_id"`
+	Order  []string `json:"order,omitempty"` // leader IDs; empty accepts the suggestion
+	Reset  bool     `json:"reset,omitempty"`
+}
+
+// ExitOrderHandler serves the exit order for each load. Load organizers
+// accept or reorder a load's exit order by posting a JSON object, e.g.
+// {"load_id": 101, "order": ["leader", ...]}, or return to the suggested
+// order with {"load_id": 101, "reset": true}.
+func (c *Controller) ExitOrderHandler(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+	case http.MethodPost:
+		var r exitOrderRequest
+		if err := json.NewDecoder(req.Body).Decode(&r); err != nil {
+			http.Error(w, fmt.Sprintf("invalid JSON: %v", err), http.StatusBadRequest)
+			return
+		}
+		if r.LoadID == 0 {
+			http.Error(w, "missing load_id", http.StatusBadRequest)
+			return
+		}
+		if r.Reset {
+			if err := c.ResetExitOrder(r.LoadID); err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		} else if err := c.AcceptExitOrder(r.LoadID, r.Order); err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, errLoadNotOnManifest) {
+				status = http.StatusNotFound
+			}
+			http.Error(w, err.Error(), status)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, HEAD, POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !c.settings.ExitOrderEnabled() {
+		http.Error(w, "Exit orders are not enabled", http.StatusNotFound)
+		return
+	}
+	loads := []exitOrderLoad{}
+	for _, l := range c.BurbleSource().Loads() {
+		groups, accepted := c.ExitOrder(l)
+		loads = append(loads, exitOrderLoad{
+			LoadID:     l.ID,
+			LoadNumber: l.LoadNumber,
+			Accepted:   accepted,
+			Groups:     groups,
+		})
+	}
+	data, err := json.MarshalIndent(struct {
+		Loads []exitOrderLoad `json:"loads"`
+	}{
+		Loads: loads,
+	}, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,80 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+)
+
+// firstLoadAlertID identifies the announcement of the first load call.
+const firstLoadAlertID = "first-load"
+
+// announceFirstLoad posts an announcement when the first load of the day
+// reaches the configured call time. A load counts when it crosses the call
+// time between snapshots or first appears already within it, and nothing is
+// announced once a load has departed today, so restarting the server later
+// in the day doesn't announce whatever load happens to be next.
+func (c *Controller) announceFirstLoad(last map[int64]eventLoad, loads []*burble.Load, now time.Time) {
+	if !c.settings.FirstLoadCall() {
+		return
+	}
+	local := now.In(c.location)
+	day := local.Format("2006-01-02")
+	c.mutex.Lock()
+	announced := c.firstLoadDay == day
+	c.mutex.Unlock()
+	if announced {
+		return
+	}
+
+	minutes := int64(c.settings.FirstLoadCallMinutes())
+	crossed := false
+	for _, l := range loads {
+		if l.IsNoTime || l.State >= burble.Airborne || l.CallMinutes > minutes {
+			continue
+		}
+		if p, ok := last[l.ID]; !ok || p.load.IsNoTime || p.load.CallMinutes > minutes {
+			crossed = true
+			break
+		}
+	}
+	if !crossed {
+		return
+	}
+
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location)
+	tx, err := c.db.Begin()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error checking for departed loads: %v\n", err)
+		return
+	}
+	departed, err := c.db.CountEvents(tx, string(LoadDepartedEvent), midnight)
+	_ = tx.Commit()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error checking for departed loads: %v\n", err)
+		return
+	}
+
+	c.mutex.Lock()
+	c.firstLoadDay = day
+	c.mutex.Unlock()
+	if departed > 0 {
+		return
+	}
+
+	message := strings.ReplaceAll(c.settings.FirstLoadMessage(),
+		"{minutes}", strconv.FormatInt(minutes, 10))
+	c.PostAlert(Alert{
+		ID:       firstLoadAlertID,
+		Class:    AnnouncementAlertClass,
+		Priority: AlertInfo,
+		Message:  message,
+		Expires:  now.Add(c.settings.FirstLoadDuration()),
+	})
+}
@@ -0,0 +1,106 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"math"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/metar"
+)
+
+// profilePoint is a temperature in degrees Celsius at an altitude in feet
+// above the ground.
+type profilePoint struct {
+	altitude    int
+	temperature float64
+}
+
+// temperatureProfile returns the temperature by altitude, lowest first, from
+// the winds aloft forecast. The forecast's surface temperature is replaced by
+// the observed temperature from the METAR report when there is one.
+func (c *Controller) temperatureProfile() []profilePoint {
+	if c.WindsAloftSource() == nil {
+		return nil
+	}
+	samples := c.WindsAloftSource().Samples()
+	if len(samples) == 0 {
+		return nil
+	}
+	profile := make([]profilePoint, len(samples))
+	for i, s := range samples {
+		profile[i] = profilePoint{altitude: s.Altitude, temperature: float64(s.Temperature)}
+	}
+	if c.METARSource() != nil && profile[0].altitude == 0 {
+		if t, ok := c.METARSource().TemperatureCelsius(); ok {
+			profile[0].temperature = t
+		}
+	}
+	return profile
+}
+
+// FreezingLevel returns the lowest altitude in feet above the ground at which
+// the temperature falls to 0℃, interpolating between the points of the
+// temperature profile. It is 0 if it is freezing at the surface. ok is false
+// if the temperature is unknown or stays above freezing to the top of the
+// winds aloft forecast.
+func (c *Controller) FreezingLevel() (feet int, ok bool) {
+	profile := c.temperatureProfile()
+	if len(profile) == 0 {
+		return 0, false
+	}
+	if profile[0].temperature <= 0 {
+		return 0, true
+	}
+	for i := 1; i < len(profile); i++ {
+		lower, upper := profile[i-1], profile[i]
+		if upper.temperature > 0 {
+			continue
+		}
+		f := lower.temperature / (lower.temperature - upper.temperature)
+		altitude := float64(lower.altitude) + f*float64(upper.altitude-lower.altitude)
+		return int(math.Round(altitude/100) * 100), true
+	}
+	return 0, false
+}
+
+// ExitTemperature returns the expected temperature in degrees Celsius at the
+// exit altitude of the aircraft flying the next departing load. ok is false
+// if the winds aloft forecast doesn't reach it.
+func (c *Controller) ExitTemperature() (celsius float64, ok bool) {
+	profile := c.temperatureProfile()
+	exit := c.ExitAltitude()
+	for i := 1; i < len(profile); i++ {
+		lower, upper := profile[i-1], profile[i]
+		if upper.altitude < exit {
+			continue
+		}
+		f := float64(exit-lower.altitude) / float64(upper.altitude-lower.altitude)
+		return lower.temperature + f*(upper.temperature-lower.temperature), true
+	}
+	return 0, false
+}
+
+// FreezingLevelMessage describes the freezing level, e.g. "Freezing level
+// 8,400 ft", or returns an empty string if it is unknown.
+func (c *Controller) FreezingLevelMessage() string {
+	feet, ok := c.FreezingLevel()
+	if !ok {
+		return ""
+	}
+	if feet == 0 {
+		return "Freezing at the surface"
+	}
+	return "Freezing level " + formatAltitude(feet, c.settings.Units())
+}
+
+// ExitTemperatureMessage describes the expected temperature at exit altitude,
+// e.g. "Exit temperature -12℃ / 10℉", or returns an empty string if it is
+// unknown.
+func (c *Controller) ExitTemperatureMessage() string {
+	celsius, ok := c.ExitTemperature()
+	if !ok {
+		return ""
+	}
+	return "Exit temperature " + metar.FormatTemperature(int64(math.Round(celsius)),
+		int64(math.Round(metar.FahrenheitFromCelsius(celsius))), c.settings.Units())
+}
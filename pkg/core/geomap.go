@@ -0,0 +1,128 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/jumprun"
+)
+
+// feetPerMinutePerKnot converts a speed in knots to feet per minute.
+const feetPerMinutePerKnot = 6076.12 / 60.0
+
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+// geoJSONPosition returns a GeoJSON position, which is longitude first.
+func geoJSONPosition(latitude, longitude float64) []float64 {
+	return []float64{longitude, latitude}
+}
+
+func newGeoJSONFeature(id, kind, geometryType string, coordinates interface{}) geoJSONFeature {
+	return geoJSONFeature{
+		Type: "Feature",
+		ID:   id,
+		Geometry: geoJSONGeometry{
+			Type:        geometryType,
+			Coordinates: coordinates,
+		},
+		Properties: map[string]interface{}{"kind": kind},
+	}
+}
+
+// mapFeatures returns the overlays drawn by moving map clients as GeoJSON
+// features: the jumprun boundary, the target, the jumprun ground track, and
+// a drift vector for each winds aloft altitude. Each feature's kind property
+// says which it is. Features that aren't known are left out.
+func (c *Controller) mapFeatures() []geoJSONFeature {
+	features := []geoJSONFeature{}
+
+	if boundary := c.settings.JumprunBoundary(); len(boundary) > 0 {
+		ring := make([][]float64, 0, len(boundary)+1)
+		for _, p := range boundary {
+			ring = append(ring, geoJSONPosition(p.Latitude, p.Longitude))
+		}
+		// GeoJSON polygon rings are closed
+		ring = append(ring, ring[0])
+		features = append(features, newGeoJSONFeature("boundary", "boundary",
+			"Polygon", [][][]float64{ring}))
+	}
+
+	latitude, longitude, err := c.Coordinates()
+	if err != nil {
+		return features
+	}
+	target := jumprun.Point{Latitude: latitude, Longitude: longitude}
+	features = append(features, newGeoJSONFeature("target", "target",
+		"Point", geoJSONPosition(latitude, longitude)))
+
+	if c.Jumprun() != nil {
+		d := c.Jumprun().Document()
+		if track := d.Derived.GroundTrack; len(track) > 0 {
+			line := make([][]float64, 0, len(track))
+			for _, p := range track {
+				line = append(line, geoJSONPosition(p.Latitude, p.Longitude))
+			}
+			f := newGeoJSONFeature("jumprun", "jumprun", "LineString", line)
+			f.Properties["true_heading"] = d.Derived.TrueHeading
+			f.Properties["modified"] = d.Modified.Format(time.RFC3339)
+			features = append(features, f)
+		}
+	}
+
+	// Drift vectors show where the winds at each altitude carry a jumper
+	// from over the target in one minute.
+	if c.WindsAloftSource() != nil {
+		for _, s := range c.WindsAloftSource().Samples() {
+			if s.LightAndVariable {
+				continue
+			}
+			downwind := math.Mod(float64(s.Heading)+180.0, 360.0)
+			end := jumprun.Offset(target, jumprun.Point{}, downwind,
+				float64(s.Speed)*feetPerMinutePerKnot)
+			f := newGeoJSONFeature("", "drift", "LineString", [][]float64{
+				geoJSONPosition(latitude, longitude),
+				geoJSONPosition(end.Latitude, end.Longitude),
+			})
+			f.Properties["altitude"] = s.Altitude
+			f.Properties["heading"] = s.Heading
+			f.Properties["speed"] = s.Speed
+			features = append(features, f)
+		}
+	}
+
+	return features
+}
+
+// MapHandler serves the map overlays as a GeoJSON feature collection.
+func (c *Controller) MapHandler(w http.ResponseWriter, req *http.Request) {
+	data, err := json.MarshalIndent(geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: c.mapFeatures(),
+	}, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/geo+json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
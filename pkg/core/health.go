@@ -0,0 +1,239 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SourceHealth describes the state of a data source's most recent refresh.
+type SourceHealth struct {
+	Name        string      `json:"name"`
+	LastRefresh time.Time   `json:"last_refresh"`
+	LastSuccess time.Time   `json:"last_success"`
+	LastChange  time.Time   `json:"last_change"`
+	LastError   string      `json:"last_error,omitempty"`
+	Refreshes   int64       `json:"refreshes"`
+	Errors      int64       `json:"errors"`
+	Detail      interface{} `json:"detail,omitempty"`
+}
+
+// Healthy returns true if the most recent refresh of the source succeeded.
+func (h SourceHealth) Healthy() bool {
+	return h.LastError == ""
+}
+
+func (c *Controller) recordRefresh(sourceName string, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	h := c.health[sourceName]
+	h.Name = sourceName
+	h.LastRefresh = c.clock.Now()
+	h.Refreshes++
+	if err != nil {
+		h.LastError = err.Error()
+		h.Errors++
+	} else {
+		h.LastSuccess = h.LastRefresh
+		h.LastError = ""
+	}
+	c.health[sourceName] = h
+}
+
+// recordChange notes that a source's data has changed.
+func (c *Controller) recordChange(sourceName string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	h := c.health[sourceName]
+	h.Name = sourceName
+	h.LastChange = c.clock.Now()
+	c.health[sourceName] = h
+}
+
+// UpdateTimes are the times at which each source's data last changed. A
+// zero time means the source has not provided any data.
+type UpdateTimes struct {
+	Burble     time.Time
+	METAR      time.Time
+	WindsAloft time.Time
+	Jumprun    time.Time
+}
+
+func (c *Controller) UpdateTimes() UpdateTimes {
+	c.mutex.Lock()
+	t := UpdateTimes{
+		Burble:     c.health[burbleSourceName].LastChange,
+		METAR:      c.health[metarSourceName].LastChange,
+		WindsAloft: c.health[windsAloftSourceName].LastChange,
+	}
+	c.mutex.Unlock()
+
+	if c.Jumprun() != nil {
+		if ts := c.Jumprun().Jumprun().TimeStamp; ts != 0 {
+			t.Jumprun = time.Unix(ts, 0)
+		}
+	}
+	return t
+}
+
+// Health returns the health of each data source, sorted by name.
+func (c *Controller) Health() []SourceHealth {
+	c.mutex.Lock()
+	health := make([]SourceHealth, 0, len(c.health))
+	for _, h := range c.health {
+		health = append(health, h)
+	}
+	c.mutex.Unlock()
+
+	sort.Slice(health, func(i, j int) bool {
+		return health[i].Name < health[j].Name
+	})
+	for i := range health {
+		switch {
+		case health[i].Name == burbleSourceName:
+			health[i].Detail = c.BurbleSource().Anomalies()
+		case health[i].Name == metarSourceName && c.METARSource() != nil:
+			health[i].Detail = c.METARSource().Quota()
+		}
+	}
+	return health
+}
+
+// degradedAlertID identifies the alert raised when several data sources are
+// failing at once.
+const degradedAlertID = "data-degraded"
+
+// updateDegradedAlert raises a single alert naming the failing data sources
+// when enough of them are failing at once that the display can no longer be
+// trusted, and clears it when they recover.
+func (c *Controller) updateDegradedAlert() {
+	threshold := c.settings.DegradedSources()
+
+	var failing []string
+	for _, h := range c.Health() {
+		if h.Healthy() {
+			continue
+		}
+		if h.LastSuccess.IsZero() {
+			failing = append(failing, fmt.Sprintf("%s (no data)", h.Name))
+		} else {
+			failing = append(failing, fmt.Sprintf("%s (last updated %s)", h.Name,
+				h.LastSuccess.In(c.Location()).Format("3:04 PM")))
+		}
+	}
+	if threshold <= 0 || len(failing) < threshold {
+		c.ClearAlert(degradedAlertID)
+		return
+	}
+
+	message := "Data degraded: " + strings.Join(failing, ", ")
+	c.mutex.Lock()
+	a, ok := c.alerts[degradedAlertID]
+	c.mutex.Unlock()
+	if ok && a.Message == message {
+		return
+	}
+	c.PostAlert(Alert{
+		ID:       degradedAlertID,
+		Class:    HealthAlertClass,
+		Priority: AlertWarning,
+		Message:  message,
+	})
+}
+
+func (c *Controller) HealthHandler(w http.ResponseWriter, req *http.Request) {
+	data, err := json.MarshalIndent(struct {
+		Sources []SourceHealth `json:"sources"`
+	}{
+		Sources: c.Health(),
+	}, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
+
+func metricName(sourceName string) string {
+	return strings.ReplaceAll(strings.ToLower(sourceName), " ", "_")
+}
+
+// MetricsHandler writes metrics in the Prometheus text exposition format.
+func (c *Controller) MetricsHandler(w http.ResponseWriter, req *http.Request) {
+	var b strings.Builder
+
+	metric := func(name, typ, help string, value interface{}) {
+		fmt.Fprintf(&b, "# HELP %s %s\n", name, help)
+		fmt.Fprintf(&b, "# TYPE %s %s\n", name, typ)
+		fmt.Fprintf(&b, "%s %v\n", name, value)
+	}
+
+	for _, h := range c.Health() {
+		name := metricName(h.Name)
+		metric("manifest_"+name+"_refreshes_total", "counter",
+			fmt.Sprintf("Number of %s refreshes", h.Name), h.Refreshes)
+		metric("manifest_"+name+"_refresh_errors_total", "counter",
+			fmt.Sprintf("Number of failed %s refreshes", h.Name), h.Errors)
+		var lastSuccess int64
+		if !h.LastSuccess.IsZero() {
+			lastSuccess = h.LastSuccess.Unix()
+		}
+		metric("manifest_"+name+"_last_success_seconds", "gauge",
+			fmt.Sprintf("Time of the last successful %s refresh", h.Name), lastSuccess)
+	}
+
+	a := c.BurbleSource().Anomalies()
+	metric("manifest_burble_unknown_jump_types_total", "counter",
+		"Number of jumpers with unknown jump types in Burble data", a.UnknownJumpTypes)
+	metric("manifest_burble_unparseable_fields_total", "counter",
+		"Number of fields in Burble data that could not be parsed", a.UnparseableFields)
+	metric("manifest_burble_dropped_slots_total", "counter",
+		"Number of slots in Burble data that were not displayed", a.DroppedSlots)
+
+	if m := c.METARSource(); m != nil {
+		var (
+			peakGust float64
+			peakTime int64
+		)
+		if speed, observed, ok := m.PeakGust(); ok {
+			peakGust, peakTime = speed, observed.Unix()
+		}
+		metric("manifest_metar_peak_gust_knots", "gauge",
+			"Strongest wind observed since sunrise", peakGust)
+		metric("manifest_metar_peak_gust_seconds", "gauge",
+			"Time of the strongest wind observed since sunrise", peakTime)
+
+		q := m.Quota()
+		metric("manifest_metar_rate_limited_total", "counter",
+			"Number of METAR requests refused by the weather service", q.RateLimited)
+		if q.Remaining != nil {
+			metric("manifest_metar_quota_remaining", "gauge",
+				"Requests left in the weather service's rate limit window", *q.Remaining)
+		}
+	}
+
+	for _, l := range c.Latency() {
+		name := "manifest_latency_" + l.Stage + "_seconds"
+		fmt.Fprintf(&b, "# HELP %s Update pipeline latency of the %s stage\n", name, l.Stage)
+		fmt.Fprintf(&b, "# TYPE %s summary\n", name)
+		fmt.Fprintf(&b, "%s_sum %v\n", name, l.Sum.Seconds())
+		fmt.Fprintf(&b, "%s_count %v\n", name, l.Count)
+		metric(name+"_max", "gauge",
+			fmt.Sprintf("Longest latency of the %s stage", l.Stage), l.Max.Seconds())
+		metric(name+"_last", "gauge",
+			fmt.Sprintf("Latest latency of the %s stage", l.Stage), l.Last.Seconds())
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	_, _ = w.Write([]byte(b.String()))
+}
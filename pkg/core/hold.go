@@ -0,0 +1,190 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+const weatherHoldAlertID = "weather-hold"
+
+// weatherHold is the state of the weather hold automation. The hold itself
+// is the WeatherHold option so that it can always be set or cleared by hand.
+type weatherHold struct {
+	active     bool      // the hold option as last seen
+	automatic  bool      // the active hold was started automatically
+	suppressed bool      // the hold was cleared by hand
+	readings   int       // consecutive reports over the limits
+	belowSince time.Time // when the winds fell below the clearing limits
+	reason     string
+}
+
+// syncWeatherHold notices holds set or cleared by hand. A hold set by hand is
+// never cleared automatically, and clearing a hold by hand while the winds
+// are above the clearing limits keeps one from starting automatically until
+// they have dropped below them. The caller must hold the controller mutex,
+// and if true is returned, call saveWeatherHold once it has released it.
+func (c *Controller) syncWeatherHold() bool {
+	active := c.settings.WeatherHold()
+	if active == c.hold.active {
+		return false
+	}
+	c.hold.active = active
+	c.hold.suppressed = !active && c.hold.belowSince.IsZero()
+	c.hold.automatic = false
+	c.hold.reason = ""
+	return true
+}
+
+// saveWeatherHold records that a hold set or cleared by hand is no longer
+// automatic so that it isn't cleared automatically after a restart.
+func (c *Controller) saveWeatherHold(active bool) {
+	if c.settings.SetWeatherHold(active) {
+		if err := c.settings.Write(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving weather hold: %v\n", err)
+		}
+	}
+}
+
+// recordWeatherHoldReading counts a new METAR report towards starting or
+// clearing an automatic weather hold.
+func (c *Controller) recordWeatherHoldReading() {
+	m := c.METARSource()
+	if m == nil || m.IsSimulated() || !c.settings.WeatherHoldAutomatic() {
+		return
+	}
+	speed, gust := m.WindSpeedKnots(), m.WindGustSpeedKnots()
+	speedLimit := float64(c.settings.WeatherHoldWindSpeed())
+	gustLimit := float64(c.settings.WeatherHoldGust())
+	margin := float64(c.settings.WeatherHoldMargin())
+
+	over := (speedLimit > 0 && speed > speedLimit) ||
+		(gustLimit > 0 && gust > gustLimit)
+	below := (speedLimit <= 0 || speed <= speedLimit-margin) &&
+		(gustLimit <= 0 || gust <= gustLimit-margin)
+
+	c.mutex.Lock()
+	synced := c.syncWeatherHold()
+	if over {
+		c.hold.readings++
+	} else {
+		c.hold.readings = 0
+	}
+	if !below {
+		c.hold.belowSince = time.Time{}
+	} else if c.hold.belowSince.IsZero() {
+		c.hold.belowSince = c.clock.Now()
+		c.hold.suppressed = false
+	}
+	if over && !c.hold.active {
+		if gust > 0 {
+			c.hold.reason = fmt.Sprintf("winds %dG%d kt", int(speed), int(gust))
+		} else {
+			c.hold.reason = fmt.Sprintf("winds %d kt", int(speed))
+		}
+	}
+	active := c.hold.active
+	c.mutex.Unlock()
+
+	if synced {
+		c.saveWeatherHold(active)
+	}
+}
+
+// updateWeatherHold starts or clears an automatic weather hold once the
+// readings have been over or below the limits for long enough, and keeps the
+// weather hold alert up to date. It returns how long until the hold should
+// be checked again, or 0 if it doesn't need to be.
+func (c *Controller) updateWeatherHold() time.Duration {
+	c.mutex.Lock()
+	synced := c.syncWeatherHold()
+	var (
+		next    time.Duration
+		changed bool
+	)
+	if c.settings.WeatherHoldAutomatic() {
+		switch {
+		case !c.hold.active && !c.hold.suppressed &&
+			c.hold.readings >= c.settings.WeatherHoldStartReadings():
+			c.hold.active, c.hold.automatic, changed = true, true, true
+		case c.hold.active && c.hold.automatic && !c.hold.belowSince.IsZero():
+			next = c.settings.WeatherHoldClearAfter() - c.clock.Now().Sub(c.hold.belowSince)
+			if next <= 0 {
+				c.hold.active, c.hold.automatic, changed = false, false, true
+				c.hold.reason = ""
+				next = 0
+			}
+		}
+	}
+	active, reason := c.hold.active, c.hold.reason
+	existing, posted := c.alerts[weatherHoldAlertID]
+	c.mutex.Unlock()
+
+	if changed {
+		c.settings.SetAutomaticWeatherHold(active)
+		if err := c.settings.Write(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error saving weather hold: %v\n", err)
+		}
+		c.WakeListeners(OptionsDataSource)
+	} else if synced {
+		c.saveWeatherHold(active)
+	}
+
+	if !active {
+		c.ClearAlert(weatherHoldAlertID)
+		return next
+	}
+	message := "Weather hold"
+	if reason != "" {
+		message += " — " + reason
+	}
+	if !posted || existing.Message != message {
+		c.PostAlert(Alert{
+			ID:       weatherHoldAlertID,
+			Class:    WeatherAlertClass,
+			Priority: AlertCritical,
+			Message:  message,
+		})
+	}
+	return next
+}
+
+// startWeatherHold keeps the weather hold alert in sync with the weather hold
+// option and, if enabled, starts and clears holds from the METAR reports.
+func (c *Controller) startWeatherHold() {
+	c.mutex.Lock()
+	c.hold.active = c.settings.WeatherHold()
+	c.hold.automatic = c.settings.AutomaticWeatherHold()
+	c.mutex.Unlock()
+	c.updateWeatherHold()
+
+	wake := make(chan DataSource, 64)
+	id := c.AddListener(wake)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.RemoveListener(id)
+		var check <-chan time.Time
+		for {
+			select {
+			case <-c.Done():
+				return
+			case source := <-wake:
+				if source&(METARDataSource|OptionsDataSource) == 0 {
+					continue
+				}
+				if source&METARDataSource != 0 {
+					c.recordWeatherHoldReading()
+				}
+			case <-check:
+			}
+			check = nil
+			if next := c.updateWeatherHold(); next > 0 {
+				check = c.clock.After(next)
+			}
+		}
+	}()
+}
@@ -0,0 +1,61 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// IsOpen returns true if the DZ is currently within its operating hours.
+// Notifications and other operational activity should be suppressed while
+// the DZ is closed.
+func (c *Controller) IsOpen() bool {
+	return c.scheduler.IsOpen(c.clock.Now())
+}
+
+// ClosedMessage returns a banner message describing when the DZ reopens, or
+// an empty string if the DZ is currently open.
+func (c *Controller) ClosedMessage() string {
+	now := c.CurrentTime()
+	if c.scheduler.IsOpen(now) {
+		return ""
+	}
+
+	opens := c.scheduler.NextOpenTime(now).In(c.Location())
+	var when string
+	if opens.Minute() == 0 {
+		when = opens.Format("3 PM")
+	} else {
+		when = opens.Format("3:04 PM")
+	}
+
+	y, m, d := now.Date()
+	today := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	switch days := int(opens.Sub(today).Hours() / 24); {
+	case days < 1:
+		return fmt.Sprintf("DZ closed — reopens at %s", when)
+	case days < 2:
+		return fmt.Sprintf("DZ closed — reopens tomorrow %s", when)
+	default:
+		return fmt.Sprintf("DZ closed — reopens %s %s", opens.Weekday(), when)
+	}
+}
+
+func (c *Controller) runAtOpenClose() {
+	lastMessage := c.ClosedMessage()
+	for {
+		select {
+		case <-c.Done():
+			return
+		case <-c.clock.After(1 * time.Minute):
+		}
+
+		// The message changes when the DZ opens or closes, and also as
+		// the reopening time moves from "Saturday" to "tomorrow".
+		if message := c.ClosedMessage(); message != lastMessage {
+			lastMessage = message
+			c.WakeListeners(OperatingHoursDataSource)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"math"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/jumprun"
+)
+
+// feetPerTenthMile converts distances in feet to the tenths of a mile in
+// which jumpruns are entered.
+const feetPerTenthMile = 528.0
+
+// JumprunWindCorrection returns the heading the pilot of the next departing
+// load must fly to make good the jumprun's ground track in the winds aloft
+// at the aircraft's exit altitude. ok is false if the jumprun is not set or
+// the winds are unknown.
+func (c *Controller) JumprunWindCorrection() (jumprun.WindCorrection, bool) {
+	if c.Jumprun() == nil || c.WindsAloftSource() == nil {
+		return jumprun.WindCorrection{}, false
+	}
+	j := c.Jumprun().Jumprun()
+	if !j.IsSet {
+		return jumprun.WindCorrection{}, false
+	}
+
+	aircraft := c.NextAircraft()
+	sample, ok := c.exitSample(aircraft)
+	if !ok {
+		return jumprun.WindCorrection{}, false
+	}
+	windSpeed := float64(sample.Speed)
+	if sample.LightAndVariable {
+		windSpeed = 0
+	}
+
+	return jumprun.CorrectForWind(j.TrueHeading(j.Heading),
+		float64(c.settings.AircraftAirspeed(aircraft)), float64(sample.Heading), windSpeed)
+}
+
+// SuggestJumprun returns a jumprun flown directly into the wind at the exit
+// altitude of the aircraft flying the next departing load, placed so that
+// its exit point is the recommended exit spot relative to the current
+// jumprun's origin. If the exit spot is behind the origin along the run, the
+// exit distance is 0, since a jumprun can't exit before it starts. ok is
+// false if the winds aloft are unknown or light and variable.
+func (c *Controller) SuggestJumprun() (jumprun.Jumprun, bool) {
+	if c.Jumprun() == nil {
+		return jumprun.Jumprun{}, false
+	}
+	aircraft := c.NextAircraft()
+	sample, ok := c.exitSample(aircraft)
+	if !ok || sample.LightAndVariable || sample.Speed <= 0 {
+		return jumprun.Jumprun{}, false
+	}
+	d, ok := c.Spot(aircraft)
+	if !ok {
+		return jumprun.Jumprun{}, false
+	}
+
+	current := c.Jumprun().Jumprun()
+	j := jumprun.Jumprun{
+		TimeStamp:           c.clock.Now().Unix(),
+		Latitude:            current.Latitude,
+		Longitude:           current.Longitude,
+		MagneticDeclination: current.MagneticDeclination,
+		CameraHeight:        current.CameraHeight,
+		IsSet:               true,
+	}
+	magnetic := func(trueHeading float64) int {
+		return ((int(math.Round(trueHeading))-j.MagneticDeclination)%360 + 360) % 360
+	}
+	heading := float64(sample.Heading)
+	j.Heading = magnetic(heading)
+
+	// The exit spot is upwind of the origin by the drift. The run is
+	// offset from the origin by the part of that across the run, and the
+	// exit distance is the part along it.
+	r := (d.Total.Bearing + 180.0 - heading) * math.Pi / 180.0
+	along := math.Max(d.Total.Distance*math.Cos(r), 0)
+	across := d.Total.Distance * math.Sin(r)
+	j.ExitDistance = int(math.Round(along / feetPerTenthMile))
+	if j.OffsetDistance = int(math.Round(math.Abs(across) / feetPerTenthMile)); j.OffsetDistance > 0 {
+		if across > 0 {
+			j.OffsetHeading = magnetic(heading + 90.0)
+		} else {
+			j.OffsetHeading = magnetic(heading - 90.0)
+		}
+	}
+	return j, true
+}
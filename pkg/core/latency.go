@@ -0,0 +1,67 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"sort"
+	"time"
+)
+
+// Stages of the update pipeline whose latency is measured.
+const (
+	// LatencyConstruct is from a Burble change being fetched to the
+	// update carrying it being constructed.
+	LatencyConstruct = "construct"
+	// LatencySend is from an update being constructed to it being sent to
+	// a display.
+	LatencySend = "send"
+	// LatencyEcho is from an update being sent to a display to the display
+	// echoing it back once it has been shown.
+	LatencyEcho = "echo"
+)
+
+// LatencySummary summarizes the latencies measured for a pipeline stage.
+type LatencySummary struct {
+	Stage string
+	Count int64
+	Sum   time.Duration
+	Max   time.Duration
+	Last  time.Duration
+}
+
+// RecordLatency records the latency measured for a stage of the update
+// pipeline.
+func (c *Controller) RecordLatency(stage string, d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.latency == nil {
+		c.latency = make(map[string]LatencySummary)
+	}
+	l := c.latency[stage]
+	l.Stage = stage
+	l.Count++
+	l.Sum += d
+	l.Last = d
+	if d > l.Max {
+		l.Max = d
+	}
+	c.latency[stage] = l
+}
+
+// Latency returns the latencies measured for each stage, sorted by stage.
+func (c *Controller) Latency() []LatencySummary {
+	c.mutex.Lock()
+	latency := make([]LatencySummary, 0, len(c.latency))
+	for _, l := range c.latency {
+		latency = append(latency, l)
+	}
+	c.mutex.Unlock()
+
+	sort.Slice(latency, func(i, j int) bool {
+		return latency[i].Stage < latency[j].Stage
+	})
+	return latency
+}
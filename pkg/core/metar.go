@@ -0,0 +1,271 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/db"
+	"github.com/jumptown-skydiving/manifest-server/pkg/metar"
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// archiveMETAR stores the current raw METAR in the database so that the
+// official weather record is available later, e.g. for incident reports.
+func (c *Controller) archiveMETAR() {
+	raw, observed, ok := c.METARSource().RawText()
+	if !ok {
+		return
+	}
+	station := c.METARSource().Station()
+	if station == "" {
+		station = c.settings.METARStation()
+	}
+	o := db.METARObservation{
+		Station:         strings.ToUpper(station),
+		RawText:         raw,
+		ObservationTime: observed,
+		ReceiptTime:     c.clock.Now(),
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error archiving METAR: %v\n", err)
+		return
+	}
+	if err = c.db.RecordMETAR(tx, o); err != nil {
+		_ = tx.Rollback()
+		fmt.Fprintf(os.Stderr, "Error archiving METAR: %v\n", err)
+		return
+	}
+	_ = tx.Commit()
+}
+
+// refreshMETAR refreshes the METAR source. A failed refresh changes nothing
+// but whether the report is degraded, so listeners are woken here when it
+// becomes degraded rather than waiting for the next successful refresh.
+func (c *Controller) refreshMETAR() (bool, error) {
+	m := c.METARSource()
+	degraded := m.Degraded()
+	changed, err := m.Refresh()
+	if err != nil && !degraded && m.Degraded() {
+		c.WakeListeners(METARDataSource)
+	}
+	c.metarStale.refreshed()
+	return changed, err
+}
+
+// PeakGustMessage describes the strongest wind observed today, or returns an
+// empty string if there is none yet. The peak is reset at sunrise.
+func (c *Controller) PeakGustMessage() string {
+	if c.METARSource() == nil {
+		return ""
+	}
+	speed, observed, ok := c.METARSource().PeakGust()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("Peak gust today %s at %s",
+		metar.FormatSpeed(speed, c.settings.Units()),
+		observed.In(c.Location()).Format("3:04 PM"))
+}
+
+// DensityAltitudeMessage describes the current density altitude, or returns
+// an empty string if it can't be computed.
+func (c *Controller) DensityAltitudeMessage() string {
+	if c.METARSource() == nil {
+		return ""
+	}
+	da, ok := c.METARSource().DensityAltitude()
+	if !ok {
+		return ""
+	}
+	return "Density altitude " + formatAltitude(da, c.settings.Units())
+}
+
+// ApparentTemperatureMessage describes the wind chill or heat index, e.g.
+// "Wind chill -12℃ / 10℉", or returns an empty string if neither applies or
+// it isn't displayed.
+func (c *Controller) ApparentTemperatureMessage() string {
+	if c.METARSource() == nil || !c.settings.DisplayApparentTemperature() {
+		return ""
+	}
+	kind, celsius, ok := c.METARSource().ApparentTemperature()
+	if !ok {
+		return ""
+	}
+	return string(kind) + " " + metar.FormatTemperature(int64(math.Round(celsius)),
+		int64(math.Round(metar.FahrenheitFromCelsius(celsius))), c.settings.Units())
+}
+
+// formatAltitude formats an altitude given in feet in the given units.
+func formatAltitude(feet int, units string) string {
+	meters := int(math.Round(float64(feet) * 0.3048))
+	switch units {
+	case settings.UnitsMetric:
+		return formatThousands(meters) + " m"
+	case settings.UnitsBoth:
+		return fmt.Sprintf("%s ft / %s m", formatThousands(feet), formatThousands(meters))
+	}
+	return formatThousands(feet) + " ft"
+}
+
+// TrendsMessage describes the weather trends that aren't steady, e.g. "Winds
+// increasing, pressure falling", or returns an empty string if there are none.
+func (c *Controller) TrendsMessage() string {
+	m := c.METARSource()
+	if m == nil {
+		return ""
+	}
+	var trends []string
+	switch m.WindTrend() {
+	case metar.Rising:
+		trends = append(trends, "winds increasing")
+	case metar.Falling:
+		trends = append(trends, "winds decreasing")
+	}
+	switch t := m.PressureTrend(); t {
+	case metar.Rising, metar.Falling:
+		trends = append(trends, "pressure "+t.String())
+	}
+	switch t := m.TemperatureTrend(); t {
+	case metar.Rising, metar.Falling:
+		trends = append(trends, "temperature "+t.String())
+	}
+	if len(trends) == 0 {
+		return ""
+	}
+	message := strings.Join(trends, ", ")
+	return strings.ToUpper(message[:1]) + message[1:]
+}
+
+// WindsColor returns the color the surface winds are shown in: red or yellow
+// when they reach the thresholds set in the options, and white otherwise.
+func (c *Controller) WindsColor() uint32 {
+	m := c.METARSource()
+	if m == nil {
+		return 0xffffff
+	}
+	// Both sets of thresholds come from one snapshot of the options so that
+	// a change to them can't be seen half applied.
+	o := c.settings.Options()
+	speed, gust := m.WindSpeedMPH(), m.WindGustSpeedMPH()
+	switch {
+	case o.WindsRedThresholds().Exceeded(speed, gust):
+		return AlertCritical.Color()
+	case o.WindsYellowThresholds().Exceeded(speed, gust):
+		return AlertWarning.Color()
+	}
+	return 0xffffff
+}
+
+// WeatherStale returns true if the current METAR report is older than the
+// configured threshold, so that the weather lines can be shown as stale.
+func (c *Controller) WeatherStale() bool {
+	m := c.METARSource()
+	return m != nil && m.IsStale(c.settings.METARStaleAfter())
+}
+
+// refreshWindsAloft refreshes the winds aloft source. A failed refresh keeps
+// the last good samples and only marks them degraded, so listeners are woken
+// here when they become degraded rather than waiting for the next successful
+// refresh.
+func (c *Controller) refreshWindsAloft() (bool, error) {
+	w := c.WindsAloftSource()
+	degraded := w.Degraded()
+	changed, err := w.Refresh()
+	if err != nil && !degraded && w.Degraded() {
+		c.WakeListeners(WindsAloftDataSource)
+	}
+	c.windsAloftStale.refreshed()
+	return changed, err
+}
+
+// WindsAloftStale returns true if the winds aloft haven't been retrieved
+// within the configured threshold, so that displays can show them as stale.
+func (c *Controller) WindsAloftStale() bool {
+	w := c.WindsAloftSource()
+	return w != nil && w.IsStale(c.settings.WindsStaleAfter())
+}
+
+// formatThousands formats an integer with commas separating thousands.
+func formatThousands(n int) string {
+	s := strconv.Itoa(n)
+	start := 0
+	if n < 0 {
+		start = 1
+	}
+	for i := len(s) - 3; i > start; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
+
+// METARHistoryHandler serves the archived raw METARs observed on the local
+// date given by the date query parameter (YYYY-MM-DD), or today if none is
+// given, one report per line. Reports are from the most preferred station
+// unless another is named by the station query parameter.
+func (c *Controller) METARHistoryHandler(w http.ResponseWriter, req *http.Request) {
+	day, station, err := c.historyDay(req.URL.Query().Get("date"), req.URL.Query().Get("station"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	observations, err := c.archivedMETARs(station, day)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "METAR history for %s on %s (times are UTC)\n\n",
+		station, day.Format("Monday, January 2, 2006"))
+	if len(observations) == 0 {
+		b.WriteString("No observations recorded.\n")
+	}
+	for _, o := range observations {
+		fmt.Fprintf(&b, "%s  received %s\n",
+			o.RawText, o.ReceiptTime.UTC().Format("2006-01-02 15:04:05Z"))
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = w.Write([]byte(b.String()))
+}
+
+// historyDay returns the start of the local date (YYYY-MM-DD), or today if
+// date is empty, and the station, or the most preferred station if station
+// is empty, for which archived reports are requested.
+func (c *Controller) historyDay(date, station string) (time.Time, string, error) {
+	now := c.CurrentTime()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, c.Location())
+	if date != "" {
+		var err error
+		day, err = time.ParseInLocation("2006-01-02", date, c.Location())
+		if err != nil {
+			return time.Time{}, "", errors.New("Invalid date; expected YYYY-MM-DD")
+		}
+	}
+	if station == "" {
+		station = c.settings.METARStation()
+	}
+	return day, strings.ToUpper(station), nil
+}
+
+// archivedMETARs returns the archived reports from station observed on the
+// local day starting at day.
+func (c *Controller) archivedMETARs(station string, day time.Time) ([]db.METARObservation, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	observations, err := c.db.QueryMETARs(tx, station, day, day.AddDate(0, 0, 1))
+	_ = tx.Commit()
+	return observations, err
+}
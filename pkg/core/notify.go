@@ -0,0 +1,119 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// Notification is an alert or manifest event delivered to people outside of
+// the displays, e.g. by SMS or Slack.
+type Notification struct {
+	Type     string // alert.<class> or event.<type>
+	Priority AlertPriority
+	Message  string
+	Time     time.Time
+	JumperID string // the jumper the notification is about, if any
+
+	// Conditions is the weather when the notification was sent, so that
+	// payloads carry it without receivers asking for it separately.
+	Conditions Conditions
+}
+
+// A Notifier delivers notifications on a channel. Notify may be slow; it is
+// called on its own goroutine.
+type Notifier interface {
+	Notify(n Notification) error
+}
+
+// RegisterNotifier registers the notifier that delivers notifications routed
+// to the named channel, replacing any notifier already registered for it.
+// Channels configured in notifications.webhooks are registered when the
+// controller is created.
+func (c *Controller) RegisterNotifier(channel string, n Notifier) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.notifiers == nil {
+		c.notifiers = make(map[string]Notifier)
+	}
+	c.notifiers[channel] = n
+}
+
+// notify delivers a notification to each channel that a route in settings
+// routes it to, with a snapshot of the current conditions. Only critical
+// notifications are delivered while the DZ is closed.
+func (c *Controller) notify(n Notification) {
+	if n.Time.IsZero() {
+		n.Time = c.clock.Now()
+	}
+	if n.Priority < AlertCritical && !c.IsOpen() {
+		return
+	}
+
+	delivered := make(map[string]bool)
+	for _, r := range c.settings.NotificationRoutes() {
+		if delivered[r.Channel] || !r.Matches(n.Type) {
+			continue
+		}
+		if minPriority, ok := ParseAlertPriority(r.MinPriority); !ok || n.Priority < minPriority {
+			continue
+		}
+		if r.JumpersOnly && n.JumperID == "" {
+			continue
+		}
+		c.mutex.Lock()
+		notifier, ok := c.notifiers[r.Channel]
+		c.mutex.Unlock()
+		if !ok {
+			continue
+		}
+		if len(delivered) == 0 {
+			n.Conditions = c.Conditions()
+		}
+		delivered[r.Channel] = true
+
+		c.wg.Add(1)
+		go func(channel string) {
+			defer c.wg.Done()
+			if err := notifier.Notify(n); err != nil {
+				fmt.Fprintf(os.Stderr, "Error sending %s notification: %v\n", channel, err)
+			}
+		}(r.Channel)
+	}
+}
+
+// notifyEvent routes a manifest event as an informational notification.
+func (c *Controller) notifyEvent(e Event, now time.Time) {
+	load := e.LoadNumber
+	if e.AircraftName != "" {
+		load = fmt.Sprintf("%s %s", e.AircraftName, e.LoadNumber)
+	}
+	var message string
+	switch e.Type {
+	case LoadCreatedEvent:
+		message = fmt.Sprintf("Load %s created", load)
+	case LoadDepartedEvent:
+		message = fmt.Sprintf("Load %s departed", load)
+	case LoadRemovedEvent:
+		message = fmt.Sprintf("Load %s removed", load)
+	case CallChangedEvent:
+		message = fmt.Sprintf("Load %s on a %d minute call", load, e.CallMinutes)
+	case HoldStartedEvent:
+		message = fmt.Sprintf("Load %s on hold", load)
+	case JumperAddedEvent:
+		message = fmt.Sprintf("%s added to load %s", e.JumperName, load)
+	case JumperRemovedEvent:
+		message = fmt.Sprintf("%s removed from load %s", e.JumperName, load)
+	default:
+		message = fmt.Sprintf("Load %s: %s", load, e.Type)
+	}
+	c.notify(Notification{
+		Type:     "event." + string(e.Type),
+		Priority: AlertInfo,
+		Message:  message,
+		Time:     now,
+		JumperID: e.JumperID,
+	})
+}
@@ -0,0 +1,93 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LoadProjection is an estimate of how many more loads will fly today.
+type LoadProjection struct {
+	// Remaining is the number of loads expected to depart before the end
+	// of the day, including loads that are already manifesting.
+	Remaining int
+
+	// End is the latest time a load can depart, which is the earlier of
+	// closing time and sunset less the configured margin.
+	End time.Time
+}
+
+// ProjectLoads estimates how many more loads will fly today from the rate
+// at which each aircraft's loads are being manifested and the daylight
+// remaining. If there are no loads or the end of the day is unknown, ok is
+// false.
+func (c *Controller) ProjectLoads() (p LoadProjection, ok bool) {
+	if c.BurbleSource() == nil {
+		return p, false
+	}
+	now := c.CurrentTime()
+
+	if _, sunset, err := c.SunriseAndSunsetTimes(); err == nil {
+		p.End = sunset.Add(-c.settings.LoadProjectionSunsetMargin())
+	}
+	if closing, ok := c.scheduler.CloseTime(now); ok && (p.End.IsZero() || closing.Before(p.End)) {
+		p.End = closing
+	}
+	if p.End.IsZero() {
+		return p, false
+	}
+	minutesLeft := int64(p.End.Sub(now) / time.Minute)
+
+	callMinutes := make(map[string][]int64)
+	for _, l := range c.BurbleSource().Loads() {
+		if l.CallMinutes >= 0 {
+			callMinutes[l.AircraftName] = append(callMinutes[l.AircraftName], l.CallMinutes)
+		}
+	}
+	if len(callMinutes) == 0 {
+		return p, false
+	}
+
+	defaultInterval := int64(c.settings.LoadProjectionInterval() / time.Minute)
+	for _, minutes := range callMinutes {
+		sort.Slice(minutes, func(i, j int) bool { return minutes[i] < minutes[j] })
+
+		// The manifesting rate is the average time between the
+		// aircraft's scheduled loads.
+		interval := defaultInterval
+		if n := int64(len(minutes)); n > 1 {
+			interval = (minutes[n-1] - minutes[0]) / (n - 1)
+		}
+
+		last := minutes[len(minutes)-1]
+		if last > minutesLeft {
+			// Count only the scheduled loads that fit
+			for _, m := range minutes {
+				if m <= minutesLeft {
+					p.Remaining++
+				}
+			}
+			continue
+		}
+		p.Remaining += len(minutes)
+		if interval > 0 {
+			p.Remaining += int((minutesLeft - last) / interval)
+		}
+	}
+	return p, true
+}
+
+// LoadsRemainingMessage returns a status line such as "Estimated 7 loads
+// remaining", or an empty string if no estimate can be made.
+func (c *Controller) LoadsRemainingMessage() string {
+	p, ok := c.ProjectLoads()
+	if !ok {
+		return ""
+	}
+	if p.Remaining == 1 {
+		return "Estimated 1 load remaining"
+	}
+	return fmt.Sprintf("Estimated %d loads remaining", p.Remaining)
+}
@@ -0,0 +1,114 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+)
+
+// publicStatusMaxAge is how long the public status is cached, both here and
+// by clients. The DZ website may be polled heavily, so the status is only
+// rebuilt this often no matter how many requests arrive.
+const publicStatusMaxAge = 60 * time.Second
+
+type PublicWinds struct {
+	Summary   string `json:"summary"`
+	Speed     int    `json:"speed_mph"`
+	Gust      int    `json:"gust_mph,omitempty"`
+	Direction int    `json:"direction"`
+}
+
+type PublicLoad struct {
+	Aircraft       string    `json:"aircraft"`
+	LoadNumber     string    `json:"load_number"`
+	CallTime       time.Time `json:"call_time"`
+	SlotsAvailable int64     `json:"slots_available"`
+}
+
+// PublicStatus is a summary of the DZ's status suitable for the public
+// website. It intentionally contains no jumper names.
+type PublicStatus struct {
+	Open          bool         `json:"open"`
+	ClosedMessage string       `json:"closed_message,omitempty"`
+	Winds         *PublicWinds `json:"winds,omitempty"`
+	Temperature   string       `json:"temperature,omitempty"`
+	NextLoad      *PublicLoad  `json:"next_load,omitempty"`
+	Sunset        *time.Time   `json:"sunset,omitempty"`
+	Updated       time.Time    `json:"updated"`
+}
+
+func (c *Controller) PublicStatus() PublicStatus {
+	now := c.CurrentTime()
+	status := PublicStatus{
+		Open:          c.IsOpen(),
+		ClosedMessage: c.ClosedMessage(),
+		Updated:       now,
+	}
+
+	if m := c.METARSource(); m != nil {
+		status.Winds = &PublicWinds{
+			Summary:   m.WindConditions(),
+			Speed:     int(math.Round(m.WindSpeedMPH())),
+			Gust:      int(math.Round(m.WindGustSpeedMPH())),
+			Direction: int(math.Round(m.WindDirectionDegrees())),
+		}
+		status.Temperature = m.TemperatureString()
+	}
+
+	if status.Open {
+		for _, l := range c.BurbleSource().Loads() {
+			if l.IsNoTime || l.State >= burble.Boarding || l.SlotsAvailable <= 0 {
+				continue
+			}
+			status.NextLoad = &PublicLoad{
+				Aircraft:       l.AircraftName,
+				LoadNumber:     l.LoadNumber,
+				CallTime:       now.Add(time.Duration(l.CallMinutes) * time.Minute).Truncate(time.Minute),
+				SlotsAvailable: l.SlotsAvailable,
+			}
+			break
+		}
+	}
+
+	if _, sunset, err := c.SunriseAndSunsetTimes(); err == nil {
+		status.Sunset = &sunset
+	}
+	return status
+}
+
+// PublicStatusHandler serves PublicStatus as JSON, rebuilding it at most
+// once per publicStatusMaxAge.
+func (c *Controller) PublicStatusHandler(w http.ResponseWriter, req *http.Request) {
+	now := c.clock.Now()
+
+	c.mutex.Lock()
+	data, built := c.publicStatus, c.publicStatusTime
+	c.mutex.Unlock()
+
+	if data == nil || now.Sub(built) >= publicStatusMaxAge {
+		var err error
+		data, err = json.Marshal(c.PublicStatus())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		built = now
+
+		c.mutex.Lock()
+		c.publicStatus, c.publicStatusTime = data, built
+		c.mutex.Unlock()
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(publicStatusMaxAge.Seconds())))
+	h.Set("Access-Control-Allow-Origin", "*")
+	http.ServeContent(w, req, "", built, bytes.NewReader(data))
+}
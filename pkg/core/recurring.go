@@ -0,0 +1,106 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// recurringAlertPrefix prefixes the IDs of recurring message alerts, which
+// are numbered by their position in the recurring messages option.
+const recurringAlertPrefix = "recurring-"
+
+// nextMidnight returns the local midnight that ends the day of t.
+func (c *Controller) nextMidnight(t time.Time) time.Time {
+	t = t.In(c.location)
+	return time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.location)
+}
+
+// updateRecurringMessages posts today's recurring messages as announcements
+// that expire at midnight, and clears any that no longer apply.
+func (c *Controller) updateRecurringMessages() {
+	c.recurringLock.Lock()
+	defer c.recurringLock.Unlock()
+
+	now := c.clock.Now().In(c.location)
+	midnight := c.nextMidnight(now)
+
+	wanted := make(map[string]string)
+	for i, m := range c.settings.RecurringMessages() {
+		if m.Applies(now) {
+			wanted[recurringAlertPrefix+strconv.Itoa(i)] = m.Text(now)
+		}
+	}
+
+	var stale []string
+	posted := make(map[string]string)
+	c.mutex.Lock()
+	for id, a := range c.alerts {
+		if !strings.HasPrefix(id, recurringAlertPrefix) {
+			continue
+		}
+		if _, ok := wanted[id]; ok && !a.expired(now) {
+			posted[id] = a.Message
+		} else {
+			stale = append(stale, id)
+		}
+	}
+	c.mutex.Unlock()
+
+	for _, id := range stale {
+		c.ClearAlert(id)
+	}
+	for id, message := range wanted {
+		if existing, ok := posted[id]; ok && existing == message {
+			continue
+		}
+		c.PostAlert(Alert{
+			ID:       id,
+			Class:    AnnouncementAlertClass,
+			Priority: AlertInfo,
+			Message:  message,
+			Expires:  midnight,
+		})
+	}
+}
+
+// startRecurringMessages keeps the recurring message announcements in sync
+// with the recurring messages option and the date. The scheduler updates
+// them at each midnight, whether or not the DZ is open, and they are also
+// updated whenever the options change.
+func (c *Controller) startRecurringMessages() {
+	untilMidnight := func() settings.RefreshPolicy {
+		now := c.clock.Now()
+		d := c.nextMidnight(now).Sub(now)
+		return settings.RefreshPolicy{Interval: d, ClosedInterval: d}
+	}
+	c.scheduler.Launch("recurring messages", untilMidnight,
+		func() (bool, error) {
+			c.updateRecurringMessages()
+			return false, nil
+		},
+		func() {}, nil)
+
+	wake := make(chan DataSource, 64)
+	id := c.AddListener(wake)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.RemoveListener(id)
+		for {
+			select {
+			case <-c.Done():
+				return
+			case source := <-wake:
+				if source&OptionsDataSource != 0 {
+					c.updateRecurringMessages()
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,229 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// Clock is the source of time used for scheduling.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock is the Clock that uses the system's time.
+var SystemClock Clock = systemClock{}
+
+// Scheduler periodically refreshes data sources according to their refresh
+// policies.
+type Scheduler struct {
+	clock    Clock
+	settings *settings.Settings
+	location *time.Location
+	done     <-chan struct{}
+	wg       sync.WaitGroup
+
+	lock sync.Mutex
+	rand *rand.Rand
+}
+
+func NewScheduler(
+	clock Clock,
+	settings *settings.Settings,
+	location *time.Location,
+	done <-chan struct{},
+) *Scheduler {
+	return &Scheduler{
+		clock:    clock,
+		settings: settings,
+		location: location,
+		done:     done,
+		rand:     rand.New(rand.NewSource(clock.Now().UnixNano())),
+	}
+}
+
+// Wait waits for all launched data sources to stop, which they do once the
+// done channel is closed.
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return time.Duration(s.rand.Int63n(int64(2*d+1))) - d
+}
+
+// operatingHours returns the configured operating hours and days. If
+// operating hours are not configured at all, ok is false.
+func (s *Scheduler) operatingHours() (start, end time.Duration, days map[time.Weekday]bool, ok bool) {
+	start, end, ok = s.settings.OperatingHours()
+	operatingDays := s.settings.OperatingDays()
+	if !ok {
+		if len(operatingDays) == 0 {
+			return 0, 0, nil, false
+		}
+		start, end = 0, 24*time.Hour
+	}
+	days = make(map[time.Weekday]bool)
+	for _, day := range operatingDays {
+		days[day] = true
+	}
+	if len(days) == 0 {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			days[day] = true
+		}
+	}
+	return start, end, days, true
+}
+
+func timeOfDay(t time.Time, offset time.Duration) time.Time {
+	y, m, d := t.Date()
+	h := int(offset / time.Hour)
+	min := int((offset % time.Hour) / time.Minute)
+	return time.Date(y, m, d, h, min, 0, 0, t.Location())
+}
+
+// IsOpen returns true if t is within operating hours. If operating hours are
+// not configured, the DZ is always open.
+func (s *Scheduler) IsOpen(t time.Time) bool {
+	start, end, days, ok := s.operatingHours()
+	if !ok {
+		return true
+	}
+	t = t.In(s.location)
+	offset := t.Sub(timeOfDay(t, 0))
+	if start < end {
+		return days[t.Weekday()] && offset >= start && offset < end
+	}
+	// Operating hours span midnight, so the early morning hours belong
+	// to the previous day.
+	if offset >= start {
+		return days[t.Weekday()]
+	}
+	return offset < end && days[t.AddDate(0, 0, -1).Weekday()]
+}
+
+// CloseTime returns the time at which the operating hours that t falls
+// within end. If operating hours are not configured or t is outside of them,
+// ok is false.
+func (s *Scheduler) CloseTime(t time.Time) (time.Time, bool) {
+	start, end, _, configured := s.operatingHours()
+	if !configured || !s.IsOpen(t) {
+		return time.Time{}, false
+	}
+	t = t.In(s.location)
+	if start < end || t.Sub(timeOfDay(t, 0)) < end {
+		return timeOfDay(t, end), true
+	}
+	return timeOfDay(t.AddDate(0, 0, 1), end), true
+}
+
+// NextOpenTime returns the earliest time at or after t that is within
+// operating hours.
+func (s *Scheduler) NextOpenTime(t time.Time) time.Time {
+	if s.IsOpen(t) {
+		return t
+	}
+	start, _, days, _ := s.operatingHours()
+	t = t.In(s.location)
+	for i := 0; i <= 7; i++ {
+		next := timeOfDay(t.AddDate(0, 0, i), start)
+		if next.After(t) && days[next.Weekday()] {
+			return next
+		}
+	}
+	return t
+}
+
+// NextRefresh returns how long to wait before the next refresh given the
+// source's policy and number of consecutive failed refreshes.
+func (s *Scheduler) NextRefresh(policy settings.RefreshPolicy, failures int) time.Duration {
+	now := s.clock.Now()
+	if !s.IsOpen(now) {
+		untilOpen := s.NextOpenTime(now).Sub(now)
+		if policy.ClosedInterval <= 0 || policy.ClosedInterval > untilOpen {
+			return untilOpen
+		}
+		return policy.ClosedInterval + s.jitter(policy.Jitter)
+	}
+
+	delay := policy.Interval
+	if failures > 0 && policy.MaxBackoff > delay {
+		for i := 0; i < failures && delay < policy.MaxBackoff; i++ {
+			delay *= 2
+		}
+		if delay > policy.MaxBackoff {
+			delay = policy.MaxBackoff
+		}
+	}
+	delay += s.jitter(policy.Jitter)
+	if delay < time.Second {
+		delay = time.Second
+	}
+	return delay
+}
+
+// Launch starts a goroutine that refreshes a data source according to the
+// policy returned by the policy function, which is consulted before each
+// refresh so that configuration changes take effect. The update function is
+// called whenever a refresh reports that data has changed, and the record
+// function is called with the result of every refresh.
+func (s *Scheduler) Launch(
+	sourceName string,
+	policy func() settings.RefreshPolicy,
+	refresh func() (bool, error),
+	update func(),
+	record func(string, error),
+) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		failures := 0
+		for {
+			p := policy()
+			if p.ClosedInterval > 0 || s.IsOpen(s.clock.Now()) {
+				changed, err := refresh()
+				if record != nil {
+					record(sourceName, err)
+				}
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error refreshing %s: %v\n", sourceName, err)
+					failures++
+				} else {
+					failures = 0
+					if changed {
+						update()
+					}
+				}
+			}
+
+			select {
+			case <-s.done:
+				return
+			case <-s.clock.After(s.NextRefresh(p, failures)):
+			}
+		}
+	}()
+}
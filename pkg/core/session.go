@@ -38,7 +38,7 @@ func (c *Controller) NewSession(
 	nonce string,
 	provider string,
 ) (*db.Session, error) {
-	now := time.Now()
+	now := c.clock.Now()
 	refreshTime := now.Add(24 * time.Hour)
 	expireTime := now.Add(6 * 30 * 24 * time.Hour)
 
@@ -57,7 +57,7 @@ func (c *Controller) LookupSession(
 		return nil, err
 	}
 
-	now := time.Now()
+	now := c.clock.Now()
 	/* Ignore session expiration time for now. We'll rely on the refresh
 	   time entirely instead. With providers other than Apple, this might
 	   make more sense, but it doesn't really so much with Apple, and that's
@@ -0,0 +1,163 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/jumprun"
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// sharedState is state that is shared through the database with other
+// servers using the same database file, so that any of them can serve the
+// displays with the same options and jumprun no matter which one they were
+// set on. The update snapshot and data source caches are not shared, and
+// since the database is SQLite, the servers must run on the same host.
+type sharedState struct {
+	name   string
+	source DataSource // wakes when the state changes locally
+	get    func() ([]byte, error)
+	apply  func([]byte) error
+}
+
+func (c *Controller) sharedStates() []sharedState {
+	states := []sharedState{
+		{
+			name:   "options",
+			source: OptionsDataSource,
+			get: func() ([]byte, error) {
+				return json.Marshal(c.settings.Options())
+			},
+			apply: func(data []byte) error {
+				var o settings.Options
+				if err := json.Unmarshal(data, &o); err != nil {
+					return err
+				}
+				if c.settings.SetOptions(o) {
+					if err := c.settings.Write(); err != nil {
+						fmt.Fprintf(os.Stderr, "Error saving shared options: %v\n", err)
+					}
+					c.WakeListeners(OptionsDataSource)
+				}
+				return nil
+			},
+		},
+	}
+	if c.Jumprun() != nil {
+		states = append(states, sharedState{
+			name:   "jumprun",
+			source: JumprunDataSource,
+			get: func() ([]byte, error) {
+				return json.Marshal(c.Jumprun().Jumprun())
+			},
+			apply: func(data []byte) error {
+				var j jumprun.Jumprun
+				if err := json.Unmarshal(data, &j); err != nil {
+					return err
+				}
+				if c.Jumprun().Set(j) {
+					if err := c.Jumprun().Write(); err != nil {
+						fmt.Fprintf(os.Stderr, "cannot save jumprun state: %v\n", err)
+					}
+				}
+				return nil
+			},
+		})
+	}
+	return states
+}
+
+// publishSharedState stores the local state, returning its new version.
+func (c *Controller) publishSharedState(s sharedState) (int64, error) {
+	data, err := s.get()
+	if err != nil {
+		return 0, err
+	}
+	tx, err := c.db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	version, err := c.db.StoreState(tx, s.name, data)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+	return version, tx.Commit()
+}
+
+// pullSharedState applies the stored state if another server has changed it
+// since the version last seen, returning the stored version. State that has
+// never been stored is published instead.
+func (c *Controller) pullSharedState(s sharedState, seen int64) (int64, error) {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return seen, err
+	}
+	data, version, err := c.db.QueryState(tx, s.name)
+	_ = tx.Commit()
+	if err != nil {
+		return seen, err
+	}
+	if version == 0 {
+		return c.publishSharedState(s)
+	}
+	if version == seen {
+		return seen, nil
+	}
+	if err = s.apply(data); err != nil {
+		return seen, err
+	}
+	return version, nil
+}
+
+// startSharedState adopts the shared state, then keeps it in sync by
+// publishing local changes and polling for changes made by other servers.
+func (c *Controller) startSharedState() {
+	states := c.sharedStates()
+	versions := make(map[string]int64, len(states))
+	pull := func() {
+		for _, s := range states {
+			version, err := c.pullSharedState(s, versions[s.name])
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading shared %s: %v\n", s.name, err)
+				continue
+			}
+			versions[s.name] = version
+		}
+	}
+	pull()
+
+	wake := make(chan DataSource, 64)
+	id := c.AddListener(wake)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.RemoveListener(id)
+		poll := c.clock.After(c.settings.SharedStatePollInterval())
+		for {
+			select {
+			case <-c.Done():
+				return
+			case source := <-wake:
+				for _, s := range states {
+					if source&s.source == 0 {
+						continue
+					}
+					version, err := c.publishSharedState(s)
+					if err != nil {
+						fmt.Fprintf(os.Stderr, "Error sharing %s: %v\n", s.name, err)
+						continue
+					}
+					versions[s.name] = version
+				}
+			case <-poll:
+				pull()
+				poll = c.clock.After(c.settings.SharedStatePollInterval())
+			}
+		}
+	}()
+}
@@ -0,0 +1,34 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import "fmt"
+
+const shearAlertID = "winds-shear"
+
+// updateShearAlert posts a warning alert while winds aloft shear exceeds
+// the configured thresholds and clears it once it no longer does.
+func (c *Controller) updateShearAlert() {
+	if c.WindsAloftSource() == nil {
+		return
+	}
+	warning, altitude := c.WindsAloftSource().ShearWarning()
+	if !warning {
+		c.ClearAlert(shearAlertID)
+		return
+	}
+
+	message := fmt.Sprintf("Strong wind shear at %d ft — consider extra separation", altitude)
+	c.mutex.Lock()
+	existing, ok := c.alerts[shearAlertID]
+	c.mutex.Unlock()
+	if ok && existing.Message == message {
+		return
+	}
+	c.PostAlert(Alert{
+		ID:       shearAlertID,
+		Class:    WeatherAlertClass,
+		Priority: AlertWarning,
+		Message:  message,
+	})
+}
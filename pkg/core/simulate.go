@@ -0,0 +1,51 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"github.com/jumptown-skydiving/manifest-server/pkg/metar"
+	"github.com/jumptown-skydiving/manifest-server/pkg/winds"
+)
+
+// SimulateWeather replaces observed weather with simulated weather. Either
+// may be nil to leave that source alone.
+func (c *Controller) SimulateWeather(m *metar.Simulation, samples []winds.Sample) {
+	var source DataSource
+	if m != nil && c.METARSource() != nil {
+		c.METARSource().Simulate(*m)
+		c.recordChange(metarSourceName)
+		source |= METARDataSource
+	}
+	if samples != nil && c.WindsAloftSource() != nil {
+		c.WindsAloftSource().Simulate(samples)
+		c.recordChange(windsAloftSourceName)
+		source |= WindsAloftDataSource
+	}
+	if source != 0 {
+		c.WakeListeners(source)
+	}
+	if source&WindsAloftDataSource != 0 {
+		c.updateShearAlert()
+	}
+}
+
+// ClearWeatherSimulation restores the use of observed weather.
+func (c *Controller) ClearWeatherSimulation() {
+	var source DataSource
+	if c.METARSource() != nil {
+		c.METARSource().ClearSimulation()
+		c.recordChange(metarSourceName)
+		source |= METARDataSource
+	}
+	if c.WindsAloftSource() != nil {
+		c.WindsAloftSource().ClearSimulation()
+		c.recordChange(windsAloftSourceName)
+		source |= WindsAloftDataSource
+	}
+	if source != 0 {
+		c.WakeListeners(source)
+	}
+	if source&WindsAloftDataSource != 0 {
+		c.updateShearAlert()
+	}
+}
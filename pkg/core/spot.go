@@ -0,0 +1,127 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/jumprun"
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+	"github.com/jumptown-skydiving/manifest-server/pkg/spot"
+	"github.com/jumptown-skydiving/manifest-server/pkg/winds"
+)
+
+// feetPerNauticalMile converts distances in nautical miles to feet.
+const feetPerNauticalMile = 1852.0 / 0.3048
+
+// spotParameters returns the parameters of a jump from the named aircraft at
+// its exit altitude and jump run airspeed. Jump run is flown along the
+// jumprun's track if it is set, or otherwise directly into the wind.
+func (c *Controller) spotParameters(aircraft string) spot.Parameters {
+	p := spot.Parameters{
+		ExitAltitude:    c.settings.AircraftExitAltitude(aircraft),
+		OpeningAltitude: c.settings.SpotOpeningAltitude(),
+		FreefallRate:    c.settings.SpotFreefallRate(),
+		CanopyRate:      c.settings.SpotCanopyRate(),
+		Airspeed:        float64(c.settings.AircraftAirspeed(aircraft)),
+		GroupSpacing:    c.settings.SpotGroupSpacing(),
+	}
+	if c.Jumprun() != nil {
+		if j := c.Jumprun().Jumprun(); j.IsSet {
+			p.Track, p.HasTrack = j.TrueHeading(j.Heading), true
+		}
+	}
+	return p
+}
+
+// Spot returns the drift through the winds aloft and the separation between
+// groups exiting the named aircraft. ok is false if the winds aloft are
+// unknown.
+func (c *Controller) Spot(aircraft string) (spot.Result, bool) {
+	return spot.Calculate(c.windsAloftSamples(), c.spotParameters(aircraft))
+}
+
+// ExitAltitude returns the altitude in feet AGL at which jumpers exit the
+// aircraft flying the next departing load.
+func (c *Controller) ExitAltitude() int {
+	return c.settings.AircraftExitAltitude(c.NextAircraft())
+}
+
+// exitSample returns the winds aloft at the named aircraft's exit altitude,
+// interpolated between the samples either side of it. ok is false if the
+// winds aloft are unknown.
+func (c *Controller) exitSample(aircraft string) (winds.Sample, bool) {
+	if c.WindsAloftSource() == nil {
+		return winds.Sample{}, false
+	}
+	return c.WindsAloftSource().Interpolate(c.settings.AircraftExitAltitude(aircraft))
+}
+
+// windsAloftSamples returns the winds aloft samples, or nil if winds aloft
+// are disabled.
+func (c *Controller) windsAloftSamples() []winds.Sample {
+	if c.WindsAloftSource() == nil {
+		return nil
+	}
+	return c.WindsAloftSource().Samples()
+}
+
+// Drift returns the drift through the winds aloft and the separation between
+// groups for the aircraft flying the next departing load.
+func (c *Controller) Drift() (spot.Result, bool) {
+	return c.Spot(c.NextAircraft())
+}
+
+// ExitSpot is where jumpers should exit to land on the target, which is
+// upwind of it by their drift.
+type ExitSpot struct {
+	Distance float64 // feet from the target
+	Bearing  float64 // degrees true from the target
+	Point    jumprun.Point
+}
+
+// ExitSpot returns the recommended exit spot relative to the target, the
+// jumprun origin. ok is false if the winds aloft or the target are unknown.
+func (c *Controller) ExitSpot() (ExitSpot, bool) {
+	d, ok := c.Drift()
+	if !ok {
+		return ExitSpot{}, false
+	}
+	latitude, longitude, err := c.Coordinates()
+	if err != nil {
+		return ExitSpot{}, false
+	}
+	target := jumprun.Point{Latitude: latitude, Longitude: longitude}
+	bearing := math.Mod(d.Total.Bearing+180.0, 360.0)
+	return ExitSpot{
+		Distance: d.Total.Distance,
+		Bearing:  bearing,
+		Point:    jumprun.Offset(target, jumprun.Point{}, bearing, d.Total.Distance),
+	}, true
+}
+
+// ExitSpotMessage describes the recommended exit spot, e.g. "Spot 0.3 nm
+// upwind on heading 270", or returns an empty string if it is unknown.
+func (c *Controller) ExitSpotMessage() string {
+	s, ok := c.ExitSpot()
+	if !ok {
+		return ""
+	}
+	nm := s.Distance / feetPerNauticalMile
+	if nm < 0.05 {
+		return "Spot over the target"
+	}
+	km := s.Distance * 0.3048 / 1000.0
+	var distance string
+	switch c.settings.Units() {
+	case settings.UnitsMetric:
+		distance = fmt.Sprintf("%.1f km", km)
+	case settings.UnitsBoth:
+		distance = fmt.Sprintf("%.1f nm / %.1f km", nm, km)
+	default:
+		distance = fmt.Sprintf("%.1f nm", nm)
+	}
+	heading := c.DisplayHeading(int(math.Round(s.Bearing)) % 360)
+	return fmt.Sprintf("Spot %s upwind on heading %d", distance, heading)
+}
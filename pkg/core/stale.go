@@ -0,0 +1,52 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import "time"
+
+// staleMargin is how long after data becomes stale listeners are woken, so
+// that the data is past its threshold when the update is constructed.
+const staleMargin = time.Second
+
+// staleWatch wakes the listeners of a data source when its data becomes
+// stale. Listeners are otherwise only woken by refreshes, so displays would
+// never learn that the data that they show has aged.
+type staleWatch chan struct{}
+
+// watchStale starts watching a data source. staleAt returns when the data
+// becomes stale, or false if it never will as it is.
+func (c *Controller) watchStale(source DataSource, staleAt func() (time.Time, bool)) staleWatch {
+	w := make(staleWatch, 1)
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for {
+			var timeout <-chan time.Time
+			if t, ok := staleAt(); ok {
+				if d := t.Sub(c.clock.Now()); d >= 0 {
+					timeout = c.clock.After(d + staleMargin)
+				}
+			}
+			select {
+			case <-c.done:
+				return
+			case <-w:
+			case <-timeout:
+				c.WakeListeners(source)
+			}
+		}
+	}()
+	return w
+}
+
+// refreshed tells the watch that the data source has been refreshed, so
+// that it becomes stale at a different time.
+func (w staleWatch) refreshed() {
+	if w == nil {
+		return
+	}
+	select {
+	case w <- struct{}{}:
+	default:
+	}
+}
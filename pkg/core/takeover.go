@@ -0,0 +1,81 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import "time"
+
+// DefaultTakeoverTTL is how long a takeover is shown when no TTL is given.
+const DefaultTakeoverTTL = 5 * time.Minute
+
+// Takeover is a full-screen message shown on every display in place of the
+// manifest, e.g. to call everyone to a weather briefing.
+type Takeover struct {
+	Message string
+	Posted  time.Time
+	Expires time.Time
+}
+
+// PostTakeover immediately shows a takeover message on all displays for the
+// given duration, replacing any takeover already being shown.
+func (c *Controller) PostTakeover(message string, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = DefaultTakeoverTTL
+	}
+	now := c.clock.Now()
+	t := Takeover{
+		Message: message,
+		Posted:  now,
+		Expires: now.Add(ttl),
+	}
+
+	c.mutex.Lock()
+	c.takeover = &t
+	c.mutex.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		select {
+		case <-c.Done():
+		case <-c.clock.After(ttl):
+			c.expireTakeover()
+		}
+	}()
+	c.WakeListeners(TakeoverDataSource)
+}
+
+// ClearTakeover removes the takeover message, if any.
+func (c *Controller) ClearTakeover() {
+	c.mutex.Lock()
+	ok := c.takeover != nil
+	c.takeover = nil
+	c.mutex.Unlock()
+
+	if ok {
+		c.WakeListeners(TakeoverDataSource)
+	}
+}
+
+func (c *Controller) expireTakeover() {
+	now := c.clock.Now()
+	c.mutex.Lock()
+	expired := c.takeover != nil && !now.Before(c.takeover.Expires)
+	if expired {
+		c.takeover = nil
+	}
+	c.mutex.Unlock()
+
+	if expired {
+		c.WakeListeners(TakeoverDataSource)
+	}
+}
+
+// Takeover returns the takeover message currently being shown.
+func (c *Controller) Takeover() (Takeover, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.takeover == nil || !c.clock.Now().Before(c.takeover.Expires) {
+		return Takeover{}, false
+	}
+	return *c.takeover, true
+}
@@ -0,0 +1,53 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"strconv"
+	"strings"
+)
+
+// highUppers returns the color and text of the high uppers advisory for the
+// named aircraft. The advisory is red when the winds at exit altitude reach
+// the red line or jump run can't make progress into them, and yellow when
+// the ground speed on jump run is below the target. It returns white and an
+// empty string when there's nothing to advise.
+func (c *Controller) highUppers(aircraft string) (uint32, string) {
+	color := uint32(0xffffff)
+	sample, ok := c.exitSample(aircraft)
+	if !ok {
+		return color, ""
+	}
+	speed := sample.Speed
+	if sample.LightAndVariable {
+		speed = 0
+	}
+
+	r, _ := c.Spot(aircraft)
+	redLine := c.settings.HighUppersRedLine()
+	switch {
+	case r.Separation <= 0 || (redLine > 0 && speed >= redLine):
+		color = 0xff0000
+	case float64(c.settings.HighUppersGroundSpeed()) > r.GroundSpeed:
+		color = 0xffff00
+	default:
+		return color, ""
+	}
+
+	groundSpeed := int(r.GroundSpeed)
+	if groundSpeed < 0 {
+		groundSpeed = 0
+	}
+	return color, strings.NewReplacer(
+		"{speed}", strconv.Itoa(speed),
+		"{ground_speed}", strconv.Itoa(groundSpeed),
+	).Replace(c.settings.HighUppersMessage())
+}
+
+// HighUppersAdvisory returns the high uppers advisory for the aircraft
+// flying the next departing load, or an empty string if there's nothing to
+// advise.
+func (c *Controller) HighUppersAdvisory() string {
+	_, advisory := c.highUppers(c.NextAircraft())
+	return advisory
+}
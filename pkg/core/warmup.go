@@ -0,0 +1,76 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// warmupRetryAfter is how long clients are asked to wait before
+	// retrying while the server is warming up.
+	warmupRetryAfter = 2 * time.Second
+
+	// maxWarmup is how long after starting the server stops waiting for
+	// data sources whose first refresh hasn't finished, so that a source
+	// that hangs can't keep the server warming up forever.
+	maxWarmup = time.Minute
+)
+
+// WarmingUp returns the names of the data sources whose first refresh hasn't
+// finished yet, or nil once they all have (successfully or not).
+func (c *Controller) WarmingUp() []string {
+	if c.clock.Now().Sub(c.started) >= maxWarmup {
+		return nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	var pending []string
+	for _, name := range c.launched {
+		if c.health[name].LastRefresh.IsZero() {
+			pending = append(pending, name)
+		}
+	}
+	return pending
+}
+
+// ServeWhenWarm wraps a handler so that while the server is warming up it
+// answers with 503 Service Unavailable and a Retry-After hint instead, so
+// that clients can tell a server that just started from one with nothing to
+// show. JSON clients get a placeholder naming the data sources still being
+// refreshed.
+func (c *Controller) ServeWhenWarm(next func(http.ResponseWriter, *http.Request)) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		pending := c.WarmingUp()
+		if len(pending) == 0 {
+			next(w, req)
+			return
+		}
+
+		retryAfter := int(warmupRetryAfter.Seconds())
+		h := w.Header()
+		h.Set("Retry-After", strconv.Itoa(retryAfter))
+		h.Set("Cache-Control", "no-cache")
+		if strings.Contains(req.Header.Get("Accept"), "protobuf") ||
+			strings.HasSuffix(req.URL.Path, ".pb") {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		data, _ := json.Marshal(struct {
+			Status     string   `json:"status"`
+			RetryAfter int      `json:"retry_after"`
+			Pending    []string `json:"pending"`
+		}{
+			Status:     "warming_up",
+			RetryAfter: retryAfter,
+			Pending:    pending,
+		})
+		h.Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = w.Write(data)
+	}
+}
@@ -0,0 +1,72 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// webhookNotifier delivers notifications by posting them as JSON to a URL.
+// The message is in the text field so that Slack incoming webhooks can be
+// used directly.
+type webhookNotifier struct {
+	settings *settings.Settings
+	client   *http.Client
+	url      string
+}
+
+type webhookPayload struct {
+	Text       string     `json:"text"`
+	Type       string     `json:"type"`
+	Priority   string     `json:"priority"`
+	Time       time.Time  `json:"time"`
+	JumperID   string     `json:"jumper_id,omitempty"`
+	Conditions Conditions `json:"conditions"`
+}
+
+func (w webhookNotifier) Notify(n Notification) error {
+	data, err := json.Marshal(webhookPayload{
+		Text:       n.Message,
+		Type:       n.Type,
+		Priority:   n.Priority.String(),
+		Time:       n.Time,
+		JumperID:   n.JumperID,
+		Conditions: n.Conditions,
+	})
+	if err != nil {
+		return err
+	}
+	request, err := w.settings.NewHTTPRequest(http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(request)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// registerWebhooks registers a webhook notifier for each channel configured
+// in notifications.webhooks.
+func (c *Controller) registerWebhooks(client *http.Client) {
+	for channel, url := range c.settings.NotificationWebhooks() {
+		c.RegisterNotifier(channel, webhookNotifier{
+			settings: c.settings,
+			client:   client,
+			url:      url,
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// (c) Copyright 2026 Matt Messier
+
+package core
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/metar"
+)
+
+// WindObservation is the surface wind reported by an archived METAR.
+type WindObservation struct {
+	Time      time.Time
+	Speed     float64 // knots
+	Gust      float64 // knots; 0 if no gust was reported
+	Direction int     // degrees for display, 1 to 360; 0 if variable or calm
+	Variable  bool
+}
+
+// WindHistory returns the surface winds reported by station over the local
+// date (YYYY-MM-DD), or today if date is empty, oldest first, for graphing
+// how the wind has changed over the day. Station is the most preferred
+// station if it's empty. It returns the station the winds were reported by.
+func (c *Controller) WindHistory(date, station string) (string, []WindObservation, error) {
+	day, station, err := c.historyDay(date, station)
+	if err != nil {
+		return "", nil, err
+	}
+	observations, err := c.windHistory(station, day)
+	return station, observations, err
+}
+
+// windHistory returns the surface winds reported by station over the local
+// day starting at day.
+func (c *Controller) windHistory(station string, day time.Time) ([]WindObservation, error) {
+	archived, err := c.archivedMETARs(station, day)
+	if err != nil {
+		return nil, err
+	}
+
+	var observations []WindObservation
+	for _, a := range archived {
+		w, ok := metar.ParseWindFromRaw(a.RawText)
+		if !ok {
+			continue
+		}
+		o := WindObservation{
+			Time:     a.ObservationTime,
+			Speed:    w.Speed,
+			Gust:     w.Gust,
+			Variable: w.Variable,
+		}
+		if !w.Variable && w.Speed > 0 {
+			// Winds from the north are reported as 360 so that 0 is
+			// left to mean variable or calm.
+			if o.Direction = c.DisplayHeading(int(math.Round(w.Direction))); o.Direction == 0 {
+				o.Direction = 360
+			}
+		}
+		observations = append(observations, o)
+	}
+	return observations, nil
+}
+
+type jsonWindObservation struct {
+	Time      int64   `json:"time"` // Unix time
+	Speed     float64 `json:"speed"`
+	Gust      float64 `json:"gust,omitempty"`
+	Direction int     `json:"direction"`
+	Variable  bool    `json:"variable,omitempty"`
+}
+
+// WindHistoryHandler serves the surface winds reported over a day as JSON
+// for a wind trend graph. The date and station query parameters are as for
+// METARHistoryHandler.
+func (c *Controller) WindHistoryHandler(w http.ResponseWriter, req *http.Request) {
+	day, station, err := c.historyDay(req.URL.Query().Get("date"), req.URL.Query().Get("station"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	observations, err := c.windHistory(station, day)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	doc := struct {
+		Station      string                `json:"station"`
+		Observations []jsonWindObservation `json:"observations"`
+	}{
+		Station:      station,
+		Observations: []jsonWindObservation{},
+	}
+	for _, o := range observations {
+		doc.Observations = append(doc.Observations, jsonWindObservation{
+			Time:      o.Time.Unix(),
+			Speed:     o.Speed,
+			Gust:      o.Gust,
+			Direction: o.Direction,
+			Variable:  o.Variable,
+		})
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
@@ -45,6 +45,41 @@ type Session struct {
 	_  struct{}
 }
 
+// METARObservation is a raw METAR report as received from the weather
+// service.
+type METARObservation struct {
+	Station         string
+	RawText         string
+	ObservationTime time.Time
+	ReceiptTime     time.Time
+}
+
+// Event is an entry in the manifest event log. The event's details are
+// stored as opaque JSON.
+type Event struct {
+	Sequence int64
+	Type     string
+	Time     time.Time
+	Data     []byte
+}
+
+// Acknowledgment records that a display rendered a delivery of a takeover or
+// critical alert.
+type Acknowledgment struct {
+	Delivery string
+	Display  string
+	Channel  string
+	Time     time.Time
+}
+
+// ExitOrder is the exit order a load organizer accepted for a load, as the
+// IDs of each group's leader in the order that they exit.
+type ExitOrder struct {
+	LoadID  int64
+	Leaders []string
+	Time    time.Time
+}
+
 var (
 	ErrInvalidUserID    = errors.New("invalid user ID")
 	ErrInvalidSessionID = errors.New("invalid session ID")
@@ -84,6 +119,62 @@ type Connection interface {
 	AddRole(tx *sql.Tx, user *User, role string) error
 	RemoveRole(tx *sql.Tx, user *User, role string) error
 	QueryRoles(tx *sql.Tx, user *User) ([]string, error)
+
+	// RecordMETAR archives a METAR observation. Recording the same
+	// observation more than once has no effect.
+	RecordMETAR(tx *sql.Tx, o METARObservation) error
+	// QueryMETARs returns the archived observations for a station that
+	// were observed in the range [from, to), oldest first.
+	QueryMETARs(tx *sql.Tx, station string, from, to time.Time) ([]METARObservation, error)
+
+	// RecordEvent appends an event to the event log, setting its
+	// sequence number.
+	RecordEvent(tx *sql.Tx, e *Event) error
+	// QueryEvents returns up to limit events with sequence numbers
+	// greater than after, in order.
+	QueryEvents(tx *sql.Tx, after int64, limit int) ([]Event, error)
+	// CountEvents returns the number of events of a type recorded at or
+	// after a time.
+	CountEvents(tx *sql.Tx, eventType string, since time.Time) (int, error)
+	// DeleteEvents deletes the events recorded before a time. Sequence
+	// numbers are never reused.
+	DeleteEvents(tx *sql.Tx, before time.Time) error
+
+	// StoreState saves a named piece of state shared between servers
+	// using the same database, returning its version. The version only
+	// changes when the data does.
+	StoreState(tx *sql.Tx, name string, data []byte) (int64, error)
+	// QueryState returns a named piece of shared state and its version.
+	// The version is 0 if the state has never been stored.
+	QueryState(tx *sql.Tx, name string) ([]byte, int64, error)
+
+	// RecordAcknowledgment records that a display acknowledged a
+	// delivery. Only the first acknowledgment by each display is kept.
+	RecordAcknowledgment(tx *sql.Tx, a Acknowledgment) error
+	// QueryAcknowledgments returns the acknowledgments of a delivery,
+	// oldest first.
+	QueryAcknowledgments(tx *sql.Tx, delivery string) ([]Acknowledgment, error)
+	// DeleteAcknowledgments deletes the acknowledgments of every delivery
+	// other than those to keep.
+	DeleteAcknowledgments(tx *sql.Tx, keep []string) error
+
+	// StoreExitOrder saves the exit order accepted for a load, replacing
+	// any accepted for it before.
+	StoreExitOrder(tx *sql.Tx, o ExitOrder) error
+	// QueryExitOrders returns every accepted exit order.
+	QueryExitOrders(tx *sql.Tx) ([]ExitOrder, error)
+	// DeleteExitOrders deletes the exit orders accepted for loads.
+	DeleteExitOrders(tx *sql.Tx, loadIDs []int64) error
+
+	// AddDepartures adds to the number of jumpers by jump type who
+	// departed on day, which is formatted as YYYY-MM-DD.
+	AddDepartures(tx *sql.Tx, day string, jumpers map[string]int) error
+	// QueryDepartures returns the number of jumpers by jump type who
+	// departed on day.
+	QueryDepartures(tx *sql.Tx, day string) (map[string]int, error)
+	// DeleteDepartures deletes the departures of every day other than
+	// the one to keep.
+	DeleteDepartures(tx *sql.Tx, keep string) error
 }
 
 func Connect(settings *settings.Settings) (Connection, error) {
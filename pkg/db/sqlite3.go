@@ -4,6 +4,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -61,6 +62,58 @@ CREATE TABLE IF NOT EXISTS users_roles (
 CREATE INDEX IF NOT EXISTS users_roles_userid ON users_roles (userid);
 `
 
+const createMETARsTableSQLite3 = `
+CREATE TABLE IF NOT EXISTS metars (
+	id INTEGER NOT NULL PRIMARY KEY ASC AUTOINCREMENT,
+	station TEXT NOT NULL,
+	raw_text TEXT NOT NULL,
+	observation_time TIMESTAMP NOT NULL,
+	receipt_time TIMESTAMP NOT NULL,
+	UNIQUE (station, observation_time) ON CONFLICT IGNORE);
+CREATE INDEX IF NOT EXISTS metars_observation_time ON metars (station, observation_time);
+`
+
+const createEventsTableSQLite3 = `
+CREATE TABLE IF NOT EXISTS events (
+	sequence INTEGER NOT NULL PRIMARY KEY ASC AUTOINCREMENT,
+	type TEXT NOT NULL,
+	event_time TIMESTAMP NOT NULL,
+	data TEXT NOT NULL);
+CREATE INDEX IF NOT EXISTS events_event_time ON events (event_time);
+`
+
+const createSharedStateTableSQLite3 = `
+CREATE TABLE IF NOT EXISTS shared_state (
+	name TEXT NOT NULL PRIMARY KEY,
+	version INTEGER NOT NULL,
+	update_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	data TEXT NOT NULL);
+`
+
+const createAcknowledgmentsTableSQLite3 = `
+CREATE TABLE IF NOT EXISTS acknowledgments (
+	delivery TEXT NOT NULL,
+	display TEXT NOT NULL,
+	channel TEXT NOT NULL,
+	ack_time TIMESTAMP NOT NULL,
+	PRIMARY KEY (delivery, display) ON CONFLICT IGNORE);
+`
+
+const createExitOrdersTableSQLite3 = `
+CREATE TABLE IF NOT EXISTS exit_orders (
+	load_id INTEGER NOT NULL PRIMARY KEY,
+	leaders TEXT NOT NULL,
+	accept_time TIMESTAMP NOT NULL);
+`
+
+const createDeparturesTableSQLite3 = `
+CREATE TABLE IF NOT EXISTS departures (
+	day TEXT NOT NULL,
+	jump_type TEXT NOT NULL,
+	jumpers INTEGER NOT NULL,
+	PRIMARY KEY (day, jump_type));
+`
+
 type userSQLite3 struct {
 	rowid int64
 }
@@ -96,6 +149,42 @@ func connectViaSQLite3(settings *settings.Settings) (*SQLite3, error) {
 		return nil, err
 	}
 
+	_, err = c.Exec(createMETARsTableSQLite3)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	_, err = c.Exec(createEventsTableSQLite3)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	_, err = c.Exec(createSharedStateTableSQLite3)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	_, err = c.Exec(createAcknowledgmentsTableSQLite3)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	_, err = c.Exec(createExitOrdersTableSQLite3)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
+	_, err = c.Exec(createDeparturesTableSQLite3)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+
 	db := SQLite3{
 		c:        c,
 		settings: settings,
@@ -383,3 +472,242 @@ func (db *SQLite3) QueryRoles(tx *sql.Tx, user *User) ([]string, error) {
 	}
 	return roles, nil
 }
+
+func (db *SQLite3) RecordMETAR(tx *sql.Tx, o METARObservation) error {
+	_, err := tx.Exec("INSERT INTO metars (station, raw_text, observation_time, receipt_time) VALUES ($1, $2, $3, $4);",
+		o.Station, o.RawText, o.ObservationTime.UTC(), o.ReceiptTime.UTC())
+	return err
+}
+
+func (db *SQLite3) QueryMETARs(
+	tx *sql.Tx,
+	station string,
+	from, to time.Time,
+) ([]METARObservation, error) {
+	rs, err := tx.Query("SELECT station, raw_text, observation_time, receipt_time FROM metars WHERE station = $1 AND observation_time >= $2 AND observation_time < $3 ORDER BY observation_time;",
+		station, from.UTC(), to.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	var observations []METARObservation
+	for rs.Next() {
+		var o METARObservation
+		if err = rs.Scan(&o.Station, &o.RawText, &o.ObservationTime, &o.ReceiptTime); err != nil {
+			return nil, err
+		}
+		observations = append(observations, o)
+	}
+	if err = rs.Err(); err != nil {
+		return nil, err
+	}
+	return observations, nil
+}
+
+func (db *SQLite3) RecordEvent(tx *sql.Tx, e *Event) error {
+	r := tx.QueryRow("INSERT INTO events (type, event_time, data) VALUES ($1, $2, $3) RETURNING sequence;",
+		e.Type, e.Time.UTC(), string(e.Data))
+	return r.Scan(&e.Sequence)
+}
+
+func (db *SQLite3) QueryEvents(tx *sql.Tx, after int64, limit int) ([]Event, error) {
+	rs, err := tx.Query("SELECT sequence, type, event_time, data FROM events WHERE sequence > $1 ORDER BY sequence LIMIT $2;",
+		after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	var events []Event
+	for rs.Next() {
+		var (
+			e    Event
+			data string
+		)
+		if err = rs.Scan(&e.Sequence, &e.Type, &e.Time, &data); err != nil {
+			return nil, err
+		}
+		e.Data = []byte(data)
+		events = append(events, e)
+	}
+	if err = rs.Err(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+func (db *SQLite3) CountEvents(tx *sql.Tx, eventType string, since time.Time) (int, error) {
+	var n int
+	r := tx.QueryRow("SELECT COUNT(*) FROM events WHERE type = $1 AND event_time >= $2;",
+		eventType, since.UTC())
+	if err := r.Scan(&n); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (db *SQLite3) DeleteEvents(tx *sql.Tx, before time.Time) error {
+	_, err := tx.Exec("DELETE FROM events WHERE event_time < $1;", before.UTC())
+	return err
+}
+
+func (db *SQLite3) StoreState(tx *sql.Tx, name string, data []byte) (int64, error) {
+	_, err := tx.Exec("INSERT INTO shared_state (name, version, data) VALUES ($1, 1, $2) ON CONFLICT (name) DO UPDATE SET version = version + 1, update_time = CURRENT_TIMESTAMP, data = excluded.data WHERE data != excluded.data;",
+		name, string(data))
+	if err != nil {
+		return 0, err
+	}
+
+	var version int64
+	r := tx.QueryRow("SELECT version FROM shared_state WHERE name = $1;", name)
+	if err = r.Scan(&version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (db *SQLite3) QueryState(tx *sql.Tx, name string) ([]byte, int64, error) {
+	var (
+		data    string
+		version int64
+	)
+	r := tx.QueryRow("SELECT data, version FROM shared_state WHERE name = $1;", name)
+	if err := r.Scan(&data, &version); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, 0, nil
+		}
+		return nil, 0, err
+	}
+	return []byte(data), version, nil
+}
+
+func (db *SQLite3) RecordAcknowledgment(tx *sql.Tx, a Acknowledgment) error {
+	_, err := tx.Exec("INSERT INTO acknowledgments (delivery, display, channel, ack_time) VALUES ($1, $2, $3, $4);",
+		a.Delivery, a.Display, a.Channel, a.Time.UTC())
+	return err
+}
+
+func (db *SQLite3) QueryAcknowledgments(tx *sql.Tx, delivery string) ([]Acknowledgment, error) {
+	rs, err := tx.Query("SELECT delivery, display, channel, ack_time FROM acknowledgments WHERE delivery = $1 ORDER BY ack_time;",
+		delivery)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	var acks []Acknowledgment
+	for rs.Next() {
+		var a Acknowledgment
+		if err = rs.Scan(&a.Delivery, &a.Display, &a.Channel, &a.Time); err != nil {
+			return nil, err
+		}
+		acks = append(acks, a)
+	}
+	if err = rs.Err(); err != nil {
+		return nil, err
+	}
+	return acks, nil
+}
+
+func (db *SQLite3) DeleteAcknowledgments(tx *sql.Tx, keep []string) error {
+	if len(keep) == 0 {
+		_, err := tx.Exec("DELETE FROM acknowledgments;")
+		return err
+	}
+	args := make([]interface{}, len(keep))
+	placeholders := make([]string, len(keep))
+	for i, delivery := range keep {
+		args[i] = delivery
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+	}
+	_, err := tx.Exec("DELETE FROM acknowledgments WHERE delivery NOT IN ("+
+		strings.Join(placeholders, ", ")+");", args...)
+	return err
+}
+
+func (db *SQLite3) StoreExitOrder(tx *sql.Tx, o ExitOrder) error {
+	leaders, err := json.Marshal(o.Leaders)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("INSERT OR REPLACE INTO exit_orders (load_id, leaders, accept_time) VALUES ($1, $2, $3);",
+		o.LoadID, string(leaders), o.Time.UTC())
+	return err
+}
+
+func (db *SQLite3) QueryExitOrders(tx *sql.Tx) ([]ExitOrder, error) {
+	rs, err := tx.Query("SELECT load_id, leaders, accept_time FROM exit_orders ORDER BY load_id;")
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	var orders []ExitOrder
+	for rs.Next() {
+		var (
+			o       ExitOrder
+			leaders string
+		)
+		if err = rs.Scan(&o.LoadID, &leaders, &o.Time); err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal([]byte(leaders), &o.Leaders); err != nil {
+			return nil, err
+		}
+		orders = append(orders, o)
+	}
+	if err = rs.Err(); err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (db *SQLite3) DeleteExitOrders(tx *sql.Tx, loadIDs []int64) error {
+	for _, id := range loadIDs {
+		if _, err := tx.Exec("DELETE FROM exit_orders WHERE load_id = $1;", id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *SQLite3) AddDepartures(tx *sql.Tx, day string, jumpers map[string]int) error {
+	for jumpType, n := range jumpers {
+		_, err := tx.Exec("INSERT INTO departures (day, jump_type, jumpers) VALUES ($1, $2, $3) ON CONFLICT (day, jump_type) DO UPDATE SET jumpers = jumpers + excluded.jumpers;",
+			day, jumpType, n)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *SQLite3) QueryDepartures(tx *sql.Tx, day string) (map[string]int, error) {
+	rs, err := tx.Query("SELECT jump_type, jumpers FROM departures WHERE day = $1;", day)
+	if err != nil {
+		return nil, err
+	}
+	defer rs.Close()
+
+	jumpers := make(map[string]int)
+	for rs.Next() {
+		var (
+			jumpType string
+			n        int
+		)
+		if err = rs.Scan(&jumpType, &n); err != nil {
+			return nil, err
+		}
+		jumpers[jumpType] = n
+	}
+	if err = rs.Err(); err != nil {
+		return nil, err
+	}
+	return jumpers, nil
+}
+
+func (db *SQLite3) DeleteDepartures(tx *sql.Tx, keep string) error {
+	_, err := tx.Exec("DELETE FROM departures WHERE day != $1;", keep)
+	return err
+}
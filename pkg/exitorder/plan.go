@@ -0,0 +1,151 @@
+// (c) Copyright 2026 Matt Messier
+
+// Package exitorder suggests the order in which the groups on a load should
+// exit the aircraft, and the separation between them.
+package exitorder
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+)
+
+// Discipline is the kind of skydive a group is making, which determines
+// where it exits relative to the other groups.
+type Discipline string
+
+const (
+	HopAndPop Discipline = "hop_and_pop"
+	Belly     Discipline = "belly"
+	Freefly   Discipline = "freefly"
+	Tracking  Discipline = "tracking"
+	Wingsuit  Discipline = "wingsuit"
+	Student   Discipline = "student"
+	Tandem    Discipline = "tandem"
+)
+
+// DefaultOrder is the conventional exit order: hop and pops first, then
+// the slowest falling groups through the fastest, students and tandems, and
+// wingsuits last since they fly away from the jumprun.
+var DefaultOrder = []Discipline{
+	HopAndPop, Belly, Freefly, Tracking, Student, Tandem, Wingsuit,
+}
+
+// Group is a group exiting together.
+type Group struct {
+	LeaderID   string     `json:"leader_id"` // the leader's stable ID
+	Name       string     `json:"name"`      // the leader's name
+	Discipline Discipline `json:"discipline"`
+	Size       int        `json:"size"`
+	Separation int        `json:"separation"` // seconds after the previous group
+}
+
+// Classify returns the discipline of a group from its leader.
+func Classify(j *burble.Jumper) Discipline {
+	switch {
+	case j.IsTandem:
+		return Tandem
+	case j.IsStudent:
+		return Student
+	}
+	jumpType := strings.ToLower(j.JumpType)
+	switch {
+	case strings.Contains(jumpType, "hop"):
+		return HopAndPop
+	case strings.Contains(jumpType, "wingsuit"):
+		return Wingsuit
+	case strings.Contains(jumpType, "track"), strings.Contains(jumpType, "angle"):
+		return Tracking
+	case strings.Contains(jumpType, "free"), strings.Contains(jumpType, "head"),
+		strings.Contains(jumpType, "sit"):
+		return Freefly
+	}
+	return Belly
+}
+
+// Plan suggests an exit order for a load. Groups exit by discipline in the
+// given order, and larger groups before smaller ones of the same discipline.
+// Disciplines that aren't in the order exit after those that are. Each group
+// is separated from the previous one by separation seconds, plus change
+// seconds when the discipline changes.
+func Plan(l *burble.Load, order []Discipline, separation, change int) []Group {
+	rank := make(map[Discipline]int, len(order))
+	for i, d := range order {
+		rank[d] = i + 1
+	}
+	rankOf := func(d Discipline) int {
+		if r, ok := rank[d]; ok {
+			return r
+		}
+		return len(order) + 1
+	}
+
+	var groups []Group
+	add := func(j *burble.Jumper) {
+		size := 1
+		j.ForEachGroupMember(func(*burble.Jumper) { size++ })
+		groups = append(groups, Group{
+			LeaderID:   j.StableID,
+			Name:       j.Name,
+			Discipline: Classify(j),
+			Size:       size,
+		})
+	}
+	for _, j := range l.Tandems {
+		add(j)
+	}
+	for _, j := range l.Students {
+		add(j)
+	}
+	for _, j := range l.SportJumpers {
+		add(j)
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		if ri, rj := rankOf(groups[i].Discipline), rankOf(groups[j].Discipline); ri != rj {
+			return ri < rj
+		}
+		return groups[i].Size > groups[j].Size
+	})
+	space(groups, separation, change)
+	return groups
+}
+
+// Reorder reorders a plan to follow the leader IDs given, e.g. when a load
+// organizer has rearranged it. Groups that aren't named follow in their
+// planned order, and IDs of groups that aren't on the plan are ignored.
+func Reorder(plan []Group, ids []string, separation, change int) []Group {
+	position := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if _, ok := position[id]; !ok {
+			position[id] = i
+		}
+	}
+	groups := make([]Group, len(plan))
+	copy(groups, plan)
+	sort.SliceStable(groups, func(i, j int) bool {
+		pi, iok := position[groups[i].LeaderID]
+		pj, jok := position[groups[j].LeaderID]
+		if iok && jok {
+			return pi < pj
+		}
+		return iok && !jok
+	})
+	space(groups, separation, change)
+	return groups
+}
+
+// space sets the separation before each group.
+func space(groups []Group, separation, change int) {
+	for i := range groups {
+		switch {
+		case i == 0:
+			groups[i].Separation = 0
+		case groups[i].Discipline != groups[i-1].Discipline:
+			groups[i].Separation = separation + change
+		default:
+			groups[i].Separation = separation
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// (c) Copyright 2026 Matt Messier
+
+// Package geomag computes the magnetic declination from the World Magnetic
+// Model.
+package geomag
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// WGS 84 ellipsoid
+	semiMajorAxis = 6378.137 // km
+	flattening    = 1 / 298.257223563
+
+	// referenceRadius is the geomagnetic reference radius of the model.
+	referenceRadius = 6371.2 // km
+)
+
+// Declination returns the magnetic declination in degrees, east positive,
+// at a geodetic latitude and longitude in degrees and an altitude in meters
+// above the ellipsoid at time t. Adding the declination to a magnetic
+// heading gives the true heading.
+func Declination(latitude, longitude, altitude float64, t time.Time) float64 {
+	x, y, _ := field(latitude, longitude, altitude/1000.0, decimalYear(t))
+	return math.Atan2(y, x) * 180.0 / math.Pi
+}
+
+// decimalYear returns t as a year and fraction of the year.
+func decimalYear(t time.Time) float64 {
+	t = t.UTC()
+	start := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	return float64(t.Year()) + float64(t.Sub(start))/float64(end.Sub(start))
+}
+
+// field returns the north, east, and down components of the main field in
+// nanotesla at a geodetic latitude and longitude in degrees and altitude in
+// kilometers at a decimal year.
+func field(latitude, longitude, altitude, year float64) (x, y, z float64) {
+	// Convert the geodetic coordinates to geocentric spherical coordinates.
+	phi := latitude * math.Pi / 180.0
+	lambda := longitude * math.Pi / 180.0
+	e2 := flattening * (2.0 - flattening)
+	rc := semiMajorAxis / math.Sqrt(1.0-e2*math.Sin(phi)*math.Sin(phi))
+	p := (rc + altitude) * math.Cos(phi)
+	pz := (rc*(1.0-e2) + altitude) * math.Sin(phi)
+	r := math.Hypot(p, pz)
+	phiPrime := math.Asin(pz / r)
+
+	// Schmidt semi-normalized associated Legendre functions of the sine of
+	// the geocentric latitude, and their derivatives with respect to it.
+	var pnm, dpnm [maxDegree + 1][maxDegree + 1]float64
+	sin, cos := math.Sin(phiPrime), math.Cos(phiPrime)
+	pnm[0][0] = 1.0
+	for n := 1; n <= maxDegree; n++ {
+		if n == 1 {
+			pnm[1][1], dpnm[1][1] = cos, -sin
+		} else {
+			k := math.Sqrt(float64(2*n-1) / float64(2*n))
+			pnm[n][n] = k * cos * pnm[n-1][n-1]
+			dpnm[n][n] = k * (cos*dpnm[n-1][n-1] - sin*pnm[n-1][n-1])
+		}
+		for m := 0; m < n; m++ {
+			k := math.Sqrt(float64(n*n - m*m))
+			a := float64(2*n - 1)
+			pnm[n][m] = a * sin * pnm[n-1][m]
+			dpnm[n][m] = a * (cos*pnm[n-1][m] + sin*dpnm[n-1][m])
+			if n >= 2 {
+				b := math.Sqrt(float64((n-1)*(n-1) - m*m))
+				pnm[n][m] -= b * pnm[n-2][m]
+				dpnm[n][m] -= b * dpnm[n-2][m]
+			}
+			pnm[n][m] /= k
+			dpnm[n][m] /= k
+		}
+	}
+
+	dt := year - epoch
+	var xp, yp, zp float64
+	for _, c := range coefficients {
+		n, m := c.n, c.m
+		g := c.g + dt*c.gDot
+		h := c.h + dt*c.hDot
+		scale := math.Pow(referenceRadius/r, float64(n+2))
+		cosML, sinML := math.Cos(float64(m)*lambda), math.Sin(float64(m)*lambda)
+		xp -= scale * (g*cosML + h*sinML) * dpnm[n][m]
+		yp += scale * float64(m) * (g*sinML - h*cosML) * pnm[n][m]
+		zp -= scale * float64(n+1) * (g*cosML + h*sinML) * pnm[n][m]
+	}
+	yp /= cos
+
+	// Rotate from geocentric to geodetic coordinates.
+	psi := phiPrime - phi
+	x = xp*math.Cos(psi) - zp*math.Sin(psi)
+	z = xp*math.Sin(psi) + zp*math.Cos(psi)
+	return x, yp, z
+}
@@ -0,0 +1,106 @@
+// (c) Copyright 2026 Matt Messier
+
+package jumprun
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Origin returns a jumprun that isn't set, with the origin, magnetic
+// declination, and camera height of the current jumprun. Jumpruns set
+// programmatically start from it so that clients needn't repeat them.
+func (c *Controller) Origin() Jumprun {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return Jumprun{
+		Latitude:            c.jumprun.Latitude,
+		Longitude:           c.jumprun.Longitude,
+		MagneticDeclination: c.jumprun.MagneticDeclination,
+		CameraHeight:        c.jumprun.CameraHeight,
+	}
+}
+
+// Update checks j and makes it the current jumprun, as if it had been
+// entered in the form, and saves it. Like the form, the jumprun is set even
+// if it strays outside of the boundary, and warnings about that are returned.
+func (c *Controller) Update(j Jumprun) ([]string, error) {
+	if err := j.Check(); err != nil {
+		return nil, err
+	}
+	j.TimeStamp = time.Now().Unix()
+	j.IsSet = true
+	c.Set(j)
+	if err := c.Write(); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot save jumprun: %v\n", err)
+	}
+
+	warnings := j.Validate(c.settings.JumprunBoundary(), c.settings.JumprunMaxLength())
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "jumprun warning: %s\n", warning)
+	}
+	return warnings, nil
+}
+
+// Clear unsets the current jumprun and saves it.
+func (c *Controller) Clear() {
+	c.Reset()
+	if err := c.Write(); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot save jumprun: %v\n", err)
+	}
+}
+
+// APIHandler sets the jumprun from a JSON document in the body of a PUT
+// request, with the same fields as the jumprun in the document served by
+// JSONHandler. The origin, magnetic declination, and camera height are those
+// of the current jumprun unless they're given. A DELETE request clears the
+// jumprun. Either responds with the resulting jumprun document.
+func (c *Controller) APIHandler(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodPut:
+		// Hook turns are decoded into a slice rather than the jumprun's
+		// array, which would silently drop any beyond those it holds.
+		body := struct {
+			Jumprun
+			HookTurns []Turn `json:"hook_turns"`
+		}{
+			Jumprun: c.Origin(),
+		}
+		decoder := json.NewDecoder(req.Body)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&body); err != nil {
+			http.Error(w, fmt.Sprintf("cannot parse jumprun: %v", err), http.StatusBadRequest)
+			return
+		}
+		j := body.Jumprun
+		if len(body.HookTurns) > len(j.HookTurns) {
+			http.Error(w, fmt.Sprintf("at most %d hook turns may be given", len(j.HookTurns)),
+				http.StatusBadRequest)
+			return
+		}
+		copy(j.HookTurns[:], body.HookTurns)
+		if _, err := c.Update(j); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	case http.MethodDelete:
+		c.Clear()
+	default:
+		w.Header().Set("Allow", http.MethodPut+", "+http.MethodDelete)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	data, err := json.MarshalIndent(c.Document(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
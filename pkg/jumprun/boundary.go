@@ -0,0 +1,117 @@
+// (c) Copyright 2026 Matt Messier
+
+package jumprun
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// toPlane converts a coordinate into X and Y offsets in feet from the
+// origin using the same flat projection as project.
+func toPlane(origin Point, c settings.Coordinate) Point {
+	return Point{
+		Latitude:  c.Latitude,
+		Longitude: c.Longitude,
+		X: radians(c.Longitude-origin.Longitude) * earthRadiusFeet *
+			math.Cos(radians(origin.Latitude)),
+		Y: radians(c.Latitude-origin.Latitude) * earthRadiusFeet,
+	}
+}
+
+// contains returns true if p is inside polygon, using ray casting.
+func contains(polygon []Point, p Point) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) &&
+			p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func cross(o, a, b Point) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+// intersects returns true if segments p1-p2 and q1-q2 properly cross.
+func intersects(p1, p2, q1, q2 Point) bool {
+	d1 := cross(q1, q2, p1)
+	d2 := cross(q1, q2, p2)
+	d3 := cross(p1, p2, q1)
+	d4 := cross(p1, p2, q2)
+	return ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) &&
+		((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0))
+}
+
+// Length returns the total length of the jumprun in tenths of a mile: the
+// distance to the exit point plus each hook turn leg.
+func (j *Jumprun) Length() int {
+	length := j.ExitDistance
+	if length < 0 {
+		length = -length
+	}
+	for _, t := range j.HookTurns {
+		if t.Distance == 0 && t.Heading == 0 {
+			break
+		}
+		length += t.Distance
+	}
+	return length
+}
+
+// Validate checks the jumprun against the configured boundary polygon and
+// maximum length, returning a warning for each problem found.
+func (j *Jumprun) Validate(boundary []settings.Coordinate, maxLength int) []string {
+	var warnings []string
+	if !j.IsSet {
+		return nil
+	}
+	if maxLength > 0 && j.Length() > maxLength {
+		warnings = append(warnings, fmt.Sprintf(
+			"Jumprun length of %.1f miles exceeds the limit of %.1f miles",
+			float64(j.Length())/10.0, float64(maxLength)/10.0))
+	}
+	if len(boundary) == 0 {
+		return warnings
+	}
+
+	origin, ok := j.Origin()
+	if !ok {
+		return warnings
+	}
+	polygon := make([]Point, len(boundary))
+	for i, c := range boundary {
+		polygon[i] = toPlane(origin, c)
+	}
+
+	names := []string{"Jumprun center", "Exit point"}
+	track := j.GroundTrack()
+	for i, p := range track {
+		if contains(polygon, p) {
+			continue
+		}
+		name := fmt.Sprintf("End of hook turn %d", i-1)
+		if i < len(names) {
+			name = names[i]
+		}
+		warnings = append(warnings, fmt.Sprintf(
+			"%s (%.5f, %.5f) is outside the boundary", name,
+			p.Latitude, p.Longitude))
+	}
+	for i := 1; i < len(track); i++ {
+		for k := range polygon {
+			a, b := polygon[k], polygon[(k+1)%len(polygon)]
+			if intersects(track[i-1], track[i], a, b) {
+				warnings = append(warnings, fmt.Sprintf(
+					"Leg %d of the ground track crosses the boundary", i))
+				break
+			}
+		}
+	}
+	return warnings
+}
@@ -7,7 +7,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"html/template"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
@@ -17,28 +16,42 @@ import (
 	"time"
 
 	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+	"github.com/jumptown-skydiving/manifest-server/pkg/statefile"
 )
 
 type UpdateFunc func()
 
+// SuggestFunc returns a suggested jumprun, or false if there is none.
+type SuggestFunc func() (Jumprun, bool)
+
 type Controller struct {
 	settings      *settings.Settings
 	stateFilename string
 	update        UpdateFunc
+	suggest       SuggestFunc
 
 	lock     sync.Mutex
 	jumprun  Jumprun
+	history  []HistoryEntry // oldest first
 	template *template.Template
 }
 
+// NewController creates a jumprun controller. update is called whenever the
+// jumprun changes, and suggest, if it isn't nil, is offered to the pilot on
+// the jumprun form.
 func NewController(
 	settings *settings.Settings,
 	update UpdateFunc,
+	suggest SuggestFunc,
 ) *Controller {
 	c := &Controller{
 		settings:      settings,
 		stateFilename: settings.JumprunStateFile(),
 		update:        update,
+		suggest:       suggest,
+	}
+	if err := c.restoreHistory(); err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "cannot restore jumprun history: %v\n", err)
 	}
 	if err := c.restore(); err != nil {
 		fmt.Fprintf(os.Stderr, "cannot restore jumprun state: %v\n", err)
@@ -46,7 +59,7 @@ func NewController(
 			TimeStamp:           time.Now().Unix(),
 			Latitude:            settings.JumprunLatitude(),
 			Longitude:           settings.JumprunLongitude(),
-			MagneticDeclination: settings.JumprunMagneticDeclination(),
+			MagneticDeclination: settings.MagneticDeclination(),
 			CameraHeight:        settings.JumprunCameraHeight(),
 		}
 	}
@@ -59,6 +72,21 @@ func (c *Controller) Jumprun() Jumprun {
 	return c.jumprun
 }
 
+// Set replaces the jumprun, returning true if it changed.
+func (c *Controller) Set(j Jumprun) bool {
+	c.lock.Lock()
+	if c.jumprun == j {
+		c.lock.Unlock()
+		return false
+	}
+	c.jumprun = j
+	c.recordHistory(j)
+	c.lock.Unlock()
+
+	c.updateStaticData()
+	return true
+}
+
 func (c *Controller) Reset() {
 	c.lock.Lock()
 	c.jumprun.TimeStamp = time.Now().Unix()
@@ -86,9 +114,6 @@ func (c *Controller) SetFromURLValues(values url.Values) error {
 	if v, err = newj.getIntValue(values, "main_heading", 0); err != nil {
 		return err
 	}
-	if v < 0 || v > 359 {
-		return fmt.Errorf("main heading out of range: %d", v)
-	}
 	newj.Heading = v
 
 	if v, err = newj.getIntValue(values, "exit_distance", 0); err != nil {
@@ -138,9 +163,6 @@ func (c *Controller) SetFromURLValues(values url.Values) error {
 		if v64, err = strconv.ParseInt(value, 10, 32); err != nil {
 			return fmt.Errorf("cannot parse hook heading %d: %v", i, err)
 		}
-		if v64 < 0 || v64 > 359 {
-			return fmt.Errorf("hook heading %d out of range: %d", i, v64)
-		}
 		turn.Heading = int(v64)
 
 		key = fmt.Sprintf("hook_distance_%d", i)
@@ -150,10 +172,14 @@ func (c *Controller) SetFromURLValues(values url.Values) error {
 		turn.Distance = int(v64)
 		newj.HookTurns[i] = turn
 	}
+	if err = newj.Check(); err != nil {
+		return err
+	}
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	c.jumprun = newj
+	c.recordHistory(newj)
 	c.updateStaticData()
 
 	return nil
@@ -166,7 +192,7 @@ func (c *Controller) updateStaticData() {
 }
 
 func (c *Controller) restore() error {
-	dataBytes, err := ioutil.ReadFile(c.stateFilename)
+	dataBytes, err := statefile.Read(c.stateFilename)
 	if err != nil {
 		return err
 	}
@@ -194,11 +220,7 @@ func (c *Controller) Write() error {
 		return err
 	}
 
-	tempFilename := c.stateFilename + ".tmp"
-	if err = ioutil.WriteFile(tempFilename, dataBytes, 0600); err == nil {
-		_ = os.Rename(tempFilename, c.stateFilename)
-	}
-	return err
+	return statefile.Write(c.stateFilename, dataBytes, 0600)
 }
 
 func (c *Controller) initializeTemplate() *template.Template {
@@ -210,8 +232,9 @@ func (c *Controller) initializeTemplate() *template.Template {
 		t = t.Funcs(template.FuncMap{
 			"latitude":             func() string { return c.settings.JumprunLatitude() },
 			"longitude":            func() string { return c.settings.JumprunLongitude() },
-			"magnetic_declination": func() int { return c.settings.JumprunMagneticDeclination() },
+			"magnetic_declination": func() int { return c.settings.MagneticDeclination() },
 			"camera_height":        func() int { return c.settings.JumprunCameraHeight() },
+			"suggestion":           c.suggestion,
 		})
 
 		var err error
@@ -267,8 +290,25 @@ func (c *Controller) FormHandler(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 	}
-	if err := c.SetFromURLValues(req.Form); err == nil {
-		_ = c.Write()
+	if err := c.SetFromURLValues(req.Form); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	_ = c.Write()
+
+	// The jumprun is saved regardless, but the pilot is warned about
+	// anything that strays outside of the boundary
+	j := c.Jumprun()
+	warnings := j.Validate(c.settings.JumprunBoundary(), c.settings.JumprunMaxLength())
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if len(warnings) == 0 {
+		fmt.Fprintf(w, "Jumprun saved\n")
+		return
+	}
+	fmt.Fprintf(w, "Jumprun saved with warnings:\n")
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "jumprun warning: %s\n", warning)
+		fmt.Fprintf(w, "  %s\n", warning)
 	}
 }
 
@@ -276,6 +316,16 @@ const jumprunHTML = `<html>
 	<head>
 		<title>Manifest - Set Jump Run</title>
 		<script>
+		function use_suggestion(submit) {
+			var form = document.getElementById("jumprun");
+			form.elements["main_heading"].value = "{{with suggestion}}{{.Heading}}{{end}}";
+			form.elements["exit_distance"].value = "{{with suggestion}}{{.ExitDistance}}{{end}}";
+			form.elements["offset_heading"].value = "{{with suggestion}}{{.OffsetHeading}}{{end}}";
+			form.elements["offset_distance"].value = "{{with suggestion}}{{.OffsetDistance}}{{end}}";
+			if (submit) {
+				form.requestSubmit();
+			}
+		}
 		function reset_origin() {
 			document.getElementById("latitude").value = "{{latitude}}";
 			document.getElementById("longitude").value = "{{longitude}}";
@@ -318,6 +368,19 @@ const jumprunHTML = `<html>
 					<input type="button" value="Reset to Default" onclick="reset_origin();">
 				</div>
 			</div>
+			{{with suggestion}}
+			<div>
+				<h4>Suggested from the winds aloft:</h4>
+				<div>
+					Heading {{.Heading}}, exit distance {{.ExitDistance}},
+					offset {{.OffsetDistance}} on heading {{.OffsetHeading}}
+				</div>
+				<div>
+					<input type="button" value="Fill In" onclick="use_suggestion(false);">
+					<input type="button" value="Accept" onclick="use_suggestion(true);">
+				</div>
+			</div>
+			{{end}}
 			<div>
 				<h4>Run:</h4>
 				<div>
@@ -0,0 +1,111 @@
+// (c) Copyright 2026 Matt Messier
+
+package jumprun
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Derived is the ground geometry computed from a jumprun's settings.
+// Headings are relative to true north and distances are in feet unless
+// noted otherwise.
+type Derived struct {
+	TrueHeading       float64   `json:"true_heading"`
+	OffsetTrueHeading float64   `json:"offset_true_heading"`
+	HookTrueHeadings  []float64 `json:"hook_true_headings,omitempty"`
+	Length            int       `json:"length"` // tenths of a mile
+	ViewWidth         int       `json:"view_width"`
+	GroundTrack       []Point   `json:"ground_track,omitempty"`
+	Warnings          []string  `json:"warnings,omitempty"`
+}
+
+// Document is the complete description of the jumprun served as JSON: the
+// settings as entered, the geometry derived from them, and when they were
+// last modified.
+type Document struct {
+	Jumprun  Jumprun   `json:"jumprun"`
+	Derived  Derived   `json:"derived"`
+	Modified time.Time `json:"modified"`
+}
+
+// Document returns the complete description of the current jumprun.
+func (c *Controller) Document() Document {
+	return c.document(c.Jumprun())
+}
+
+// document returns the complete description of j.
+func (c *Controller) document(j Jumprun) Document {
+	d := Document{
+		Jumprun:  j,
+		Modified: time.Unix(j.TimeStamp, 0).UTC(),
+		Derived: Derived{
+			ViewWidth: j.ViewWidth(),
+		},
+	}
+	if !j.IsSet {
+		return d
+	}
+
+	d.Derived.TrueHeading = j.TrueHeading(j.Heading)
+	d.Derived.OffsetTrueHeading = j.TrueHeading(j.OffsetHeading)
+	for _, t := range j.HookTurns {
+		if t.Distance == 0 && t.Heading == 0 {
+			break
+		}
+		d.Derived.HookTrueHeadings = append(d.Derived.HookTrueHeadings,
+			j.TrueHeading(t.Heading))
+	}
+	d.Derived.Length = j.Length()
+	d.Derived.GroundTrack = j.GroundTrack()
+	d.Derived.Warnings = j.Validate(c.settings.JumprunBoundary(),
+		c.settings.JumprunMaxLength())
+	return d
+}
+
+// JSONHandler serves the jumprun document. The Last-Modified header is the
+// time the jumprun was last set, so clients can make conditional requests.
+func (c *Controller) JSONHandler(w http.ResponseWriter, req *http.Request) {
+	d := c.Document()
+	data, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	http.ServeContent(w, req, "", d.Modified, bytes.NewReader(data))
+}
+
+// suggestion returns the suggested jumprun, or nil if there is none.
+func (c *Controller) suggestion() *Jumprun {
+	if c.suggest == nil {
+		return nil
+	}
+	j, ok := c.suggest()
+	if !ok {
+		return nil
+	}
+	return &j
+}
+
+// SuggestionHandler serves the complete description of the suggested
+// jumprun, or 404 if there is none.
+func (c *Controller) SuggestionHandler(w http.ResponseWriter, req *http.Request) {
+	j := c.suggestion()
+	if j == nil {
+		http.Error(w, "No jumprun can be suggested", http.StatusNotFound)
+		return
+	}
+	data, err := json.MarshalIndent(c.document(*j), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
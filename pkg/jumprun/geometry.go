@@ -0,0 +1,118 @@
+// (c) Copyright 2026 Matt Messier
+
+package jumprun
+
+import (
+	"math"
+	"strconv"
+)
+
+const (
+	// feetPerTenthMile is the number of feet in each distance unit used
+	// by Jumprun.
+	feetPerTenthMile = 528.0
+
+	// earthRadiusFeet is the mean radius of the earth.
+	earthRadiusFeet = 20902231.0
+
+	// cameraFieldOfView is the horizontal field of view in degrees that
+	// display clients use when rendering the jumprun from CameraHeight.
+	cameraFieldOfView = 60.0
+)
+
+// Point is a location on the ground. X and Y are offsets in feet east and
+// north of the jumprun origin.
+type Point struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	X         float64 `json:"x"`
+	Y         float64 `json:"y"`
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180.0
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180.0 / math.Pi
+}
+
+// Origin returns the location of the jumprun origin, and whether it could be
+// parsed from the jumprun's latitude and longitude.
+func (j *Jumprun) Origin() (Point, bool) {
+	latitude, err := strconv.ParseFloat(j.Latitude, 64)
+	if err != nil {
+		return Point{}, false
+	}
+	longitude, err := strconv.ParseFloat(j.Longitude, 64)
+	if err != nil {
+		return Point{}, false
+	}
+	return Point{Latitude: latitude, Longitude: longitude}, true
+}
+
+// TrueHeading converts a heading relative to magnetic north into a heading
+// relative to true north using the jumprun's magnetic declination.
+func (j *Jumprun) TrueHeading(magnetic int) float64 {
+	return math.Mod(float64(magnetic+j.MagneticDeclination)+720.0, 360.0)
+}
+
+// project returns the point that is distance tenths of a mile from p along
+// the magnetic heading. The projection is a flat plane tangent to the origin,
+// which is plenty accurate over the few miles that a jumprun covers.
+func (j *Jumprun) project(origin, p Point, heading, distance int) Point {
+	return Offset(origin, p, j.TrueHeading(heading), float64(distance)*feetPerTenthMile)
+}
+
+// Offset returns the point that is feet from p along the true heading, where
+// p is relative to origin.
+func Offset(origin, p Point, trueHeading, feet float64) Point {
+	r := radians(trueHeading)
+	x := p.X + feet*math.Sin(r)
+	y := p.Y + feet*math.Cos(r)
+	return Point{
+		Latitude: origin.Latitude + degrees(y/earthRadiusFeet),
+		Longitude: origin.Longitude +
+			degrees(x/(earthRadiusFeet*math.Cos(radians(origin.Latitude)))),
+		X: x,
+		Y: y,
+	}
+}
+
+// ViewWidth returns the width in feet of the ground visible to display
+// clients when viewed from CameraHeight.
+func (j *Jumprun) ViewWidth() int {
+	if j.CameraHeight <= 0 {
+		return 0
+	}
+	w := 2.0 * float64(j.CameraHeight) * math.Tan(radians(cameraFieldOfView/2.0))
+	return int(math.Round(w))
+}
+
+// GroundTrack returns the ground track flown for the jumprun. The first
+// point is the center of the run (the origin moved by the offset), the second
+// is the exit point, and each subsequent point is the end of a hook turn leg,
+// flown in order from the exit point. Nil is returned if the jumprun is not
+// set or its origin is invalid.
+func (j *Jumprun) GroundTrack() []Point {
+	if !j.IsSet {
+		return nil
+	}
+	origin, ok := j.Origin()
+	if !ok {
+		return nil
+	}
+
+	center := j.project(origin, origin, j.OffsetHeading, j.OffsetDistance)
+	exit := j.project(origin, center, j.Heading, j.ExitDistance)
+	track := []Point{center, exit}
+	p := exit
+	for _, t := range j.HookTurns {
+		if t.Distance == 0 && t.Heading == 0 {
+			break
+		}
+		p = j.project(origin, p, t.Heading, t.Distance)
+		track = append(track, p)
+	}
+	return track
+}
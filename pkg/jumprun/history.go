@@ -0,0 +1,164 @@
+// (c) Copyright 2026 Matt Messier
+
+package jumprun
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/statefile"
+)
+
+// ErrUnknownHistoryEntry is returned when restoring a history entry that
+// doesn't exist.
+var ErrUnknownHistoryEntry = errors.New("no such jumprun history entry")
+
+// HistoryEntry is a jumprun as it was set, so that it can be restored if a
+// later one is entered by mistake.
+type HistoryEntry struct {
+	ID      int64     `json:"id"`
+	Time    time.Time `json:"time"`
+	Jumprun Jumprun   `json:"jumprun"`
+}
+
+// recordHistory adds j to the history and saves it, dropping the oldest
+// entries beyond the configured size. Jumpruns that aren't set, such as when
+// the jumprun is cleared at sunrise, aren't recorded. The caller must hold
+// the controller lock.
+func (c *Controller) recordHistory(j Jumprun) {
+	size := c.settings.JumprunHistorySize()
+	if !j.IsSet || size <= 0 {
+		return
+	}
+	e := HistoryEntry{
+		ID:      1,
+		Time:    time.Now(),
+		Jumprun: j,
+	}
+	if n := len(c.history); n > 0 {
+		e.ID = c.history[n-1].ID + 1
+	}
+	c.history = append(c.history, e)
+	if len(c.history) > size {
+		c.history = append([]HistoryEntry(nil), c.history[len(c.history)-size:]...)
+	}
+	if err := c.writeHistory(); err != nil {
+		fmt.Fprintf(os.Stderr, "cannot save jumprun history: %v\n", err)
+	}
+}
+
+// writeHistory saves the history to the history file, if one is configured.
+// The caller must hold the controller lock.
+func (c *Controller) writeHistory() error {
+	filename := c.settings.JumprunHistoryFile()
+	if filename == "" {
+		return nil
+	}
+	data, err := json.Marshal(c.history)
+	if err != nil {
+		return err
+	}
+	return statefile.Write(filename, data, 0600)
+}
+
+// restoreHistory loads the history from the history file, if one is
+// configured.
+func (c *Controller) restoreHistory() error {
+	filename := c.settings.JumprunHistoryFile()
+	if filename == "" {
+		return nil
+	}
+	data, err := statefile.Read(filename)
+	if err != nil {
+		return err
+	}
+	var history []HistoryEntry
+	if err = json.Unmarshal(data, &history); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.history = history
+	return nil
+}
+
+// History returns the jumpruns that have been set, newest first.
+func (c *Controller) History() []HistoryEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	history := make([]HistoryEntry, 0, len(c.history))
+	for i := len(c.history) - 1; i >= 0; i-- {
+		history = append(history, c.history[i])
+	}
+	return history
+}
+
+// Restore makes the jumprun in the history entry with the given ID the
+// current jumprun again, as if it had just been set, and saves it.
+func (c *Controller) Restore(id int64) error {
+	c.lock.Lock()
+	var (
+		j     Jumprun
+		found bool
+	)
+	for _, e := range c.history {
+		if e.ID == id {
+			j, found = e.Jumprun, true
+			break
+		}
+	}
+	if !found {
+		c.lock.Unlock()
+		return fmt.Errorf("%w: %d", ErrUnknownHistoryEntry, id)
+	}
+	j.TimeStamp = time.Now().Unix()
+	c.jumprun = j
+	c.recordHistory(j)
+	c.lock.Unlock()
+
+	c.updateStaticData()
+	return c.Write()
+}
+
+// HistoryHandler serves the jumprun history as JSON, newest first.
+func (c *Controller) HistoryHandler(w http.ResponseWriter, req *http.Request) {
+	data, err := json.MarshalIndent(c.History(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
+
+// RestoreHandler restores the jumprun in the history entry given by the id
+// form value.
+func (c *Controller) RestoreHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.ParseInt(req.FormValue("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "A history entry id is required", http.StatusBadRequest)
+		return
+	}
+	if err = c.Restore(id); errors.Is(err, ErrUnknownHistoryEntry) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintf(w, "Jumprun restored\n")
+}
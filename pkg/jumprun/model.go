@@ -49,3 +49,45 @@ func (j *Jumprun) getCoordinate(values url.Values, key string, defaultValue stri
 	}
 	return v, nil
 }
+
+// Check returns an error describing the first setting of the jumprun that is
+// out of range.
+func (j Jumprun) Check() error {
+	if j.Heading < 0 || j.Heading > 359 {
+		return fmt.Errorf("main heading out of range: %d", j.Heading)
+	}
+	if j.ExitDistance < 0 {
+		return fmt.Errorf("exit distance out of range: %d", j.ExitDistance)
+	}
+	if j.OffsetHeading < 0 || j.OffsetHeading > 359 {
+		return fmt.Errorf("offset heading out of range: %d", j.OffsetHeading)
+	}
+	if j.OffsetDistance < 0 {
+		return fmt.Errorf("offset distance out of range: %d", j.OffsetDistance)
+	}
+	for i, t := range j.HookTurns {
+		if t.Heading < 0 || t.Heading > 359 {
+			return fmt.Errorf("hook heading %d out of range: %d", i, t.Heading)
+		}
+		if t.Distance < 0 {
+			return fmt.Errorf("hook distance %d out of range: %d", i, t.Distance)
+		}
+	}
+	if j.MagneticDeclination < -180 || j.MagneticDeclination > 180 {
+		return fmt.Errorf("magnetic declination out of range: %d", j.MagneticDeclination)
+	}
+	if j.CameraHeight < 0 {
+		return fmt.Errorf("camera height out of range: %d", j.CameraHeight)
+	}
+	if v, err := strconv.ParseFloat(j.Latitude, 64); err != nil {
+		return fmt.Errorf("cannot parse latitude: %w", err)
+	} else if v < -90 || v > 90 {
+		return fmt.Errorf("latitude out of range: %s", j.Latitude)
+	}
+	if v, err := strconv.ParseFloat(j.Longitude, 64); err != nil {
+		return fmt.Errorf("cannot parse longitude: %w", err)
+	} else if v < -180 || v > 180 {
+		return fmt.Errorf("longitude out of range: %s", j.Longitude)
+	}
+	return nil
+}
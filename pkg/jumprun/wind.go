@@ -0,0 +1,35 @@
+// (c) Copyright 2026 Matt Messier
+
+package jumprun
+
+import "math"
+
+// WindCorrection is the heading an aircraft must fly to make good a ground
+// track in a wind.
+type WindCorrection struct {
+	Heading     float64 // degrees true
+	Correction  float64 // degrees; positive is a correction to the right
+	GroundSpeed float64 // knots
+}
+
+// CorrectForWind returns the heading to fly to make good track (degrees
+// true) at a true airspeed of tas knots in a wind blowing from windFrom
+// (degrees true) at windSpeed knots. ok is false if the crosswind is too
+// strong for the track to be flown, or if the resulting ground speed would
+// not be positive.
+func CorrectForWind(track, tas, windFrom, windSpeed float64) (c WindCorrection, ok bool) {
+	if tas <= 0 {
+		return c, false
+	}
+	angle := radians(windFrom - track)
+	crosswind := windSpeed * math.Sin(angle)
+	if math.Abs(crosswind) > tas {
+		return c, false
+	}
+	wca := math.Asin(crosswind / tas)
+
+	c.Correction = degrees(wca)
+	c.Heading = math.Mod(track+c.Correction+720.0, 360.0)
+	c.GroundSpeed = tas*math.Cos(wca) - windSpeed*math.Cos(angle)
+	return c, c.GroundSpeed > 0
+}
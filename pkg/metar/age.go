@@ -0,0 +1,44 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import "time"
+
+// ObservationTime returns the time at which the current report was observed,
+// or false if it's not known.
+func (c *Controller) ObservationTime() (time.Time, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	r := report{fields: c.fields}
+	return r.observationTime()
+}
+
+// ObservationAge returns how long ago the current report was observed, or
+// false if it's not known.
+func (c *Controller) ObservationAge() (time.Duration, bool) {
+	t, ok := c.ObservationTime()
+	if !ok {
+		return 0, false
+	}
+	return c.now().Sub(t), true
+}
+
+// StaleTime returns when the current report becomes stale, maxAge after it
+// was observed. Simulated weather, reports whose observation time is not
+// known, and a maxAge of zero never become stale, so ok is false.
+func (c *Controller) StaleTime(maxAge time.Duration) (t time.Time, ok bool) {
+	if maxAge <= 0 || c.IsSimulated() {
+		return time.Time{}, false
+	}
+	if t, ok = c.ObservationTime(); !ok {
+		return time.Time{}, false
+	}
+	return t.Add(maxAge), true
+}
+
+// IsStale returns true if the current report was observed more than maxAge
+// ago.
+func (c *Controller) IsStale(maxAge time.Duration) bool {
+	t, ok := c.StaleTime(maxAge)
+	return ok && c.now().After(t)
+}
@@ -0,0 +1,79 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import "math"
+
+// ApparentKind identifies how an apparent temperature was computed.
+type ApparentKind string
+
+const (
+	WindChill ApparentKind = "Wind chill"
+	HeatIndex ApparentKind = "Heat index"
+)
+
+const (
+	// windChillMaxCelsius and windChillMinKPH are the conditions under
+	// which wind chill is defined.
+	windChillMaxCelsius = 10.0
+	windChillMinKPH     = 4.8
+
+	// heatIndexMinCelsius is the temperature at or above which the heat
+	// index is reported.
+	heatIndexMinCelsius = 27.0
+)
+
+// windChill returns the wind chill in degrees Celsius for a temperature in
+// degrees Celsius and a wind speed in km/h, using the formula adopted by
+// the NWS and Environment Canada in 2001.
+func windChill(celsius, kph float64) float64 {
+	v := math.Pow(kph, 0.16)
+	return 13.12 + 0.6215*celsius - 11.37*v + 0.3965*celsius*v
+}
+
+// heatIndex returns the heat index in degrees Fahrenheit for a temperature
+// in degrees Fahrenheit and a relative humidity in percent, using the NWS
+// Rothfusz regression and its adjustments for very dry and very humid air.
+func heatIndex(t, rh float64) float64 {
+	hi := 0.5 * (t + 61.0 + (t-68.0)*1.2 + rh*0.094)
+	if (hi+t)/2.0 < 80.0 {
+		return hi
+	}
+
+	hi = -42.379 + 2.04901523*t + 10.14333127*rh -
+		0.22475541*t*rh - 0.00683783*t*t - 0.05481717*rh*rh +
+		0.00122874*t*t*rh + 0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+	switch {
+	case rh < 13.0 && t >= 80.0 && t <= 112.0:
+		hi -= ((13.0 - rh) / 4.0) * math.Sqrt((17.0-math.Abs(t-95.0))/17.0)
+	case rh > 85.0 && t >= 80.0 && t <= 87.0:
+		hi += ((rh - 85.0) / 10.0) * ((87.0 - t) / 5.0)
+	}
+	return hi
+}
+
+// ApparentTemperature returns the wind chill when it's cold and windy, or
+// the heat index when it's hot, in degrees Celsius. It returns false when
+// neither applies or the observations needed to compute it are missing.
+func (c *Controller) ApparentTemperature() (ApparentKind, float64, bool) {
+	temp, ok := c.TemperatureCelsius()
+	if !ok {
+		return "", 0.0, false
+	}
+	switch {
+	case temp <= windChillMaxCelsius:
+		kph := KPHFromKnots(c.WindSpeedKnots())
+		if kph < windChillMinKPH {
+			return "", 0.0, false
+		}
+		return WindChill, windChill(temp, kph), true
+	case temp >= heatIndexMinCelsius:
+		rh, ok := c.RelativeHumidity()
+		if !ok {
+			return "", 0.0, false
+		}
+		hi := heatIndex(FahrenheitFromCelsius(temp), rh)
+		return HeatIndex, (hi - 32.0) * 5.0 / 9.0, true
+	}
+	return "", 0.0, false
+}
@@ -0,0 +1,116 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+// FlightCategory is the flight category of the current conditions, from
+// their ceiling and visibility.
+type FlightCategory string
+
+const (
+	UnknownFlightCategory FlightCategory = ""
+	VFR                   FlightCategory = "VFR"
+	MVFR                  FlightCategory = "MVFR"
+	IFR                   FlightCategory = "IFR"
+	LIFR                  FlightCategory = "LIFR"
+)
+
+// Color returns the conventional color used to show the flight category.
+func (f FlightCategory) Color() uint32 {
+	switch f {
+	case VFR:
+		return 0x00ff00 // green
+	case MVFR:
+		return 0x4080ff // blue
+	case IFR:
+		return 0xff0000 // red
+	case LIFR:
+		return 0xff00ff // magenta
+	}
+	return 0xffffff
+}
+
+// ceilingFeet returns the ceiling in feet AGL, which is the lowest broken or
+// overcast layer, or the vertical visibility into an obscuration. The caller
+// must hold the controller lock.
+func (c *Controller) ceilingFeet() (int, bool) {
+	if c.ceiling > 0 {
+		return c.ceiling, true
+	}
+	if vv, ok := c.floatField("vert_vis_ft"); ok {
+		return int(vv), true
+	}
+	return 0, false
+}
+
+// CeilingFeet returns the ceiling in feet AGL. It returns false if there is
+// no ceiling or no report.
+func (c *Controller) CeilingFeet() (int, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.fields == nil {
+		return 0, false
+	}
+	return c.ceilingFeet()
+}
+
+// Clearances are the operations cleared to jump under the current ceiling.
+type Clearances struct {
+	Tandems  bool
+	Students bool
+}
+
+// ceilingClears returns true if the ceiling is at least minimum feet. A
+// minimum of 0 is always cleared. The caller must hold the controller lock.
+func (c *Controller) ceilingClears(minimum int) bool {
+	if minimum <= 0 {
+		return true
+	}
+	ceiling, ok := c.ceilingFeet()
+	return !ok || ceiling >= minimum
+}
+
+// Clearances returns the operations cleared to jump under the current
+// ceiling and the configured minimums. Without a report there is nothing
+// to hold for, so everything is cleared.
+func (c *Controller) Clearances() Clearances {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.fields == nil {
+		return Clearances{Tandems: true, Students: true}
+	}
+	return Clearances{
+		Tandems:  c.ceilingClears(c.settings.TandemCeilingMinimum()),
+		Students: c.ceilingClears(c.settings.StudentCeilingMinimum()),
+	}
+}
+
+// FlightCategory returns the flight category computed from the ceiling and
+// visibility. It is unknown if there is no visibility report; conditions
+// without a ceiling have unlimited ceiling.
+func (c *Controller) FlightCategory() FlightCategory {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.fields == nil {
+		return UnknownFlightCategory
+	}
+	visibility, _, ok := c.visibility()
+	if !ok {
+		return UnknownFlightCategory
+	}
+	ceiling, ok := c.ceilingFeet()
+	if !ok {
+		ceiling = 1 << 30
+	}
+
+	switch {
+	case ceiling < 500 || visibility < 1.0:
+		return LIFR
+	case ceiling < 1000 || visibility < 3.0:
+		return IFR
+	case ceiling <= 3000 || visibility <= 5.0:
+		return MVFR
+	}
+	return VFR
+}
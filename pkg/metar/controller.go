@@ -8,10 +8,12 @@ import (
 	"io/ioutil"
 	"math"
 	"net/http"
+	"os"
 	"reflect"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
 )
@@ -126,37 +128,125 @@ func weatherCondition(wx string) string {
 	return strings.Join(results, ", ")
 }
 
+// Fetcher retrieves raw METAR data.
+type Fetcher interface {
+	Fetch(station string) ([]byte, error)
+}
+
 type Controller struct {
 	settings *settings.Settings
+	fetcher  Fetcher
+	now      func() time.Time
+	quota    *quotaTracker
 
 	lock        sync.Mutex
 	fields      map[string]interface{}
 	skyCover    string
 	wxCondition string
+	ceiling     int
+	windHistory []windObservation
+	history     history
+	peakGust    windObservation
+	simulation  *Simulation
+	degraded    bool // the report is the last good one; the latest refresh failed
 }
 
 func NewController(settings *settings.Settings) *Controller {
-	return &Controller{
+	return NewControllerWithFetcher(settings, nil, nil, nil)
+}
+
+// NewControllerWithFetcher creates a controller that retrieves observations
+// using fetcher and records them at the times returned by now. Nil values use
+// aviationweather.gov and the system clock. The default fetcher makes its
+// requests with client, or a client from settings if client is nil.
+func NewControllerWithFetcher(
+	settings *settings.Settings,
+	fetcher Fetcher,
+	client *http.Client,
+	now func() time.Time,
+) *Controller {
+	if now == nil {
+		now = time.Now
+	}
+	quota := &quotaTracker{}
+	if fetcher == nil {
+		if client == nil {
+			client = settings.NewHTTPClient()
+		}
+		fetcher = metarFetcher{client: client, quota: quota, now: now}
+	}
+	c := &Controller{
 		settings: settings,
+		fetcher:  fetcher,
+		now:      now,
+		quota:    quota,
+	}
+	if err := c.restore(); err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "cannot restore METAR state: %v\n", err)
 	}
+	return c
 }
 
 const metarURL = "https://aviationweather.gov/cgi-bin/data/dataserver.php?datasource=metars&requesttype=retrieve&format=csv&hoursBeforeNow=24&mostRecent=true"
 
-// Refresh retrieves and parses weather data.
-func (c *Controller) Refresh() (bool, error) {
-	url := fmt.Sprintf("%s&stationString=%s", metarURL, c.settings.METARStation())
-	resp, err := http.Get(url)
+type metarFetcher struct {
+	client *http.Client
+	quota  *quotaTracker
+	now    func() time.Time
+}
+
+func (f metarFetcher) Fetch(station string) ([]byte, error) {
+	url := fmt.Sprintf("%s&stationString=%s", metarURL, station)
+	resp, err := f.client.Get(url)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer resp.Body.Close()
+	if err = f.quota.observe(resp, f.now()); err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// report is a parsed METAR observation.
+type report struct {
+	station     string
+	data        []byte // as fetched
+	fields      map[string]interface{}
+	skyCover    string
+	wxCondition string
+	ceiling     int // feet AGL; 0 if there is no ceiling
+}
+
+// observationTime returns the time the report was observed, or false if it's
+// not known.
+func (r *report) observationTime() (time.Time, bool) {
+	if s, ok := r.fields["observation_time"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
 
-	data, err := ioutil.ReadAll(resp.Body)
+// fetch retrieves and parses the latest observation from a station.
+func (c *Controller) fetch(station string) (*report, error) {
+	data, err := c.fetcher.Fetch(station)
 	if err != nil {
-		return false, err
+		return nil, err
+	}
+	r, err := parseReport(data)
+	if err != nil {
+		return nil, err
 	}
+	r.station, r.data = station, data
+	return r, nil
+}
 
+// parseReport parses the latest observation from the data fetched for a
+// station.
+func parseReport(data []byte) (*report, error) {
 	// There should be at least 5 lines. Any less is invalid data.
 	// Line 0: "No errors"
 	// Line 1: "No warnings"
@@ -171,21 +261,22 @@ func (c *Controller) Refresh() (bool, error) {
 			l = strings.TrimSpace(l)
 			fmt.Printf("Line %d: %s\n", i, l)
 		}
-		return false, fmt.Errorf("Too few lines (expected >= 5; got %d)",
+		return nil, fmt.Errorf("Too few lines (expected >= 5; got %d)",
 			len(lines))
 	}
 
 	nresults, err := strconv.Atoi(strings.Fields(strings.TrimSpace(lines[4]))[0])
 	if err != nil {
-		return false, fmt.Errorf("Error parsing # results: %v", err)
+		return nil, fmt.Errorf("Error parsing # results: %v", err)
 	}
 	if nresults < 1 {
-		return false, errors.New("No results")
+		return nil, errNoResults
 	}
 
 	var (
 		lowClouds, highClouds []string
 		wxCondition           string
+		ceiling               int
 	)
 
 	parsedFields := make(map[string]interface{})
@@ -202,6 +293,10 @@ func (c *Controller) Refresh() (bool, error) {
 				if err != nil {
 					break
 				}
+				if (fields[i] == "BKN" || fields[i] == "OVC") &&
+					(ceiling == 0 || base < ceiling) {
+					ceiling = base
+				}
 				switch fields[i] {
 				case "FEW":
 					lowClouds = append(lowClouds, fmt.Sprintf("few at %d", base))
@@ -240,85 +335,209 @@ func (c *Controller) Refresh() (bool, error) {
 		}
 	}
 
-	c.lock.Lock()
-	defer c.lock.Unlock()
-
-	changed := false
-	if !reflect.DeepEqual(c.fields, parsedFields) {
-		c.fields = parsedFields
-		changed = true
-	}
 	skyCover := "clear"
 	if len(highClouds) > 0 {
 		skyCover = strings.Join(highClouds, ", ")
 	} else if len(lowClouds) > 0 {
 		skyCover = strings.Join(lowClouds, ", ")
 	}
-	if c.skyCover != skyCover {
-		c.skyCover = skyCover
+	return &report{
+		fields:      parsedFields,
+		skyCover:    skyCover,
+		wxCondition: wxCondition,
+		ceiling:     ceiling,
+	}, nil
+}
+
+// Refresh retrieves and parses weather data. Stations are tried in order of
+// preference, falling through to the next when a station returns nothing
+// usable or its latest report is too old. If every station's report is too
+// old, the most preferred one that reported is used.
+//
+// Nothing is requested while the weather service's rate limit is in effect.
+// Stations that had no report aren't asked again for a while.
+//
+// If no report can be retrieved, the last good report is kept and marked as
+// degraded until a refresh succeeds.
+func (c *Controller) Refresh() (bool, error) {
+	changed, err := c.refresh()
+	if err != nil {
+		c.lock.Lock()
+		c.degraded = c.fields != nil
+		c.lock.Unlock()
+	}
+	return changed, err
+}
+
+func (c *Controller) refresh() (bool, error) {
+	stations := c.settings.METARStations()
+	if len(stations) == 0 {
+		return false, errors.New("No METAR stations configured")
+	}
+	if until, paused := c.quota.pausedUntil(c.now()); paused {
+		return false, fmt.Errorf("Rate limited until %s", until.Format(time.RFC3339))
+	}
+
+	var (
+		r   *report
+		err error
+	)
+	maxAge := c.settings.METARMaxAge()
+	for _, station := range stations {
+		if c.quota.hasNoData(station, c.now()) {
+			err = fmt.Errorf("%s: %w", station, errNoResults)
+			continue
+		}
+		var sr *report
+		if sr, err = c.fetch(station); err != nil {
+			var rateLimited *RateLimitError
+			if errors.As(err, &rateLimited) {
+				// Don't ask any more stations; the limit applies
+				// to all of them.
+				pause := rateLimited.RetryAfter
+				if pause <= 0 {
+					pause = c.settings.METARRateLimitPause()
+				}
+				c.quota.pause(c.now().Add(pause))
+				err = fmt.Errorf("%s: %w", station, err)
+				break
+			}
+			if errors.Is(err, errNoResults) {
+				c.quota.setNoData(station, c.now().Add(c.settings.METARNoDataRetry()))
+			}
+			err = fmt.Errorf("%s: %w", station, err)
+			continue
+		}
+		if r == nil {
+			r = sr
+		}
+		if t, ok := sr.observationTime(); ok && c.now().Sub(t) <= maxAge {
+			r = sr
+			break
+		}
+	}
+	if r == nil {
+		return false, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	changed := c.degraded
+	c.degraded = false
+	if !reflect.DeepEqual(c.fields, r.fields) {
+		c.fields = r.fields
 		changed = true
 	}
-	if c.wxCondition != wxCondition {
-		c.wxCondition = wxCondition
+	if c.skyCover != r.skyCover {
+		c.skyCover = r.skyCover
 		changed = true
 	}
+	if c.wxCondition != r.wxCondition {
+		c.wxCondition = r.wxCondition
+		changed = true
+	}
+	if c.ceiling != r.ceiling {
+		c.ceiling = r.ceiling
+		changed = true
+	}
+	if c.recordWindObservation(c.now()) {
+		changed = true
+	}
+	c.recordObservation(c.now())
+	if changed {
+		if err := c.save(r); err != nil {
+			fmt.Fprintf(os.Stderr, "cannot save METAR state: %v\n", err)
+		}
+	}
 
 	return changed, nil
 }
 
+// floatField returns the named field as a float64, taking any simulated value
+// in preference to the observed value. The caller must hold the controller
+// lock.
+func (c *Controller) floatField(name string) (float64, bool) {
+	value, ok := c.simulation.field(name)
+	if !ok {
+		value = c.fields[name]
+	}
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0.0, false
+}
+
 // WindSpeedMPH returns the current wind speed in MPH.
 func (c *Controller) WindSpeedMPH() float64 {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	var speed float64
-	switch v := c.fields["wind_speed_kt"].(type) {
-	case float64:
-		speed = v
-	case int64:
-		speed = float64(v)
-	default:
-		return 0.0
-	}
+	speed, _ := c.floatField("wind_speed_kt")
 	return MPHFromKnots(speed)
 }
 
+// WindSpeedKnots returns the current wind speed in knots.
+func (c *Controller) WindSpeedKnots() float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	speed, _ := c.floatField("wind_speed_kt")
+	return speed
+}
+
 // WindGustSpeedMPH returns current wind gust speed in MPH.
 func (c *Controller) WindGustSpeedMPH() float64 {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	var gusting float64
-	switch v := c.fields["wind_gust_kt"].(type) {
-	case float64:
-		gusting = v
-	case int64:
-		gusting = float64(v)
-	default:
-		return 0.0
-	}
+	gusting, _ := c.floatField("wind_gust_kt")
 	return MPHFromKnots(gusting)
 }
 
-// WindDirectionDegrees returns the current wind direction in degrees.
-func (c *Controller) WindDirectionDegrees() float64 {
+// WindGustSpeedKnots returns the current wind gust speed in knots.
+func (c *Controller) WindGustSpeedKnots() float64 {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	var windDirectionDegrees float64
-	switch v := c.fields["wind_dir_degrees"].(type) {
-	case float64:
-		windDirectionDegrees = v
-	case int64:
-		windDirectionDegrees = float64(v)
-	default:
-		return 0.0
+	gusting, _ := c.floatField("wind_gust_kt")
+	return gusting
+}
+
+// WindDirectionTrueDegrees returns the current wind direction in degrees
+// relative to true north, as reported.
+func (c *Controller) WindDirectionTrueDegrees() float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	windDirectionDegrees, _ := c.floatField("wind_dir_degrees")
+	return windDirectionDegrees
+}
+
+// WindDirectionMagneticDegrees returns the current wind direction in degrees
+// relative to magnetic north.
+func (c *Controller) WindDirectionMagneticDegrees() float64 {
+	return c.magneticFromTrue(c.WindDirectionTrueDegrees())
+}
+
+// WindDirectionDegrees returns the current wind direction in degrees relative
+// to the configured heading reference.
+func (c *Controller) WindDirectionDegrees() float64 {
+	return c.displayDirection(c.WindDirectionTrueDegrees())
+}
+
+func (c *Controller) magneticFromTrue(degrees float64) float64 {
+	return float64(((int(degrees)-c.settings.MagneticDeclination())%360 + 360) % 360)
+}
+
+func (c *Controller) displayDirection(degrees float64) float64 {
+	if c.settings.MagneticHeadings() {
+		return c.magneticFromTrue(degrees)
 	}
-	// Adjust true north to magnetic north. Magnetic deviance for Orange, MA is -14.7
-	// This is a little gross, but use jumprun's magnetic declination setting
-	return float64((int(windDirectionDegrees) + c.settings.JumprunMagneticDeclination() + 360) % 360)
+	return degrees
 }
 
 // WindConditions returns the current wind conditions as a human-readable string.
 func (c *Controller) WindConditions() string {
-	speed := c.WindSpeedMPH()
+	speed := c.WindSpeedKnots()
 	if speed <= 0 {
 		return "light and variable"
 	}
@@ -326,14 +545,15 @@ func (c *Controller) WindConditions() string {
 	windDirectionDegrees := c.WindDirectionDegrees()
 	windDirection := CardinalDirection(windDirectionDegrees)
 
-	gusting := c.WindGustSpeedMPH()
+	units := c.settings.Units()
+	gusting := c.WindGustSpeedKnots()
 	if gusting > 0 {
-		return fmt.Sprintf("%d MPH gusting to %d MPH from %d° (%s)",
-			int64(speed), int64(gusting),
+		return fmt.Sprintf("%s gusting to %s from %d° (%s)",
+			FormatSpeed(speed, units), FormatSpeed(gusting, units),
 			int64(windDirectionDegrees), windDirection)
 	}
-	return fmt.Sprintf("%d MPH from %d° (%s)",
-		int64(speed), int64(windDirectionDegrees), windDirection)
+	return fmt.Sprintf("%s from %d° (%s)",
+		FormatSpeed(speed, units), int64(windDirectionDegrees), windDirection)
 }
 
 // WeatherConditions returns a human-readable description of current weather
@@ -341,6 +561,9 @@ func (c *Controller) WindConditions() string {
 func (c *Controller) WeatherConditions() string {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	if s := c.simulation; s != nil && s.Weather != "" {
+		return s.Weather
+	}
 	if c.wxCondition == "" {
 		return "data error"
 	}
@@ -351,28 +574,62 @@ func (c *Controller) WeatherConditions() string {
 func (c *Controller) SkyCover() string {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	if s := c.simulation; s != nil && s.SkyCover != "" {
+		return s.SkyCover
+	}
 	if c.skyCover == "" {
 		return "data error"
 	}
 	return c.skyCover
 }
 
+// TemperatureCelsius returns the temperature in degrees Celsius.
+func (c *Controller) TemperatureCelsius() (float64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.floatField("temp_c")
+}
+
 // TemperatureString returns a human-readable temperature string
 func (c *Controller) TemperatureString() string {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	var temp float64
-	switch v := c.fields["temp_c"].(type) {
-	case float64:
-		temp = v
-	case int64:
-		temp = float64(v)
-	default:
+	temp, ok := c.floatField("temp_c")
+	if !ok {
 		return "data error"
 	}
 
-	return fmt.Sprintf("%d℃ / %d℉",
-		int64(temp), int64(FahrenheitFromCelsius(temp)))
+	return FormatTemperature(int64(temp), int64(FahrenheitFromCelsius(temp)),
+		c.settings.Units())
+}
+
+// RawText returns the METAR report exactly as it was received and the time
+// at which it was observed. Simulated conditions are not reflected.
+func (c *Controller) RawText() (string, time.Time, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	raw, ok := c.fields["raw_text"].(string)
+	if !ok || raw == "" {
+		return "", time.Time{}, false
+	}
+	observed, ok := c.fields["observation_time"].(string)
+	if !ok {
+		return "", time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, observed)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return raw, t, true
+}
+
+// Station returns the ID of the station that the current observation came
+// from.
+func (c *Controller) Station() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	station, _ := c.fields["station_id"].(string)
+	return station
 }
 
 func (c *Controller) Location() (float64, float64, bool) {
@@ -0,0 +1,47 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import "math"
+
+const (
+	// feetPerMeter converts the station elevation reported in meters.
+	feetPerMeter = 3.28084
+
+	// standardAltimeter is the altimeter setting of the standard
+	// atmosphere in inches of mercury.
+	standardAltimeter = 29.92
+)
+
+// DensityAltitude returns the density altitude in feet computed from the
+// temperature, the altimeter setting, and the field elevation. The field
+// elevation is the configured elevation, or the reporting station's if none
+// is configured. It returns false if any of them are unknown.
+func (c *Controller) DensityAltitude() (int, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	temp, ok := c.floatField("temp_c")
+	if !ok {
+		return 0, false
+	}
+	altimeter, ok := c.altimeter()
+	if !ok {
+		return 0, false
+	}
+	elevation := float64(c.settings.METARFieldElevation())
+	if elevation == 0 {
+		meters, ok := c.floatField("elevation_m")
+		if !ok {
+			return 0, false
+		}
+		elevation = meters * feetPerMeter
+	}
+
+	// The standard temperature lapse rate is 2℃ per thousand feet, and
+	// each degree warmer than standard raises density altitude by about
+	// 120 feet.
+	pressureAltitude := elevation + (standardAltimeter-altimeter)*1000.0
+	standardTemp := 15.0 - 2.0*pressureAltitude/1000.0
+	return int(math.Round(pressureAltitude + 120.0*(temp-standardTemp))), true
+}
@@ -0,0 +1,183 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"math"
+	"time"
+)
+
+// Trend is the direction in which an observed value is changing.
+type Trend int
+
+const (
+	UnknownTrend Trend = iota
+	Steady
+	Rising
+	Falling
+)
+
+func (t Trend) String() string {
+	switch t {
+	case Steady:
+		return "steady"
+	case Rising:
+		return "rising"
+	case Falling:
+		return "falling"
+	}
+	return ""
+}
+
+// trendWindow is how far back trends look. Three hours is the period over
+// which pressure tendency is conventionally reported.
+const trendWindow = 3 * time.Hour
+
+// Changes smaller than these over the trend window are reported as steady.
+const (
+	pressureTrendThreshold    = 0.03 // inches of mercury, about 1 hPa
+	windTrendThreshold        = 5.0  // knots
+	temperatureTrendThreshold = 2.0  // degrees Celsius
+)
+
+// observation holds the values of a report that trends are computed from.
+// Values that weren't reported are NaN.
+type observation struct {
+	time        time.Time
+	altimeter   float64
+	windSpeed   float64
+	temperature float64
+}
+
+// history is a ring buffer of the most recent observations.
+type history struct {
+	observations []observation
+	next         int
+	count        int
+}
+
+// add records o, replacing the oldest observation if the buffer is full.
+func (h *history) add(o observation, size int) {
+	if size <= 0 {
+		return
+	}
+	if len(h.observations) != size {
+		// The size changed, so start over with the observations that fit.
+		old := h.all()
+		if len(old) > size-1 {
+			old = old[len(old)-(size-1):]
+		}
+		h.observations = make([]observation, size)
+		h.next, h.count = 0, 0
+		for _, o := range old {
+			h.add(o, size)
+		}
+	}
+	h.observations[h.next] = o
+	h.next = (h.next + 1) % size
+	if h.count < size {
+		h.count++
+	}
+}
+
+// all returns the observations in the buffer from oldest to newest.
+func (h *history) all() []observation {
+	all := make([]observation, 0, h.count)
+	for i := 0; i < h.count; i++ {
+		n := len(h.observations)
+		all = append(all, h.observations[(h.next-h.count+i+n)%n])
+	}
+	return all
+}
+
+// latest returns the most recent observation, or false if there is none.
+func (h *history) latest() (observation, bool) {
+	if h.count == 0 {
+		return observation{}, false
+	}
+	n := len(h.observations)
+	return h.observations[(h.next-1+n)%n], true
+}
+
+// trend compares the latest value returned by value with the oldest one
+// within the trend window.
+func (h *history) trend(value func(observation) float64, threshold float64) Trend {
+	latest, ok := h.latest()
+	if !ok || math.IsNaN(value(latest)) {
+		return UnknownTrend
+	}
+	cutoff := latest.time.Add(-trendWindow)
+	for _, o := range h.all() {
+		if o.time.Before(cutoff) || !o.time.Before(latest.time) {
+			continue
+		}
+		v := value(o)
+		if math.IsNaN(v) {
+			continue
+		}
+		switch change := value(latest) - v; {
+		case change >= threshold:
+			return Rising
+		case change <= -threshold:
+			return Falling
+		}
+		return Steady
+	}
+	return UnknownTrend
+}
+
+// recordObservation adds the current report to the history if it's newer
+// than the latest one recorded. Simulated weather isn't recorded. The caller
+// must hold the controller lock.
+func (c *Controller) recordObservation(now time.Time) {
+	if c.simulation != nil {
+		return
+	}
+	t := c.observationTime(now)
+	if latest, ok := c.history.latest(); ok && !latest.time.Before(t) {
+		return
+	}
+	o := observation{
+		time:        t,
+		altimeter:   math.NaN(),
+		windSpeed:   math.NaN(),
+		temperature: math.NaN(),
+	}
+	if v, ok := c.altimeter(); ok {
+		o.altimeter = v
+	}
+	if v, ok := c.floatField("wind_speed_kt"); ok {
+		o.windSpeed = v
+	}
+	if v, ok := c.floatField("temp_c"); ok {
+		o.temperature = v
+	}
+	c.history.add(o, c.settings.METARHistorySize())
+}
+
+// PressureTrend returns the trend of the altimeter setting.
+func (c *Controller) PressureTrend() Trend {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.history.trend(func(o observation) float64 {
+		return o.altimeter
+	}, pressureTrendThreshold)
+}
+
+// WindTrend returns the trend of the sustained wind speed.
+func (c *Controller) WindTrend() Trend {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.history.trend(func(o observation) float64 {
+		return o.windSpeed
+	}, windTrendThreshold)
+}
+
+// TemperatureTrend returns the trend of the temperature.
+func (c *Controller) TemperatureTrend() Trend {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.history.trend(func(o observation) float64 {
+		return o.temperature
+	}, temperatureTrendThreshold)
+}
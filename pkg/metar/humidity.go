@@ -0,0 +1,92 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// parseDewpointFromRaw finds the temperature/dewpoint group of a raw METAR
+// report, e.g. "22/12" or "M04/M09", and returns the dewpoint in degrees
+// Celsius. The dewpoint may be missing from the group, e.g. "22/".
+func parseDewpointFromRaw(raw string) (float64, bool) {
+	for _, group := range strings.Fields(raw) {
+		x := strings.IndexByte(group, '/')
+		if x < 1 || x != strings.LastIndexByte(group, '/') {
+			continue
+		}
+		if _, ok := parseTemperature(group[:x]); !ok {
+			continue
+		}
+		return parseTemperature(group[x+1:])
+	}
+	return 0.0, false
+}
+
+// parseTemperature parses a whole degree temperature from a METAR, where
+// negative temperatures are prefixed with M.
+func parseTemperature(s string) (float64, bool) {
+	sign := 1.0
+	if strings.HasPrefix(s, "M") {
+		sign, s = -1.0, s[1:]
+	}
+	if len(s) != 2 {
+		return 0.0, false
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0.0, false
+	}
+	return sign * float64(v), true
+}
+
+// dewpoint returns the dewpoint in degrees Celsius. The caller must hold the
+// controller lock.
+func (c *Controller) dewpoint() (float64, bool) {
+	if dewpoint, ok := c.floatField("dewpoint_c"); ok {
+		return dewpoint, true
+	}
+	if raw, ok := c.fields["raw_text"].(string); ok {
+		return parseDewpointFromRaw(raw)
+	}
+	return 0.0, false
+}
+
+// DewpointCelsius returns the dewpoint in degrees Celsius.
+func (c *Controller) DewpointCelsius() (float64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.dewpoint()
+}
+
+// DewpointString returns a human-readable dewpoint string, or an empty
+// string if the dewpoint wasn't reported.
+func (c *Controller) DewpointString() string {
+	dewpoint, ok := c.DewpointCelsius()
+	if !ok {
+		return ""
+	}
+	return FormatTemperature(int64(dewpoint), int64(FahrenheitFromCelsius(dewpoint)),
+		c.settings.Units())
+}
+
+// RelativeHumidity returns the relative humidity as a percentage computed
+// from the temperature and dewpoint using the Magnus approximation.
+func (c *Controller) RelativeHumidity() (float64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	temp, ok := c.floatField("temp_c")
+	if !ok {
+		return 0.0, false
+	}
+	dewpoint, ok := c.dewpoint()
+	if !ok {
+		return 0.0, false
+	}
+	const a, b = 17.625, 243.04
+	rh := 100.0 * math.Exp(a*dewpoint/(b+dewpoint)) / math.Exp(a*temp/(b+temp))
+	return math.Min(rh, 100.0), true
+}
@@ -0,0 +1,73 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// hectopascalsPerInHg converts an altimeter setting in inches of mercury to
+// hectopascals.
+const hectopascalsPerInHg = 33.8639
+
+// parseAltimeterFromRaw finds the altimeter group of a raw METAR report and
+// returns the altimeter setting in inches of mercury. The group is either
+// inches of mercury in hundredths, e.g. "A2992", or whole hectopascals, e.g.
+// "Q1013".
+func parseAltimeterFromRaw(raw string) (float64, bool) {
+	for _, group := range strings.Fields(raw) {
+		if len(group) != 5 || (group[0] != 'A' && group[0] != 'Q') {
+			continue
+		}
+		v, err := strconv.Atoi(group[1:])
+		if err != nil {
+			continue
+		}
+		if group[0] == 'Q' {
+			return float64(v) / hectopascalsPerInHg, true
+		}
+		return float64(v) / 100.0, true
+	}
+	return 0.0, false
+}
+
+// altimeter returns the altimeter setting in inches of mercury. The caller
+// must hold the controller lock.
+func (c *Controller) altimeter() (float64, bool) {
+	if altimeter, ok := c.floatField("altim_in_hg"); ok && altimeter > 0 {
+		return altimeter, true
+	}
+	if raw, ok := c.fields["raw_text"].(string); ok {
+		return parseAltimeterFromRaw(raw)
+	}
+	return 0.0, false
+}
+
+// AltimeterInHg returns the altimeter setting in inches of mercury.
+func (c *Controller) AltimeterInHg() (float64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.altimeter()
+}
+
+// AltimeterHectopascals returns the altimeter setting (QNH) in hectopascals.
+func (c *Controller) AltimeterHectopascals() (float64, bool) {
+	altimeter, ok := c.AltimeterInHg()
+	if !ok {
+		return 0.0, false
+	}
+	return altimeter * hectopascalsPerInHg, true
+}
+
+// AltimeterString returns a human-readable altimeter setting, or an empty
+// string if the altimeter setting wasn't reported.
+func (c *Controller) AltimeterString() string {
+	altimeter, ok := c.AltimeterInHg()
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("%.2f inHg / %.0f hPa",
+		altimeter, altimeter*hectopascalsPerInHg)
+}
@@ -0,0 +1,190 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// errNoResults is returned when a station has no recent report.
+var errNoResults = errors.New("No results")
+
+// RateLimitError is returned by a Fetcher when the weather service refuses a
+// request because too many have been made. RetryAfter is how long the
+// service asked to wait, or zero if it didn't say.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited; retry after %s", e.RetryAfter)
+	}
+	return "rate limited"
+}
+
+// Quota describes the weather service's rate limit as last advertised and
+// what has been done to stay within it.
+type Quota struct {
+	Limit       int       `json:"limit,omitempty"`     // requests per window; 0 if not advertised
+	Remaining   *int      `json:"remaining,omitempty"` // requests left in the window
+	Reset       time.Time `json:"reset,omitempty"`     // when the window resets
+	PausedUntil time.Time `json:"paused_until,omitempty"`
+	RateLimited int64     `json:"rate_limited"` // requests refused by the service
+
+	// NoData lists the stations that recently had no report, which aren't
+	// asked again until their retry time.
+	NoData []string `json:"no_data,omitempty"`
+}
+
+// quotaTracker records the rate limit state shared by the controller and
+// its fetcher.
+type quotaTracker struct {
+	lock   sync.Mutex
+	quota  Quota
+	noData map[string]time.Time // station to retry time
+}
+
+// parseResetTime parses a rate limit reset header, which is either a number
+// of seconds from now or a Unix time.
+func parseResetTime(s string, now time.Time) (time.Time, bool) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n < 0 {
+		return time.Time{}, false
+	}
+	// Anything less than a year is a number of seconds from now.
+	if n < 365*24*60*60 {
+		return now.Add(time.Duration(n) * time.Second), true
+	}
+	return time.Unix(n, 0), true
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date.
+func parseRetryAfter(s string, now time.Time) time.Duration {
+	if s == "" {
+		return 0
+	}
+	if n, err := strconv.Atoi(s); err == nil && n > 0 {
+		return time.Duration(n) * time.Second
+	}
+	if t, err := http.ParseTime(s); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// headerValue returns the first of the named headers that is set.
+func headerValue(h http.Header, names ...string) string {
+	for _, name := range names {
+		if v := h.Get(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// observe records the rate limit advertised in a response's headers and
+// returns a RateLimitError if the request was refused.
+func (q *quotaTracker) observe(resp *http.Response, now time.Time) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	h := resp.Header
+	if v := headerValue(h, "X-RateLimit-Limit", "RateLimit-Limit"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			q.quota.Limit = n
+		}
+	}
+	if v := headerValue(h, "X-RateLimit-Remaining", "RateLimit-Remaining"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			q.quota.Remaining = &n
+		}
+	}
+	if v := headerValue(h, "X-RateLimit-Reset", "RateLimit-Reset"); v != "" {
+		if t, ok := parseResetTime(v, now); ok {
+			q.quota.Reset = t
+		}
+	}
+
+	retryAfter := parseRetryAfter(h.Get("Retry-After"), now)
+	if resp.StatusCode == http.StatusTooManyRequests ||
+		(resp.StatusCode == http.StatusServiceUnavailable && retryAfter > 0) {
+		return &RateLimitError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// pause stops requests until the given time, unless they're already paused
+// for longer.
+func (q *quotaTracker) pause(until time.Time) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.quota.RateLimited++
+	if until.After(q.quota.PausedUntil) {
+		q.quota.PausedUntil = until
+	}
+}
+
+// pausedUntil returns the time until which requests must not be made, or
+// false if requests may be made now. Requests are paused after the service
+// refuses one and while the advertised quota is used up.
+func (q *quotaTracker) pausedUntil(now time.Time) (time.Time, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if now.Before(q.quota.PausedUntil) {
+		return q.quota.PausedUntil, true
+	}
+	if r := q.quota.Remaining; r != nil && *r <= 0 && now.Before(q.quota.Reset) {
+		return q.quota.Reset, true
+	}
+	return time.Time{}, false
+}
+
+// setNoData remembers that a station had no report, so that it isn't asked
+// again until retry.
+func (q *quotaTracker) setNoData(station string, retry time.Time) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.noData == nil {
+		q.noData = make(map[string]time.Time)
+	}
+	q.noData[station] = retry
+}
+
+// hasNoData returns true if the station recently had no report.
+func (q *quotaTracker) hasNoData(station string, now time.Time) bool {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	retry, ok := q.noData[station]
+	if ok && !now.Before(retry) {
+		delete(q.noData, station)
+		return false
+	}
+	return ok
+}
+
+// Quota returns the weather service's rate limit state.
+func (c *Controller) Quota() Quota {
+	now := c.now()
+	c.quota.lock.Lock()
+	defer c.quota.lock.Unlock()
+	q := c.quota.quota
+	if q.Remaining != nil {
+		remaining := *q.Remaining
+		q.Remaining = &remaining
+	}
+	for station, retry := range c.quota.noData {
+		if now.Before(retry) {
+			q.NoData = append(q.NoData, station)
+		}
+	}
+	sort.Strings(q.NoData)
+	return q
+}
@@ -0,0 +1,58 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+// Simulation holds synthetic weather values that take the place of observed
+// values, for rehearsing weather holds and testing display thresholds. Nil
+// and empty values are not simulated.
+type Simulation struct {
+	WindSpeed     *float64 // knots
+	WindGust      *float64 // knots
+	WindDirection *float64 // degrees from true north
+	Temperature   *float64 // degrees Celsius
+	SkyCover      string
+	Weather       string
+}
+
+func (s *Simulation) field(name string) (interface{}, bool) {
+	if s == nil {
+		return nil, false
+	}
+	var v *float64
+	switch name {
+	case "wind_speed_kt":
+		v = s.WindSpeed
+	case "wind_gust_kt":
+		v = s.WindGust
+	case "wind_dir_degrees":
+		v = s.WindDirection
+	case "temp_c":
+		v = s.Temperature
+	}
+	if v == nil {
+		return nil, false
+	}
+	return *v, true
+}
+
+// Simulate replaces observed weather with simulated weather until
+// ClearSimulation is called.
+func (c *Controller) Simulate(s Simulation) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.simulation = &s
+}
+
+// ClearSimulation restores the use of observed weather.
+func (c *Controller) ClearSimulation() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.simulation = nil
+}
+
+// IsSimulated returns true if simulated weather is in effect.
+func (c *Controller) IsSimulated() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.simulation != nil
+}
@@ -0,0 +1,74 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/statefile"
+)
+
+// state is the last good report as saved to disk, so that it can be shown
+// after a restart until the weather service can be reached.
+type state struct {
+	Station   string    `json:"station"`
+	FetchedAt time.Time `json:"fetched_at"`
+	Data      string    `json:"data"` // as fetched
+}
+
+// save writes the report to the state file, if one is configured.
+func (c *Controller) save(r *report) error {
+	filename := c.settings.METARStateFile()
+	if filename == "" || r.data == nil {
+		return nil
+	}
+	data, err := json.Marshal(state{
+		Station:   r.station,
+		FetchedAt: c.now(),
+		Data:      string(r.data),
+	})
+	if err != nil {
+		return err
+	}
+	return statefile.Write(filename, data, 0600)
+}
+
+// restore loads the last good report from the state file, if one is
+// configured. The report is degraded until a refresh succeeds.
+func (c *Controller) restore() error {
+	filename := c.settings.METARStateFile()
+	if filename == "" {
+		return nil
+	}
+	data, err := statefile.Read(filename)
+	if err != nil {
+		return err
+	}
+	var s state
+	if err = json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	r, err := parseReport([]byte(s.Data))
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.fields = r.fields
+	c.skyCover = r.skyCover
+	c.wxCondition = r.wxCondition
+	c.ceiling = r.ceiling
+	c.degraded = true
+	return nil
+}
+
+// Degraded returns true if the report shown is the last good one because
+// the weather service couldn't be reached, either since the latest refresh
+// failed or since the report was restored after a restart.
+func (c *Controller) Degraded() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.degraded
+}
@@ -0,0 +1,42 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// KPHFromKnots converts a speed from knots to kilometers per hour.
+func KPHFromKnots(kts float64) float64 {
+	return kts * 1.852
+}
+
+// kilometersPerMile converts a distance in statute miles to kilometers.
+const kilometersPerMile = 1.609344
+
+// FormatTemperature formats a temperature in the given units. Temperatures
+// are reported in Celsius, so imperial units show Fahrenheit alongside it
+// rather than replacing it.
+func FormatTemperature(celsius, fahrenheit int64, units string) string {
+	if units == settings.UnitsMetric {
+		return fmt.Sprintf("%d℃", celsius)
+	}
+	return fmt.Sprintf("%d℃ / %d℉", celsius, fahrenheit)
+}
+
+// FormatSpeed formats a speed given in knots in the given units: MPH, km/h,
+// or both.
+func FormatSpeed(kts float64, units string) string {
+	mph := int64(MPHFromKnots(kts))
+	kph := int64(math.Round(KPHFromKnots(kts)))
+	switch units {
+	case settings.UnitsMetric:
+		return fmt.Sprintf("%d km/h", kph)
+	case settings.UnitsBoth:
+		return fmt.Sprintf("%d MPH (%d km/h)", mph, kph)
+	}
+	return fmt.Sprintf("%d MPH", mph)
+}
@@ -0,0 +1,138 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// parseMiles parses whole or fractional statute miles, e.g. "10", "1/2".
+func parseMiles(s string) (float64, bool) {
+	if x := strings.IndexByte(s, '/'); x > 0 {
+		n, err := strconv.Atoi(s[:x])
+		if err != nil {
+			return 0.0, false
+		}
+		d, err := strconv.Atoi(s[x+1:])
+		if err != nil || d == 0 {
+			return 0.0, false
+		}
+		return float64(n) / float64(d), true
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0.0, false
+	}
+	return float64(n), true
+}
+
+// parseVisibilityFromRaw finds the visibility group of a raw METAR report,
+// e.g. "10SM", "1 1/2SM", "M1/4SM", or "P6SM", and returns the visibility in
+// statute miles and whether it is more than that.
+func parseVisibilityFromRaw(raw string) (float64, bool, bool) {
+	groups := strings.Fields(raw)
+	for i, group := range groups {
+		if !strings.HasSuffix(group, "SM") {
+			continue
+		}
+		v, greater := group[:len(group)-2], false
+		switch {
+		case strings.HasPrefix(v, "P"):
+			v, greater = v[1:], true
+		case strings.HasPrefix(v, "M"):
+			v = v[1:]
+		}
+		miles, ok := parseMiles(v)
+		if !ok {
+			continue
+		}
+		// Whole miles of a mixed number are a separate group
+		if i > 0 && strings.Contains(v, "/") {
+			if whole, err := strconv.Atoi(groups[i-1]); err == nil && whole < 10 {
+				miles += float64(whole)
+			}
+		}
+		return miles, greater, true
+	}
+	return 0.0, false, false
+}
+
+// visibility returns the visibility in statute miles and whether it is more
+// than that. The caller must hold the controller lock.
+func (c *Controller) visibility() (float64, bool, bool) {
+	switch v := c.fields["visibility_statute_mi"].(type) {
+	case float64:
+		return v, false, true
+	case int64:
+		return float64(v), false, true
+	case string:
+		// Unlimited visibility is reported as e.g. "10+"
+		if miles, err := strconv.ParseFloat(strings.TrimSuffix(v, "+"), 64); err == nil {
+			return miles, strings.HasSuffix(v, "+"), true
+		}
+	}
+	if raw, ok := c.fields["raw_text"].(string); ok {
+		return parseVisibilityFromRaw(raw)
+	}
+	return 0.0, false, false
+}
+
+// VisibilityStatuteMiles returns the visibility in statute miles.
+func (c *Controller) VisibilityStatuteMiles() (float64, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	miles, _, ok := c.visibility()
+	return miles, ok
+}
+
+// VisibilityString returns a human-readable visibility, e.g. "10+ SM" or
+// "1 1/2 SM", or an empty string if the visibility wasn't reported. Metric
+// units show kilometers instead, e.g. "16+ km".
+func (c *Controller) VisibilityString() string {
+	c.lock.Lock()
+	miles, greater, ok := c.visibility()
+	c.lock.Unlock()
+	if !ok {
+		return ""
+	}
+
+	var plus string
+	if greater {
+		plus = "+"
+	}
+	km := fmt.Sprintf("%d%s km", int(math.Round(miles*kilometersPerMile)), plus)
+	units := c.settings.Units()
+	if units == settings.UnitsMetric {
+		return km
+	}
+
+	whole, fraction := math.Modf(miles)
+	var s string
+	switch n := int(math.Round(fraction * 16)); {
+	case n == 0 || n == 16:
+		s = strconv.Itoa(int(math.Round(miles)))
+	case whole == 0:
+		s = fractionString(n)
+	default:
+		s = fmt.Sprintf("%d %s", int(whole), fractionString(n))
+	}
+	s += plus + " SM"
+	if units == settings.UnitsBoth {
+		return s + " / " + km
+	}
+	return s
+}
+
+// fractionString returns n sixteenths reduced to lowest terms, e.g. "3/4".
+func fractionString(n int) string {
+	d := 16
+	for n%2 == 0 {
+		n, d = n/2, d/2
+	}
+	return fmt.Sprintf("%d/%d", n, d)
+}
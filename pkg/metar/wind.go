@@ -0,0 +1,200 @@
+// (c) Copyright 2026 Matt Messier
+
+package metar
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SmoothedWind describes surface winds averaged over the recent observation
+// history rather than taken from a single report.
+type SmoothedWind struct {
+	Speed      float64 // average sustained speed in knots
+	Gust       float64 // peak gust in knots
+	Direction  float64 // vector averaged direction in degrees for display
+	GustFactor float64 // peak gust less average sustained speed in knots
+	Samples    int     // number of observations averaged
+}
+
+type windObservation struct {
+	time      time.Time
+	speed     float64
+	gust      float64
+	direction float64
+}
+
+// peak returns the strongest wind in the observation, which is the sustained
+// speed if no gust was reported.
+func (o windObservation) peak() float64 {
+	return math.Max(o.speed, o.gust)
+}
+
+// observationTime returns the time of the current observation, or the
+// fallback time if the observation time is not known. The caller must hold
+// the controller lock.
+func (c *Controller) observationTime(fallback time.Time) time.Time {
+	if s, ok := c.fields["observation_time"].(string); ok {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t
+		}
+	}
+	return fallback
+}
+
+// recordWindObservation adds the current observation to the wind history,
+// discarding observations that have aged out of the smoothing window. It
+// returns true if the history changed. The caller must hold the controller
+// lock.
+func (c *Controller) recordWindObservation(now time.Time) bool {
+	speed, ok := c.floatField("wind_speed_kt")
+	if !ok {
+		return false
+	}
+	gust, _ := c.floatField("wind_gust_kt")
+	direction, _ := c.floatField("wind_dir_degrees")
+
+	t := c.observationTime(now)
+	if n := len(c.windHistory); n > 0 && !c.windHistory[n-1].time.Before(t) {
+		return false
+	}
+	o := windObservation{
+		time:      t,
+		speed:     speed,
+		gust:      gust,
+		direction: direction,
+	}
+	c.windHistory = append(c.windHistory, o)
+	if c.simulation == nil && o.peak() > c.peakGust.peak() {
+		c.peakGust = o
+	}
+
+	// Always keep the most recent observation, even if it's old, so that
+	// there is something to report if the station stops reporting.
+	cutoff := now.Add(-c.settings.METARSmoothingWindow())
+	for len(c.windHistory) > 1 && c.windHistory[0].time.Before(cutoff) {
+		c.windHistory = c.windHistory[1:]
+	}
+	return true
+}
+
+// SmoothedWind returns the surface winds averaged over the configured
+// smoothing window.
+func (c *Controller) SmoothedWind() SmoothedWind {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var (
+		w         SmoothedWind
+		sum, x, y float64
+	)
+	history := c.windHistory
+	if c.simulation != nil {
+		// Simulated winds are steady; there is no history to
+		// smooth them with.
+		speed, ok := c.floatField("wind_speed_kt")
+		if !ok {
+			return w
+		}
+		gust, _ := c.floatField("wind_gust_kt")
+		direction, _ := c.floatField("wind_dir_degrees")
+		history = []windObservation{{
+			speed:     speed,
+			gust:      gust,
+			direction: direction,
+		}}
+	}
+	if len(history) == 0 {
+		return w
+	}
+	for _, o := range history {
+		sum += o.speed
+		if o.gust > w.Gust {
+			w.Gust = o.gust
+		}
+		r := o.direction * math.Pi / 180.0
+		x += o.speed * math.Sin(r)
+		y += o.speed * math.Cos(r)
+	}
+	w.Samples = len(history)
+	w.Speed = sum / float64(w.Samples)
+	if w.Gust > w.Speed {
+		w.GustFactor = w.Gust - w.Speed
+	}
+	if x != 0.0 || y != 0.0 {
+		degrees := math.Atan2(x, y) * 180.0 / math.Pi
+		w.Direction = c.displayDirection(math.Mod(degrees+360.0, 360.0))
+	}
+	return w
+}
+
+// PeakGust returns the strongest wind observed since the peak was last reset,
+// in knots, and the time of the observation. It returns false if no winds
+// have been observed. Simulated winds are not counted.
+func (c *Controller) PeakGust() (float64, time.Time, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.peakGust.time.IsZero() {
+		return 0.0, time.Time{}, false
+	}
+	return c.peakGust.peak(), c.peakGust.time, true
+}
+
+// ResetPeakGust forgets the peak gust so that tracking starts over, such as
+// at the start of a new day.
+func (c *Controller) ResetPeakGust() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.peakGust = windObservation{}
+}
+
+// SurfaceWind is the wind reported by a single METAR.
+type SurfaceWind struct {
+	Speed     float64 // knots
+	Gust      float64 // knots; 0 if no gust was reported
+	Direction float64 // degrees true; 0 if variable or calm
+	Variable  bool
+}
+
+// windGroup matches the wind group of a raw METAR report.
+var windGroup = regexp.MustCompile(`^(\d{3}|VRB)(\d{2,3})(?:G(\d{2,3}))?(KT|MPS|KMH)$`)
+
+// ParseWindFromRaw finds the wind group of a raw METAR report, e.g.
+// "24008G14KT", "VRB03KT", or "00000KT" when calm, and returns the wind it
+// reports. Speeds in meters per second and km/h are converted to knots.
+func ParseWindFromRaw(raw string) (SurfaceWind, bool) {
+	for _, group := range strings.Fields(raw) {
+		if group == "RMK" {
+			break
+		}
+		m := windGroup.FindStringSubmatch(group)
+		if m == nil {
+			continue
+		}
+		knots := 1.0
+		switch m[4] {
+		case "MPS":
+			knots = 1.943844
+		case "KMH":
+			knots = 1.0 / 1.852
+		}
+		var w SurfaceWind
+		speed, _ := strconv.Atoi(m[2])
+		w.Speed = float64(speed) * knots
+		if m[3] != "" {
+			gust, _ := strconv.Atoi(m[3])
+			w.Gust = float64(gust) * knots
+		}
+		if m[1] == "VRB" {
+			w.Variable = true
+		} else {
+			direction, _ := strconv.Atoi(m[1])
+			w.Direction = float64(direction)
+		}
+		return w, true
+	}
+	return SurfaceWind{}, false
+}
@@ -0,0 +1,69 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import "strings"
+
+// forChannel tailors an update to the channel that it's being sent to,
+// redacting jumper names according to privacy, removing team names if the
+// channel doesn't show them, showing the separation for the channel's
+// aircraft, and adding the channel's display hints. The update is modified
+// in place.
+func (s *manifestServiceServer) forChannel(u *ManifestUpdate, channel, privacy string) *ManifestUpdate {
+	u = s.redactUpdate(u, privacy)
+	if u == nil {
+		return u
+	}
+
+	h := s.app.Settings().DisplayHints(channel)
+	if !h.TeamNames {
+		removeTeamNames(u.Loads, s.app.Settings().DisplayRows())
+	}
+	if h.SeparationAircraft != "" && u.Status != nil {
+		for _, a := range u.Status.Separations {
+			if strings.EqualFold(a.Aircraft, h.SeparationAircraft) {
+				u.Status.Separation, u.Status.SeparationColor = a.Separation, a.Color
+				break
+			}
+		}
+	}
+	if u.Options == nil {
+		return u
+	}
+
+	hints := &DisplayHints{
+		DimAtDusk:     h.DimAtDusk,
+		DimBrightness: int32(h.DimBrightness),
+		SleepStart:    h.SleepStart,
+		SleepEnd:      h.SleepEnd,
+		RotateSeconds: int32(h.RotateSeconds),
+	}
+	if h.DimAtDusk {
+		if sunrise, sunset, err := s.app.SunriseAndSunsetTimes(); err == nil {
+			hints.DimStart = sunset.Unix()
+			hints.DimEnd = sunrise.Unix()
+		}
+	}
+	u.Options.DisplayHints = hints
+	return u
+}
+
+// removeTeamNames removes the team names and group headers from loads,
+// laying them out again since the headers no longer take rows.
+func removeTeamNames(loads *Loads, rowsPerColumn int) {
+	if loads == nil {
+		return
+	}
+	removed := false
+	for _, l := range loads.Loads {
+		for _, slot := range l.Slots {
+			if g := slot.GetGroup(); g != nil && g.TeamName != "" {
+				g.TeamName, g.Header = "", ""
+				removed = true
+			}
+		}
+	}
+	if removed {
+		layoutLoads(loads, rowsPerColumn)
+	}
+}
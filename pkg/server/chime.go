@@ -0,0 +1,64 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import (
+	"strconv"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
+)
+
+// chimeEvents returns events for each load whose call minutes have crossed
+// one of the configured thresholds since the loads were last seen. Loads
+// seen for the first time do not chime, so that clients connecting or a
+// server restarting don't set off a burst of chimes. Chimes for volatile
+// loads are held until the load settles.
+func (s *manifestServiceServer) chimeEvents(loads []*burble.Load) []*ChimeEvent {
+	thresholds := s.app.Settings().ChimeThresholds()
+
+	var events []*ChimeEvent
+	callMinutes := make(map[int64]int64)
+	for _, l := range loads {
+		if l.IsNoTime {
+			continue
+		}
+		last, ok := s.lastCallMinutes[l.ID]
+		if ok && s.app.IsLoadVolatile(l.ID) {
+			// Hold off until the load settles; keeping the last
+			// call minutes means the crossing is still seen then.
+			callMinutes[l.ID] = last
+			continue
+		}
+		callMinutes[l.ID] = l.CallMinutes
+		if !ok || last <= l.CallMinutes {
+			continue
+		}
+		// Only the most urgent threshold crossed is sent
+		var crossed *int
+		for i, t := range thresholds {
+			if last > int64(t) && l.CallMinutes <= int64(t) {
+				if crossed == nil || t < *crossed {
+					crossed = &thresholds[i]
+				}
+			}
+		}
+		if crossed == nil {
+			continue
+		}
+
+		call := "NOW"
+		if *crossed > 0 {
+			call = strconv.Itoa(*crossed)
+		}
+		events = append(events, &ChimeEvent{
+			LoadId:       uint64(l.ID),
+			AircraftName: l.AircraftName,
+			LoadNumber:   l.LoadNumber,
+			CallMinutes:  int32(l.CallMinutes),
+			Call:         call,
+		})
+	}
+	s.lastCallMinutes = callMinutes
+
+	return events
+}
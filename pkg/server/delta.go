@@ -0,0 +1,236 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// errServerStopped is returned for requests that arrive as the server stops.
+var errServerStopped = status.Error(codes.Unavailable, "server is stopping")
+
+// maxDeltaHistory is the number of updates retained for computing deltas.
+// Clients that fall further behind than this receive a full snapshot.
+const maxDeltaHistory = 256
+
+type sequencedUpdate struct {
+	seq    uint64
+	update *ManifestUpdate
+}
+
+type deltaResponse struct {
+	seq    uint64
+	full   bool
+	update *ManifestUpdate
+}
+
+type deltaRequest struct {
+	reply chan deltaResponse
+	since uint64
+}
+
+// merge applies the sections present in u to x. We cannot use proto.Merge
+// here because we attribute meaning to nil on optional fields, but
+// proto.Merge ignores nil when merging in, not clearing the field in the
+// destination. This is what we want at the top-level, but not the lower
+// levels.
+func (x *ManifestUpdate) merge(u *ManifestUpdate) {
+	if u.Status != nil {
+		x.Status = u.Status
+	}
+	if u.Options != nil {
+		x.Options = u.Options
+	}
+	if u.Jumprun != nil {
+		x.Jumprun = u.Jumprun
+	}
+	if u.WindsAloft != nil {
+		x.WindsAloft = u.WindsAloft
+	}
+	if u.Loads != nil {
+		x.Loads = u.Loads
+	}
+	if u.Alerts != nil {
+		x.Alerts = u.Alerts
+	}
+	if u.Timestamps != nil {
+		x.Timestamps = u.Timestamps
+	}
+	if u.Takeover != nil {
+		x.Takeover = u.Takeover
+	}
+	if u.Counters != nil {
+		x.Counters = u.Counters
+	}
+}
+
+// delta returns the sections that have changed since the update with
+// sequence number since. If since is too old to be answered from history,
+// the full snapshot is returned instead.
+func delta(history []sequencedUpdate, snapshot *ManifestUpdate, seq, since uint64) deltaResponse {
+	if since == seq {
+		return deltaResponse{seq: seq}
+	}
+	if since == 0 || since > seq || len(history) == 0 || history[0].seq > since+1 {
+		return deltaResponse{
+			seq:    seq,
+			full:   true,
+			update: proto.Clone(snapshot).(*ManifestUpdate),
+		}
+	}
+	u := &ManifestUpdate{}
+	for _, h := range history {
+		if h.seq > since {
+			u.merge(h.update)
+		}
+	}
+	return deltaResponse{
+		seq:    seq,
+		update: proto.Clone(u).(*ManifestUpdate),
+	}
+}
+
+// requestDelta asks processUpdates for the sections that have changed since
+// the update with sequence number since. It gives up if ctx is done or the
+// server stops first, so that requests don't wait on a server that isn't
+// processing updates.
+func (s *manifestServiceServer) requestDelta(ctx context.Context, since uint64) (deltaResponse, error) {
+	request := deltaRequest{
+		reply: make(chan deltaResponse, 1),
+		since: since,
+	}
+	select {
+	case s.deltaChan <- request:
+	case <-ctx.Done():
+		return deltaResponse{}, ctx.Err()
+	case <-s.stopped:
+		return deltaResponse{}, errServerStopped
+	}
+	select {
+	case d := <-request.reply:
+		return d, nil
+	case <-ctx.Done():
+		return deltaResponse{}, ctx.Err()
+	case <-s.stopped:
+		return deltaResponse{}, errServerStopped
+	}
+}
+
+// requestDeltaHTTP is requestDelta for an HTTP request. If there is no
+// delta, an error is written to w and false is returned.
+func (s *manifestServiceServer) requestDeltaHTTP(w http.ResponseWriter, req *http.Request, since uint64) (deltaResponse, bool) {
+	d, err := s.requestDelta(req.Context(), since)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable),
+			http.StatusServiceUnavailable)
+		return d, false
+	}
+	return d, true
+}
+
+// parseSince returns the sequence number given by the since query parameter,
+// or 0 if there is none. If it is invalid, an error is written to w and false
+// is returned.
+func parseSince(w http.ResponseWriter, req *http.Request) (uint64, bool) {
+	v := req.URL.Query().Get("since")
+	if v == "" {
+		return 0, true
+	}
+	since, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid since: %v", err), http.StatusBadRequest)
+		return 0, false
+	}
+	return since, true
+}
+
+// writeDeltaProtobuf writes d as a binary ManifestUpdate. Protobuf clients
+// get the sequence number in headers since the payload is just the
+// ManifestUpdate.
+func writeDeltaProtobuf(w http.ResponseWriter, req *http.Request, d deltaResponse) {
+	w.Header().Set("X-Manifest-Seq", strconv.FormatUint(d.seq, 10))
+	w.Header().Set("X-Manifest-Full", strconv.FormatBool(d.full))
+	if d.update == nil {
+		d.update = &ManifestUpdate{}
+	}
+	writeMessageEncoding(w, req, d.update, protobuf)
+}
+
+// DeltaHandler serves the sections of the manifest that have changed since
+// the sequence number given by the since query parameter. Polling clients
+// pass the seq from each response to the next request.
+func (s *manifestServiceServer) DeltaHandler(w http.ResponseWriter, req *http.Request) {
+	since, ok := parseSince(w, req)
+	if !ok {
+		return
+	}
+
+	d, ok := s.requestDeltaHTTP(w, req, since)
+	if !ok {
+		return
+	}
+	channel := channelFromRequest(req)
+	d.update = s.forChannel(d.update, channel, s.privacyFromRequest(req, channel))
+
+	if negotiate(req) == protobuf {
+		writeDeltaProtobuf(w, req, d)
+		return
+	}
+
+	response := struct {
+		Seq    uint64          `json:"seq"`
+		Full   bool            `json:"full"`
+		Update json.RawMessage `json:"update,omitempty"`
+	}{
+		Seq:  d.seq,
+		Full: d.full,
+	}
+	if d.update != nil {
+		b, err := protojson.Marshal(d.update)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "cannot marshal manifest delta: %v\n", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError),
+				http.StatusInternalServerError)
+			return
+		}
+		response.Update = b
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Add("Vary", "Accept")
+	e := json.NewEncoder(w)
+	if negotiate(req) == prettyJSON {
+		e.SetIndent("", "  ")
+	}
+	_ = e.Encode(response)
+}
+
+// ProtobufHandler serves the manifest as a binary ManifestUpdate regardless
+// of the Accept header, for embedded clients that have the generated types
+// but cannot hold a gRPC stream open. Without a since query parameter the
+// full snapshot is served; with one, only the sections that have changed
+// since that sequence number, as with DeltaHandler.
+func (s *manifestServiceServer) ProtobufHandler(w http.ResponseWriter, req *http.Request) {
+	since, ok := parseSince(w, req)
+	if !ok {
+		return
+	}
+	d, ok := s.requestDeltaHTTP(w, req, since)
+	if !ok {
+		return
+	}
+	channel := channelFromRequest(req)
+	d.update = s.forChannel(d.update, channel, s.privacyFromRequest(req, channel))
+	writeDeltaProtobuf(w, req, d)
+}
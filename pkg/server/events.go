@@ -0,0 +1,74 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import (
+	"github.com/jumptown-skydiving/manifest-server/pkg/core"
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// eventBatchSize is the number of events read from the event log at a time
+// while catching a client up.
+const eventBatchSize = 100
+
+func translateEvent(e core.Event, privacy string) *ManifestEvent {
+	name := e.JumperName
+	if name != "" {
+		switch privacy {
+		case settings.NamesShort:
+			name = shortName(name)
+		case settings.NamesHidden:
+			name = "Jumper"
+		}
+	}
+	return &ManifestEvent{
+		Sequence:     e.Sequence,
+		Type:         string(e.Type),
+		Time:         e.Time.Unix(),
+		LoadId:       uint64(e.LoadID),
+		AircraftName: e.AircraftName,
+		LoadNumber:   e.LoadNumber,
+		CallMinutes:  int32(e.CallMinutes),
+		JumperId:     e.JumperID,
+		JumperName:   name,
+		JumpType:     e.JumpType,
+	}
+}
+
+// StreamEvents sends the manifest event log, starting after the requested
+// sequence number and continuing with new events as they're recorded.
+// Jumper names follow the name privacy mode of the client's channel.
+func (s *manifestServiceServer) StreamEvents(
+	req *StreamEventsRequest,
+	stream ManifestService_StreamEventsServer,
+) error {
+	privacy := s.privacyFromContext(stream.Context(), channelFromContext(stream.Context()))
+	wake := make(chan struct{}, 1)
+	id := s.app.AddEventListener(wake)
+	defer s.app.RemoveEventListener(id)
+
+	after := req.AfterSequence
+	for {
+		events, err := s.app.Events(after, eventBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if err = stream.Send(translateEvent(e, privacy)); err != nil {
+				return err
+			}
+			after = e.Sequence
+		}
+		if len(events) == eventBatchSize {
+			continue
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-s.app.Done():
+			return nil
+		case <-wake:
+		}
+	}
+}
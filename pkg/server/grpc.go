@@ -6,18 +6,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/jumptown-skydiving/manifest-server/pkg/burble"
 	"github.com/jumptown-skydiving/manifest-server/pkg/core"
 	"github.com/jumptown-skydiving/manifest-server/pkg/db"
+	"github.com/jumptown-skydiving/manifest-server/pkg/jumprun"
+	"github.com/jumptown-skydiving/manifest-server/pkg/metar"
 	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+	"github.com/jumptown-skydiving/manifest-server/pkg/winds"
 	"github.com/orangematt/siwa"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -45,9 +52,14 @@ type manifestServiceServer struct {
 	options settings.Options
 	wg      sync.WaitGroup
 	cancel  context.CancelFunc
+	stopped chan struct{} // closed when the server stops
+
+	// lastCallMinutes tracks each load's call minutes for chime events
+	lastCallMinutes map[int64]int64
 
 	addClientChan    chan addClientRequest
 	removeClientChan chan removeClientRequest
+	deltaChan        chan deltaRequest
 }
 
 func newManifestServiceServer(controller *core.Controller) *manifestServiceServer {
@@ -55,6 +67,8 @@ func newManifestServiceServer(controller *core.Controller) *manifestServiceServe
 		app:              controller,
 		addClientChan:    make(chan addClientRequest, 16),
 		removeClientChan: make(chan removeClientRequest, 16),
+		deltaChan:        make(chan deltaRequest, 16),
+		stopped:          make(chan struct{}),
 	}
 }
 
@@ -144,16 +158,27 @@ func (s *manifestServiceServer) translateJumper(j *burble.Jumper, leader *Jumper
 	}
 
 	return &Jumper{
-		Id:        uint64(j.ID),
-		Type:      t,
-		Name:      j.Name,
-		ShortName: j.ShortName,
-		Color:     color,
-		Repr:      repr,
-		RigName:   j.RigName,
+		Id:           uint64(j.ID),
+		Type:         t,
+		Name:         j.Name,
+		ShortName:    j.ShortName,
+		Color:        color,
+		Repr:         repr,
+		RigName:      j.RigName,
+		StableId:     j.StableID,
+		PullAltitude: int32(j.PullAltitude),
 	}
 }
 
+// teamHeader returns the header shown above a team's group, e.g. "Team Chaos
+// (4-way)".
+func teamHeader(name string, size int) string {
+	if !strings.HasPrefix(strings.ToLower(name), "team ") {
+		name = "Team " + name
+	}
+	return fmt.Sprintf("%s (%d-way)", name, size)
+}
+
 func (s *manifestServiceServer) slotFromJumper(j *burble.Jumper, load *burble.Load) *LoadSlot {
 	if len(j.GroupMembers) == 0 {
 		return &LoadSlot{
@@ -169,6 +194,10 @@ func (s *manifestServiceServer) slotFromJumper(j *burble.Jumper, load *burble.Lo
 	for _, member := range j.GroupMembers {
 		g.Members = append(g.Members, s.translateJumper(member, g.Leader, load))
 	}
+	if j.TeamName != "" {
+		g.TeamName = j.TeamName
+		g.Header = teamHeader(j.TeamName, len(g.Members)+1)
+	}
 
 	return &LoadSlot{
 		Slot: &LoadSlot_Group{
@@ -182,16 +211,52 @@ func (s *manifestServiceServer) constructUpdate(source core.DataSource) *Manifes
 
 	const sunriseSources = core.PreSunriseDataSource | core.SunriseDataSource
 	const sunsetSources = core.PreSunsetDataSource | core.SunsetDataSource
-	const optionsSources = core.OptionsDataSource | sunriseSources | sunsetSources
+	const optionsSources = core.OptionsDataSource | core.AlertsDataSource | sunriseSources | sunsetSources
 	if source&optionsSources != 0 {
-		s.options = s.app.Settings().Options()
+		var generation uint64
+		s.options, generation = s.app.Settings().OptionsGeneration()
 		o := s.options
 		u.Options = &Options{
 			DisplayWeather: o.DisplayWeather,
 			DisplayWinds:   o.DisplayWinds,
-			Message:        o.Message,
-			MessageColor:   0xffffff,
+			DisplayStandby: o.DisplayStandby,
 			FuelRequested:  o.FuelRequested,
+			WeatherHold:    o.WeatherHold,
+			Units:          s.app.Settings().Units(),
+			Generation:     generation,
+		}
+
+		// The message line shows the highest priority alert
+		alerts := s.app.Alerts(core.DisplayAlertChannel)
+		if len(alerts) > 0 {
+			u.Options.Message = alerts[0].Message
+			u.Options.MessageColor = alerts[0].Priority.Color()
+		} else {
+			u.Options.MessageColor = 0xffffff
+		}
+
+		const alertsSources = core.OptionsDataSource | core.AlertsDataSource
+		if source&alertsSources != 0 {
+			u.Alerts = &Alerts{}
+			for _, a := range alerts {
+				alert := &Alert{
+					Id:       a.ID,
+					Class:    a.Class,
+					Priority: AlertPriority(a.Priority),
+					Message:  a.Message,
+					Color:    a.Priority.Color(),
+				}
+				if !a.Posted.IsZero() {
+					alert.Posted = a.Posted.Unix()
+				}
+				if !a.Expires.IsZero() {
+					alert.Expires = a.Expires.Unix()
+				}
+				u.Alerts.Alerts = append(u.Alerts.Alerts, alert)
+			}
+		}
+		if !s.app.Settings().MagneticHeadings() {
+			u.Options.HeadingReference = HeadingReference_TRUE
 		}
 		if source&sunriseSources != 0 {
 			u.Options.Sunrise = s.app.SunriseMessage()
@@ -201,7 +266,8 @@ func (s *manifestServiceServer) constructUpdate(source core.DataSource) *Manifes
 		}
 	}
 
-	const statusSources = core.METARDataSource | core.WindsAloftDataSource
+	const statusSources = core.METARDataSource | core.WindsAloftDataSource |
+		core.BurbleDataSource | core.OptionsDataSource | sunriseSources | sunsetSources
 	if source&statusSources != 0 {
 		var (
 			separationColor  uint32
@@ -213,30 +279,170 @@ func (s *manifestServiceServer) constructUpdate(source core.DataSource) *Manifes
 			separationColor = 0xffffff
 		}
 
-		var winds, clouds, weather, temperature string
+		var (
+			winds, clouds, weather, temperature string
+			station, dewpoint, altimeter        string
+			visibility                          string
+			humidity                            int
+			altimeterInHg, altimeterHPa         float64
+			visibilityMiles                     float64
+			category                            = metar.UnknownFlightCategory
+			cloudsColor                         = uint32(0xffffff)
+			smoothedWind                        *SmoothedWind
+			windDirection                       int
+			simulated                           bool
+			observedAt                          int64
+			pressureTrend, windTrend            metar.Trend
+			temperatureTrend                    metar.Trend
+			clearances                          = metar.Clearances{Tandems: true, Students: true}
+			observation                         *Observation
+			degraded                            bool
+		)
 		if m := s.app.METARSource(); m != nil {
 			winds = m.WindConditions()
 			clouds = m.SkyCover()
 			weather = m.WeatherConditions()
 			temperature = m.TemperatureString()
+			station = m.Station()
+			dewpoint = m.DewpointString()
+			if rh, ok := m.RelativeHumidity(); ok {
+				humidity = int(math.Round(rh))
+			}
+			altimeter = m.AltimeterString()
+			altimeterInHg, _ = m.AltimeterInHg()
+			altimeterHPa, _ = m.AltimeterHectopascals()
+			visibility = m.VisibilityString()
+			visibilityMiles, _ = m.VisibilityStatuteMiles()
+			// The sky line is colored by the flight category when
+			// conditions are worse than VFR.
+			if category = m.FlightCategory(); category != metar.VFR {
+				cloudsColor = category.Color()
+			}
+			windDirection = int(m.WindDirectionTrueDegrees())
+			if t, ok := m.ObservationTime(); ok {
+				observedAt = t.Unix()
+			}
+			pressureTrend = m.PressureTrend()
+			windTrend = m.WindTrend()
+			temperatureTrend = m.TemperatureTrend()
+			clearances = m.Clearances()
+			observation = newObservation(m)
+			degraded = m.Degraded()
+			if simulated = m.IsSimulated(); simulated {
+				weather = "SIMULATED: " + weather
+			}
+			if w := m.SmoothedWind(); w.Samples > 0 {
+				smoothedWind = &SmoothedWind{
+					Speed:      float32(w.Speed),
+					Gust:       float32(w.Gust),
+					Direction:  int32(math.Round(w.Direction)) % 360,
+					GustFactor: float32(w.GustFactor),
+					Samples:    int32(w.Samples),
+				}
+			}
+		}
+
+		// Weather lines from a stale report are shown in yellow rather than
+		// white so that hours-old conditions don't pass for current ones.
+		// Lines already colored as a warning keep their colors.
+		windsColor := s.app.WindsColor()
+		weatherColor, temperatureColor := uint32(0xffffff), uint32(0xffffff)
+		stale := s.app.WeatherStale()
+		if stale {
+			for _, color := range []*uint32{&windsColor, &cloudsColor, &weatherColor, &temperatureColor} {
+				if *color == 0xffffff {
+					*color = core.AlertWarning.Color()
+				}
+			}
 		}
 
 		u.Status = &Status{
 			Winds:            winds,
-			WindsColor:       0xffffff,
+			WindsColor:       windsColor,
 			Clouds:           clouds,
-			CloudsColor:      0xffffff,
+			CloudsColor:      cloudsColor,
 			Weather:          weather,
-			WeatherColor:     0xffffff,
+			WeatherColor:     weatherColor,
 			Separation:       separationString,
 			SeparationColor:  separationColor,
 			Temperature:      temperature,
-			TemperatureColor: 0xffffff,
+			TemperatureColor: temperatureColor,
+			SmoothedWind:     smoothedWind,
+
+			WindDirectionTrue:     int32(windDirection),
+			WindDirectionMagnetic: int32(s.app.MagneticHeading(windDirection)),
+			Simulated:             simulated,
+			LoadsRemaining:        s.app.LoadsRemainingMessage(),
+			CanopyAdvisory:        s.app.CanopyAdvisory(),
+			PeakGust:              s.app.PeakGustMessage(),
+			MetarStation:          station,
+			DensityAltitude:       s.app.DensityAltitudeMessage(),
+			Dewpoint:              dewpoint,
+			RelativeHumidity:      int32(humidity),
+			Altimeter:             altimeter,
+			AltimeterInHg:         float32(altimeterInHg),
+			AltimeterHpa:          float32(altimeterHPa),
+			Visibility:            visibility,
+			VisibilitySm:          float32(visibilityMiles),
+			FlightCategory:        string(category),
+			FlightCategoryColor:   category.Color(),
+			ObservedAt:            observedAt,
+			WeatherStale:          stale,
+			PressureTrend:         pressureTrend.String(),
+			WindTrend:             windTrend.String(),
+			TemperatureTrend:      temperatureTrend.String(),
+			Trends:                s.app.TrendsMessage(),
+			ApparentTemperature:   s.app.ApparentTemperatureMessage(),
+			TandemsOk:             clearances.Tandems,
+			StudentsOk:            clearances.Students,
+			Observation:           observation,
+			WeatherDegraded:       degraded,
+			FreezingLevel:         s.app.FreezingLevelMessage(),
+			ExitTemperature:       s.app.ExitTemperatureMessage(),
+			CanopyWinds:           s.app.CanopyWindsMessage(),
+			HighUppersAdvisory:    s.app.HighUppersAdvisory(),
+		}
+		if feet, ok := s.app.FreezingLevel(); ok {
+			u.Status.FreezingLevelFt = proto.Int32(int32(feet))
+		}
+		if celsius, ok := s.app.ExitTemperature(); ok {
+			u.Status.ExitTemperatureC = proto.Float32(float32(celsius))
+		}
+		if heading, speed, ok := s.app.CanopyWind(); ok {
+			u.Status.CanopyWindSpeedKt = proto.Float32(float32(speed))
+			u.Status.CanopyWindDirection = proto.Int32(int32(s.app.DisplayHeading(int(math.Round(heading)) % 360)))
+		}
+		if s.app.WindsAloftSource() != nil {
+			for _, a := range s.app.AircraftSeparations() {
+				u.Status.Separations = append(u.Status.Separations, &AircraftSeparation{
+					Aircraft:          a.Aircraft,
+					Separation:        a.Separation,
+					Color:             a.Color,
+					SeparationSeconds: int32(a.Seconds),
+					GroundSpeedKt:     float32(a.GroundSpeed),
+					ExitAltitude:      int32(a.ExitAltitude),
+				})
+			}
+			if d, ok := s.app.Drift(); ok {
+				u.Status.Drift = &Drift{
+					FreefallFt:        float32(d.Freefall.Distance),
+					FreefallBearing:   int32(math.Round(d.Freefall.Bearing)) % 360,
+					CanopyFt:          float32(d.Canopy.Distance),
+					CanopyBearing:     int32(math.Round(d.Canopy.Bearing)) % 360,
+					TotalFt:           float32(d.Total.Distance),
+					TotalBearing:      int32(math.Round(d.Total.Bearing)) % 360,
+					FreefallSeconds:   float32(d.FreefallSeconds),
+					CanopySeconds:     float32(d.CanopySeconds),
+					GroundSpeedKt:     float32(d.GroundSpeed),
+					SeparationSeconds: int32(d.Separation),
+				}
+			}
 		}
 	}
 
-	const jumprunSources = core.JumprunDataSource
-	if source&jumprunSources != 0 {
+	// The wind-corrected heading depends on the winds aloft
+	const jumprunSources = core.JumprunDataSource | core.WindsAloftDataSource
+	if source&jumprunSources != 0 && s.app.Jumprun() != nil {
 		j := s.app.Jumprun().Jumprun()
 		u.Jumprun = &Jumprun{
 			Origin: &JumprunOrigin{
@@ -244,51 +450,109 @@ func (s *manifestServiceServer) constructUpdate(source core.DataSource) *Manifes
 				Longitude:         j.Longitude,
 				MagneticDeviation: int32(j.MagneticDeclination),
 				CameraHeight:      int32(j.CameraHeight),
+				ViewWidth:         int32(j.ViewWidth()),
 			},
 		}
 		if j.IsSet {
+			// Jumprun headings are entered relative to magnetic
+			// north, so convert them to true north as needed.
+			heading := func(magnetic int) (int32, int32, int32) {
+				t := int(j.TrueHeading(magnetic))
+				d := t
+				if s.app.Settings().MagneticHeadings() {
+					d = magnetic
+				}
+				return int32(d), int32(t), int32(magnetic)
+			}
 			p := &JumprunPath{
-				Heading:        int32(j.Heading),
 				ExitDistance:   int32(j.ExitDistance),
-				OffsetHeading:  int32(j.OffsetHeading),
 				OffsetDistance: int32(j.OffsetDistance),
 			}
+			p.Heading, p.TrueHeading, p.MagneticHeading = heading(j.Heading)
+			p.OffsetHeading, p.OffsetTrueHeading, p.OffsetMagneticHeading = heading(j.OffsetHeading)
+			if wc, ok := s.app.JumprunWindCorrection(); ok {
+				magnetic := int(math.Round(wc.Heading)) - j.MagneticDeclination
+				magnetic = (magnetic%360 + 360) % 360
+				p.WindCorrected = true
+				p.CorrectedHeading, p.CorrectedTrueHeading, p.CorrectedMagneticHeading = heading(magnetic)
+				p.GroundSpeed = int32(math.Round(wc.GroundSpeed))
+			}
 			for _, t := range j.HookTurns {
 				if t.Distance == 0 && t.Heading == 0 {
 					break
 				}
-				p.Turns = append(p.Turns, &JumprunTurn{
+				turn := &JumprunTurn{
 					Distance: int32(t.Distance),
-					Heading:  int32(t.Heading),
+				}
+				turn.Heading, turn.TrueHeading, turn.MagneticHeading = heading(t.Heading)
+				p.Turns = append(p.Turns, turn)
+			}
+			for _, pt := range j.GroundTrack() {
+				p.GroundTrack = append(p.GroundTrack, &JumprunPoint{
+					Latitude:  pt.Latitude,
+					Longitude: pt.Longitude,
+					X:         int32(math.Round(pt.X)),
+					Y:         int32(math.Round(pt.Y)),
 				})
 			}
 			u.Jumprun.Path = p
 		}
+		if spot, ok := s.app.ExitSpot(); ok {
+			t := int(math.Round(spot.Bearing)) % 360
+			u.Jumprun.Spot = &JumprunSpot{
+				DistanceFt:      int32(math.Round(spot.Distance)),
+				Heading:         int32(s.app.DisplayHeading(t)),
+				TrueHeading:     int32(t),
+				MagneticHeading: int32(s.app.MagneticHeading(t)),
+				Latitude:        spot.Point.Latitude,
+				Longitude:       spot.Point.Longitude,
+				Description:     s.app.ExitSpotMessage(),
+			}
+		}
 	}
 
 	const windsAloftSources = core.WindsAloftDataSource
 	if source&windsAloftSources != 0 {
 		w := s.app.WindsAloftSource()
-		u.WindsAloft = &WindsAloft{}
-		for _, sample := range w.Samples() {
-			u.WindsAloft.Samples = append(u.WindsAloft.Samples,
-				&WindsAloftSample{
-					Altitude:    int32(sample.Altitude),
-					Heading:     int32(sample.Heading),
-					Speed:       int32(sample.Speed),
-					Temperature: int32(sample.Temperature),
-					Variable:    sample.LightAndVariable,
-				})
+		u.WindsAloft = &WindsAloft{
+			Simulated:      w.IsSimulated(),
+			ExitAltitude:   int32(s.app.ExitAltitude()),
+			Stale:          s.app.WindsAloftStale(),
+			Degraded:       w.Degraded(),
+			FieldElevation: int32(s.app.Settings().FieldElevation()),
+		}
+		if t, ok := w.FetchTime(); ok {
+			u.WindsAloft.FetchTime = t.Unix()
+		}
+		var trends map[int]winds.Trend
+		if !w.IsSimulated() {
+			trends = w.Trends()
+		}
+		u.WindsAloft.Samples, u.WindsAloft.ShearWarning = s.windsAloftSamples(w.Samples(), trends)
+		for _, f := range w.Forecasts() {
+			forecast := &WindsAloftForecast{
+				Hour:      int32(f.Hour),
+				ValidTime: f.ValidTime.Unix(),
+			}
+			forecast.Samples, forecast.ShearWarning = s.windsAloftSamples(f.Samples, nil)
+			u.WindsAloft.Forecasts = append(u.WindsAloft.Forecasts, forecast)
 		}
 	}
 
-	const loadsSources = core.BurbleDataSource | core.OptionsDataSource
+	const loadsSources = core.BurbleDataSource | core.OptionsDataSource |
+		core.OperatingHoursDataSource | core.ExitOrderDataSource
 	if source&loadsSources != 0 {
 		b := s.app.BurbleSource()
 		u.Loads = &Loads{
-			ColumnCount: int32(b.ColumnCount()),
+			ColumnCount:   int32(b.ColumnCount()),
+			ClosedMessage: s.app.ClosedMessage(),
 		}
-		for _, l := range b.Loads() {
+		var loads []*burble.Load
+		if u.Loads.ClosedMessage == "" {
+			loads = b.Loads()
+		}
+		u.ChimeEvents = s.chimeEvents(loads)
+		for _, l := range loads {
 			var callMinutes string
 			if !l.IsNoTime {
 				if l.CallMinutes == 0 {
@@ -308,6 +572,8 @@ func (s *manifestServiceServer) constructUpdate(source core.DataSource) *Manifes
 				IsFueling:         l.IsFueling,
 				IsTurning:         l.IsTurning,
 				IsNoTime:          l.IsNoTime,
+				State:             CallState(l.State),
+				IsVolatile:        s.app.IsLoadVolatile(l.ID),
 			}
 			for _, j := range l.Tandems {
 				load.Slots = append(load.Slots, s.slotFromJumper(j, l))
@@ -318,9 +584,20 @@ func (s *manifestServiceServer) constructUpdate(source core.DataSource) *Manifes
 			for _, j := range l.SportJumpers {
 				load.Slots = append(load.Slots, s.slotFromJumper(j, l))
 			}
+			exitOrder, accepted := s.app.ExitOrder(l)
+			for _, g := range exitOrder {
+				load.ExitOrder = append(load.ExitOrder, &ExitGroup{
+					LeaderId:          g.LeaderID,
+					Name:              g.Name,
+					Discipline:        string(g.Discipline),
+					Size:              int32(g.Size),
+					SeparationSeconds: int32(g.Separation),
+				})
+			}
+			load.ExitOrderAccepted = accepted
 
 			var slotsAvailable string
-			if l.CallMinutes <= 5 {
+			if l.State >= burble.Boarding {
 				// Burble doesn't give us unique Jumper IDs in
 				// the loads even though it surely tracks them
 				// internally. So we have to do the next best
@@ -350,6 +627,56 @@ func (s *manifestServiceServer) constructUpdate(source core.DataSource) *Manifes
 
 			u.Loads.Loads = append(u.Loads.Loads, load)
 		}
+		layoutLoads(u.Loads, s.app.Settings().DisplayRows())
+
+		if u.Loads.ClosedMessage == "" && s.app.Settings().DisplayStandby() {
+			for _, j := range b.Standby() {
+				// Standby jumpers aren't on a load, so they
+				// can't be turning.
+				u.Loads.Standby = append(u.Loads.Standby, s.translateJumper(j, nil, nil))
+			}
+		}
+	}
+
+	// Departed counts start over each day, which is noticed at sunrise
+	const countersSources = core.BurbleDataSource | sunriseSources
+	if source&countersSources != 0 {
+		if counters := s.app.Counters(); len(counters) > 0 {
+			u.Counters = &Counters{}
+			for _, c := range counters {
+				u.Counters.Counters = append(u.Counters.Counters, &Counter{
+					Name:  c.Name,
+					Label: c.Label,
+					Value: int32(c.Value),
+				})
+			}
+		}
+	}
+
+	if source&core.TakeoverDataSource != 0 {
+		u.Takeover = &Takeover{}
+		if t, ok := s.app.Takeover(); ok {
+			u.Takeover = &Takeover{
+				Active:  true,
+				Message: t.Message,
+				Posted:  t.Posted.Unix(),
+				Expires: t.Expires.Unix(),
+			}
+		}
+	}
+
+	unix := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+	t := s.app.UpdateTimes()
+	u.Timestamps = &Timestamps{
+		BurbleUpdatedAt:  unix(t.Burble),
+		MetarUpdatedAt:   unix(t.METAR),
+		WindsUpdatedAt:   unix(t.WindsAloft),
+		JumprunUpdatedAt: unix(t.Jumprun),
 	}
 
 	return u
@@ -371,8 +698,22 @@ func (x *ManifestUpdate) diff(y *ManifestUpdate) bool {
 	if proto.Equal(x.Loads, y.Loads) {
 		x.Loads = nil
 	}
+	if proto.Equal(x.Alerts, y.Alerts) {
+		x.Alerts = nil
+	}
+	if proto.Equal(x.Timestamps, y.Timestamps) {
+		x.Timestamps = nil
+	}
+	if proto.Equal(x.Takeover, y.Takeover) {
+		x.Takeover = nil
+	}
+	if proto.Equal(x.Counters, y.Counters) {
+		x.Counters = nil
+	}
 	return x.Status != nil || x.Options != nil || x.Jumprun != nil ||
-		x.WindsAloft != nil || x.Loads != nil
+		x.WindsAloft != nil || x.Loads != nil || x.Alerts != nil ||
+		len(x.ChimeEvents) > 0 || x.Timestamps != nil || x.Takeover != nil ||
+		x.Counters != nil
 }
 
 func (s *manifestServiceServer) processUpdates(ctx context.Context) {
@@ -386,7 +727,8 @@ func (s *manifestServiceServer) processUpdates(ctx context.Context) {
 	clients := make(map[uint64]chan *ManifestUpdate)
 
 	// Create and send the initial baseline ManifestUpdate
-	source := core.BurbleDataSource | core.OptionsDataSource
+	source := core.BurbleDataSource | core.OptionsDataSource |
+		core.OperatingHoursDataSource | core.TakeoverDataSource
 	if s.app.Jumprun() != nil {
 		source |= core.JumprunDataSource
 	}
@@ -397,6 +739,8 @@ func (s *manifestServiceServer) processUpdates(ctx context.Context) {
 		source |= core.WindsAloftDataSource
 	}
 	lastUpdate := s.constructUpdate(source)
+	seq := uint64(1)
+	var history []sequencedUpdate
 
 	for {
 		select {
@@ -416,6 +760,9 @@ func (s *manifestServiceServer) processUpdates(ctx context.Context) {
 			delete(clients, req.id)
 			req.reply <- removeClientResponse{}
 
+		case req := <-s.deltaChan:
+			req.reply <- delta(history, lastUpdate, seq, req.since)
+
 		case source = <-c:
 		drain:
 			for {
@@ -427,37 +774,374 @@ func (s *manifestServiceServer) processUpdates(ctx context.Context) {
 				}
 			}
 			if u := s.constructUpdate(source); u.diff(lastUpdate) {
+				seq++
+				trace := s.traceUpdate(seq, source)
 				for _, client := range clients {
 					update := proto.Clone(u).(*ManifestUpdate)
+					update.Trace = proto.Clone(trace).(*Trace)
 					client <- update
 				}
-				// We cannot use proto.Merge here because we
-				// attribute meaning to nil on optional fields,
-				// but proto.Merge ignores nil when merging in,
-				// not clearing the field in the destination.
-				// This is what we want at the top-level, but
-				// not the lower levels.
-				//proto.Merge(lastUpdate, u)
-				if u.Status != nil {
-					lastUpdate.Status = u.Status
-				}
-				if u.Options != nil {
-					lastUpdate.Options = u.Options
-				}
-				if u.Jumprun != nil {
-					lastUpdate.Jumprun = u.Jumprun
-				}
-				if u.WindsAloft != nil {
-					lastUpdate.WindsAloft = u.WindsAloft
-				}
-				if u.Loads != nil {
-					lastUpdate.Loads = u.Loads
+				lastUpdate.merge(u)
+
+				history = append(history, sequencedUpdate{
+					seq:    seq,
+					update: u,
+				})
+				if len(history) > maxDeltaHistory {
+					history = history[len(history)-maxDeltaHistory:]
 				}
 			}
 		}
 	}
 }
 
+// traceUpdate returns the trace for the update with sequence number seq that
+// was constructed for source, recording how long it took to construct after
+// the Burble change that it carries was fetched.
+func (s *manifestServiceServer) traceUpdate(seq uint64, source core.DataSource) *Trace {
+	now := s.app.CurrentTime()
+	trace := &Trace{
+		Seq:             seq,
+		ConstructedAtMs: now.UnixMilli(),
+	}
+	if source&core.BurbleDataSource != 0 {
+		if fetched := s.app.UpdateTimes().Burble; !fetched.IsZero() {
+			trace.FetchedAtMs = fetched.UnixMilli()
+			s.app.RecordLatency(core.LatencyConstruct, now.Sub(fetched))
+		}
+	}
+	return trace
+}
+
+// maxEchoLatency is the longest plausible time between an update being sent
+// and a display echoing its trace.
+const maxEchoLatency = 5 * time.Minute
+
+// EchoTrace records how long an update took to be displayed after it was
+// sent. Clients call it once they have shown an update. The sent time comes
+// from the client, so traces claiming to have been sent in the future or
+// longer ago than maxEchoLatency are rejected rather than recorded.
+func (s *manifestServiceServer) EchoTrace(
+	_ context.Context,
+	req *EchoTraceRequest,
+) (*emptypb.Empty, error) {
+	if t := req.Trace; t != nil && t.SentAtMs != 0 {
+		d := s.app.CurrentTime().Sub(time.UnixMilli(t.SentAtMs))
+		if t.SentAtMs < 0 || d < 0 || d > maxEchoLatency {
+			return nil, status.Error(codes.InvalidArgument, "implausible trace sent time")
+		}
+		s.app.RecordLatency(core.LatencyEcho, d)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// windsAloftSamples returns the samples at the altitudes sent to displays
+// with their trends, if any, and whether the shear between any of them
+// raises a warning.
+func (s *manifestServiceServer) windsAloftSamples(samples []winds.Sample, trends map[int]winds.Trend) ([]*WindsAloftSample, bool) {
+	w := s.app.WindsAloftSource()
+	shear := winds.ComputeShear(samples,
+		s.app.Settings().WindsShearDirectionThreshold(),
+		s.app.Settings().WindsShearSpeedThreshold())
+	var (
+		result  []*WindsAloftSample
+		warning bool
+	)
+	for i, sample := range samples {
+		if !w.IsDisplayed(sample.Altitude) {
+			continue
+		}
+		ws := &WindsAloftSample{
+			Altitude:              int32(sample.Altitude),
+			AltitudeMsl:           int32(sample.AltitudeMSL),
+			Heading:               int32(s.app.DisplayHeading(sample.Heading)),
+			Speed:                 int32(sample.Speed),
+			Temperature:           int32(sample.Temperature),
+			Variable:              sample.LightAndVariable,
+			TrueHeading:           int32(sample.Heading),
+			MagneticHeading:       int32(s.app.MagneticHeading(sample.Heading)),
+			TemperatureFahrenheit: int32(sample.TemperatureFahrenheit()),
+			FeelsLike:             int32(sample.FeelsLike()),
+			FeelsLikeFahrenheit:   int32(sample.FeelsLikeFahrenheit()),
+			DirectionShear:        int32(shear[i].Direction),
+			SpeedShear:            int32(shear[i].Speed),
+			ShearWarning:          shear[i].Exceeds,
+		}
+		if t, ok := trends[sample.Altitude]; ok {
+			ws.SpeedChange = int32(t.SpeedChange)
+			ws.DirectionChange = int32(t.DirectionChange)
+			ws.SpeedTrend = t.Speed
+			ws.DirectionTrend = t.Direction
+		}
+		result = append(result, ws)
+		if shear[i].Exceeds {
+			warning = true
+		}
+	}
+	return result, warning
+}
+
+// newObservation returns the current METAR report as numbers, or nil if
+// there is no report.
+func newObservation(m *metar.Controller) *Observation {
+	_, observed := m.ObservationTime()
+	if !observed && !m.IsSimulated() {
+		return nil
+	}
+	o := &Observation{
+		WindSpeedKt:      proto.Float32(float32(m.WindSpeedKnots())),
+		WindDirectionDeg: proto.Int32(int32(m.WindDirectionTrueDegrees())),
+	}
+	if gust := m.WindGustSpeedKnots(); gust > 0 {
+		o.WindGustKt = proto.Float32(float32(gust))
+	}
+	if v, ok := m.TemperatureCelsius(); ok {
+		o.TemperatureC = proto.Float32(float32(v))
+	}
+	if v, ok := m.DewpointCelsius(); ok {
+		o.DewpointC = proto.Float32(float32(v))
+	}
+	if v, ok := m.CeilingFeet(); ok {
+		o.CeilingFt = proto.Int32(int32(v))
+	}
+	if v, ok := m.VisibilityStatuteMiles(); ok {
+		o.VisibilitySm = proto.Float32(float32(v))
+	}
+	if v, ok := m.AltimeterInHg(); ok {
+		o.AltimeterInHg = proto.Float32(float32(v))
+	}
+	return o
+}
+
+// Acknowledge records that a display rendered a takeover or critical alert,
+// so that the admin page can show which displays have shown it.
+func (s *manifestServiceServer) Acknowledge(
+	ctx context.Context,
+	req *AcknowledgeRequest,
+) (*AcknowledgeResponse, error) {
+	err := s.app.Acknowledge(req.AlertId, time.Unix(req.Posted, 0),
+		strings.TrimSpace(req.Display), channelFromContext(ctx))
+	if err != nil {
+		return &AcknowledgeResponse{
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+	return &AcknowledgeResponse{}, nil
+}
+
+// GetServerInfo returns the build the server is running and its uptime.
+func (s *manifestServiceServer) GetServerInfo(
+	_ context.Context,
+	_ *emptypb.Empty,
+) (*ServerInfo, error) {
+	b := s.app.BuildInfo()
+	return &ServerInfo{
+		BuildInfo: &BuildInfo{
+			Version:       b.Version,
+			Commit:        b.Commit,
+			BuildDate:     b.BuildDate,
+			GoVersion:     b.GoVersion,
+			StartedAt:     b.StartedAt.Unix(),
+			UptimeSeconds: int64(b.Uptime / time.Second),
+		},
+	}, nil
+}
+
+// GetWindHistory returns the surface winds reported over a day for a wind
+// trend graph.
+func (s *manifestServiceServer) GetWindHistory(
+	_ context.Context,
+	req *WindHistoryRequest,
+) (*WindHistory, error) {
+	if s.app.METARSource() == nil {
+		return &WindHistory{
+			ErrorMessage: "METAR is disabled",
+		}, nil
+	}
+	station, observations, err := s.app.WindHistory(req.Date, req.Station)
+	if err != nil {
+		return &WindHistory{
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+	h := &WindHistory{Station: station}
+	for _, o := range observations {
+		h.Observations = append(h.Observations, &WindObservation{
+			Time:      o.Time.Unix(),
+			SpeedKt:   float32(o.Speed),
+			GustKt:    float32(o.Gust),
+			Direction: int32(o.Direction),
+			Variable:  o.Variable,
+		})
+	}
+	return h, nil
+}
+
+// hasRole returns true if the session has one of roles.
+func (s *manifestServiceServer) hasRole(ctx context.Context, sessionID string, roles ...string) (bool, error) {
+	vresp, err := s.VerifySessionID(ctx, &VerifySessionRequest{
+		SessionId: sessionID,
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, role := range vresp.Roles {
+		for _, r := range roles {
+			if role == r {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// ListJumprunHistory returns the jumpruns that have been set, newest first.
+func (s *manifestServiceServer) ListJumprunHistory(
+	ctx context.Context,
+	req *JumprunHistoryRequest,
+) (*JumprunHistory, error) {
+	ok, err := s.hasRole(ctx, req.SessionId, "admin", "pilot")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &JumprunHistory{
+			ErrorMessage: "Permission Denied",
+		}, nil
+	}
+	if s.app.Jumprun() == nil {
+		return &JumprunHistory{
+			ErrorMessage: "Jumprun is disabled",
+		}, nil
+	}
+
+	h := &JumprunHistory{}
+	for _, e := range s.app.Jumprun().History() {
+		j := e.Jumprun
+		h.Entries = append(h.Entries, &JumprunHistoryEntry{
+			Id:             e.ID,
+			Time:           e.Time.Unix(),
+			Heading:        int32(j.Heading),
+			ExitDistance:   int32(j.ExitDistance),
+			OffsetHeading:  int32(j.OffsetHeading),
+			OffsetDistance: int32(j.OffsetDistance),
+			Latitude:       j.Latitude,
+			Longitude:      j.Longitude,
+		})
+	}
+	return h, nil
+}
+
+// RestoreJumprun makes a jumprun from the history the current one again.
+func (s *manifestServiceServer) RestoreJumprun(
+	ctx context.Context,
+	req *RestoreJumprunRequest,
+) (*RestoreJumprunResponse, error) {
+	ok, err := s.hasRole(ctx, req.SessionId, "admin", "pilot")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &RestoreJumprunResponse{
+			ErrorMessage: "Permission Denied",
+		}, nil
+	}
+	if s.app.Jumprun() == nil {
+		return &RestoreJumprunResponse{
+			ErrorMessage: "Jumprun is disabled",
+		}, nil
+	}
+	if err = s.app.Jumprun().Restore(req.Id); err != nil {
+		return &RestoreJumprunResponse{
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+	return &RestoreJumprunResponse{}, nil
+}
+
+// SetJumprun sets the jumprun as if it had been entered in the form.
+func (s *manifestServiceServer) SetJumprun(
+	ctx context.Context,
+	req *SetJumprunRequest,
+) (*SetJumprunResponse, error) {
+	ok, err := s.hasRole(ctx, req.SessionId, "admin", "pilot")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &SetJumprunResponse{
+			ErrorMessage: "Permission Denied",
+		}, nil
+	}
+	if s.app.Jumprun() == nil {
+		return &SetJumprunResponse{
+			ErrorMessage: "Jumprun is disabled",
+		}, nil
+	}
+
+	j := s.app.Jumprun().Origin()
+	j.Heading = int(req.Heading)
+	j.ExitDistance = int(req.ExitDistance)
+	j.OffsetHeading = int(req.OffsetHeading)
+	j.OffsetDistance = int(req.OffsetDistance)
+	if len(req.HookTurns) > len(j.HookTurns) {
+		return &SetJumprunResponse{
+			ErrorMessage: fmt.Sprintf("At most %d hook turns may be given", len(j.HookTurns)),
+		}, nil
+	}
+	for i, t := range req.HookTurns {
+		j.HookTurns[i] = jumprun.Turn{
+			Distance: int(t.Distance),
+			Heading:  int(t.Heading),
+		}
+	}
+	if req.Latitude != nil {
+		j.Latitude = *req.Latitude
+	}
+	if req.Longitude != nil {
+		j.Longitude = *req.Longitude
+	}
+	if req.MagneticDeclination != nil {
+		j.MagneticDeclination = int(*req.MagneticDeclination)
+	}
+	if req.CameraHeight != nil {
+		j.CameraHeight = int(*req.CameraHeight)
+	}
+
+	warnings, err := s.app.Jumprun().Update(j)
+	if err != nil {
+		return &SetJumprunResponse{
+			ErrorMessage: err.Error(),
+		}, nil
+	}
+	return &SetJumprunResponse{
+		Warnings: warnings,
+	}, nil
+}
+
+// ClearJumprun unsets the jumprun.
+func (s *manifestServiceServer) ClearJumprun(
+	ctx context.Context,
+	req *ClearJumprunRequest,
+) (*ClearJumprunResponse, error) {
+	ok, err := s.hasRole(ctx, req.SessionId, "admin", "pilot")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &ClearJumprunResponse{
+			ErrorMessage: "Permission Denied",
+		}, nil
+	}
+	if s.app.Jumprun() == nil {
+		return &ClearJumprunResponse{
+			ErrorMessage: "Jumprun is disabled",
+		}, nil
+	}
+	s.app.Jumprun().Clear()
+	return &ClearJumprunResponse{}, nil
+}
+
 func (s *manifestServiceServer) Start() {
 	ctx, cancel := context.WithCancel(context.Background())
 	s.cancel = cancel
@@ -471,6 +1155,7 @@ func (s *manifestServiceServer) Start() {
 
 func (s *manifestServiceServer) Stop() {
 	s.cancel()
+	close(s.stopped)
 	s.wg.Wait()
 }
 
@@ -497,6 +1182,8 @@ func (s *manifestServiceServer) StreamUpdates(
 	_ *emptypb.Empty,
 	stream ManifestService_StreamUpdatesServer,
 ) error {
+	channel := channelFromContext(stream.Context())
+	privacy := s.privacyFromContext(stream.Context(), channel)
 	c := make(chan *ManifestUpdate, 16)
 	id := s.addClient(c)
 	defer s.removeClient(id)
@@ -508,13 +1195,38 @@ func (s *manifestServiceServer) StreamUpdates(
 		case <-s.app.Done():
 			return nil
 		case u := <-c:
-			if err := stream.Send(u); err != nil {
+			if u.Trace != nil {
+				now := s.app.CurrentTime()
+				u.Trace.SentAtMs = now.UnixMilli()
+				s.app.RecordLatency(core.LatencySend,
+					now.Sub(time.UnixMilli(u.Trace.ConstructedAtMs)))
+			}
+			if err := stream.Send(s.forChannel(u, channel, privacy)); err != nil {
 				return err
 			}
 		}
 	}
 }
 
+// Resync returns a full snapshot of the current state. Clients that detect
+// an inconsistency call this rather than reconnecting their stream. The
+// snapshot reflects every update already sent on the stream, and since
+// each update replaces whole sections, updates that follow apply cleanly.
+func (s *manifestServiceServer) Resync(
+	ctx context.Context,
+	req *ResyncRequest,
+) (*ManifestUpdate, error) {
+	if req.Reason != "" {
+		fmt.Fprintf(os.Stderr, "Client requested resync: %s\n", req.Reason)
+	}
+	d, err := s.requestDelta(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	channel := channelFromContext(ctx)
+	return s.forChannel(d.update, channel, s.privacyFromContext(ctx, channel)), nil
+}
+
 func (s *manifestServiceServer) SignInWithApple(
 	ctx context.Context,
 	req *SignInWithAppleRequest,
@@ -729,3 +1441,164 @@ func (s *manifestServiceServer) RestartServer(
 		ErrorMessage: "Permission Denied",
 	}, nil
 }
+
+func (s *manifestServiceServer) SimulateWeather(
+	ctx context.Context,
+	req *SimulateWeatherRequest,
+) (*SimulateWeatherResponse, error) {
+	vreq := VerifySessionRequest{
+		SessionId: req.SessionId,
+	}
+	vresp, err := s.VerifySessionID(ctx, &vreq)
+	if err != nil {
+		return nil, err
+	}
+
+	ok := false
+	for _, role := range vresp.Roles {
+		if role == "admin" {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return &SimulateWeatherResponse{
+			ErrorMessage: "Permission Denied",
+		}, nil
+	}
+
+	if req.Clear {
+		s.app.ClearWeatherSimulation()
+		return &SimulateWeatherResponse{}, nil
+	}
+
+	optionalFloat := func(f *float32) *float64 {
+		if f == nil {
+			return nil
+		}
+		v := float64(*f)
+		return &v
+	}
+	m := &metar.Simulation{
+		WindSpeed:     optionalFloat(req.WindSpeed),
+		WindGust:      optionalFloat(req.WindGust),
+		WindDirection: optionalFloat(req.WindDirection),
+		Temperature:   optionalFloat(req.Temperature),
+		SkyCover:      req.SkyCover,
+		Weather:       req.Weather,
+	}
+
+	var samples []winds.Sample
+	altitudes := make(map[int32]bool)
+	for _, sample := range req.WindsAloft {
+		if sample.Altitude < 0 {
+			return &SimulateWeatherResponse{
+				ErrorMessage: fmt.Sprintf("Invalid winds aloft altitude %d", sample.Altitude),
+			}, nil
+		}
+		if altitudes[sample.Altitude] {
+			return &SimulateWeatherResponse{
+				ErrorMessage: fmt.Sprintf("Duplicate winds aloft altitude %d", sample.Altitude),
+			}, nil
+		}
+		altitudes[sample.Altitude] = true
+		samples = append(samples, winds.Sample{
+			Altitude:         int(sample.Altitude),
+			Heading:          int(sample.Heading),
+			Speed:            int(sample.Speed),
+			Temperature:      int(sample.Temperature),
+			LightAndVariable: sample.Variable || sample.Speed <= 0,
+		})
+	}
+
+	s.app.SimulateWeather(m, samples)
+	return &SimulateWeatherResponse{}, nil
+}
+
+func (s *manifestServiceServer) PostAlert(
+	ctx context.Context,
+	req *PostAlertRequest,
+) (*PostAlertResponse, error) {
+	vreq := VerifySessionRequest{
+		SessionId: req.SessionId,
+	}
+	vresp, err := s.VerifySessionID(ctx, &vreq)
+	if err != nil {
+		return nil, err
+	}
+
+	ok := false
+	for _, role := range vresp.Roles {
+		if role == "admin" {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return &PostAlertResponse{
+			ErrorMessage: "Permission Denied",
+		}, nil
+	}
+
+	if req.Id == "" {
+		return &PostAlertResponse{
+			ErrorMessage: "Alert ID is required",
+		}, nil
+	}
+	if req.Clear {
+		s.app.ClearAlert(req.Id)
+		return &PostAlertResponse{}, nil
+	}
+
+	a := core.Alert{
+		ID:       req.Id,
+		Class:    req.Class,
+		Priority: core.AlertPriority(req.Priority),
+		Message:  req.Message,
+	}
+	if req.Expires != 0 {
+		a.Expires = time.Unix(req.Expires, 0)
+	}
+	s.app.PostAlert(a)
+	return &PostAlertResponse{}, nil
+}
+
+func (s *manifestServiceServer) PostTakeover(
+	ctx context.Context,
+	req *PostTakeoverRequest,
+) (*PostTakeoverResponse, error) {
+	vreq := VerifySessionRequest{
+		SessionId: req.SessionId,
+	}
+	vresp, err := s.VerifySessionID(ctx, &vreq)
+	if err != nil {
+		return nil, err
+	}
+
+	ok := false
+	for _, role := range vresp.Roles {
+		if role == "admin" {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return &PostTakeoverResponse{
+			ErrorMessage: "Permission Denied",
+		}, nil
+	}
+
+	if req.Clear {
+		s.app.ClearTakeover()
+		return &PostTakeoverResponse{}, nil
+	}
+	if strings.TrimSpace(req.Message) == "" {
+		return &PostTakeoverResponse{
+			ErrorMessage: "Takeover message is required",
+		}, nil
+	}
+
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	s.app.PostTakeover(strings.TrimSpace(req.Message), ttl)
+	return &PostTakeoverResponse{}, nil
+}
@@ -26,50 +26,83 @@ const (
 
 type WebContentFunc func(http.ResponseWriter, *http.Request)
 
+// Zones group endpoints so that they can be served on their own listener
+// or restricted to particular networks.
+const (
+	AdminZone   = "admin"
+	DisplayZone = "display"
+	PublicZone  = "public"
+)
+
 type WebContent struct {
 	Func        WebContentFunc
 	Content     []byte
 	ContentType string
 	ModifyTime  time.Time
+	Zone        string
+}
+
+// ContentProducer is a named source of web content that routes refer to.
+type ContentProducer struct {
+	Zone string
+	Func WebContentFunc
+}
+
+type webZone struct {
+	addresses []string
+	allowed   []*net.IPNet
+	server    *http.Server
 }
 
 type WebServer struct {
-	httpServer  *http.Server
-	httpsServer *http.Server
-	wg          sync.WaitGroup
+	httpServer     *http.Server
+	httpAddresses  []string
+	httpsServer    *http.Server
+	httpsAddresses []string
+	wg             sync.WaitGroup
 
 	certFile string
 	keyFile  string
 
 	app *core.Controller
 
-	grpcServer        *grpc.Server
-	grpcServerAddress string
-	grpcServiceServer *manifestServiceServer
+	grpcServer          *grpc.Server
+	grpcServerAddresses []string
+	grpcServiceServer   *manifestServiceServer
 
-	lock    sync.Mutex
-	content map[string]WebContent
+	lock      sync.Mutex
+	content   map[string]WebContent
+	zones     map[string]*webZone
+	producers map[string]ContentProducer
 }
 
+// NewWebServer creates a web server that listens for HTTP, HTTPS, and gRPC
+// requests on each of the given addresses, e.g. on both IPv4 and IPv6 or on
+// both a LAN and a VPN interface.
 func NewWebServer(
 	controller *core.Controller,
-	httpAddress, httpsAddress, grpcAddress, certFile, keyFile string,
+	httpAddresses, httpsAddresses, grpcAddresses []string,
+	certFile, keyFile string,
 ) (*WebServer, error) {
 	s := &WebServer{
-		app:               controller,
-		certFile:          certFile,
-		keyFile:           keyFile,
-		content:           make(map[string]WebContent),
-		grpcServerAddress: grpcAddress,
+		app:                 controller,
+		certFile:            certFile,
+		keyFile:             keyFile,
+		content:             make(map[string]WebContent),
+		zones:               make(map[string]*webZone),
+		producers:           make(map[string]ContentProducer),
+		httpAddresses:       httpAddresses,
+		httpsAddresses:      httpsAddresses,
+		grpcServerAddresses: grpcAddresses,
 	}
 	if s.keyFile == "" {
 		s.keyFile = s.certFile
 	}
-	if httpAddress == "" {
-		httpAddress = ":http"
+	if len(s.httpAddresses) == 0 {
+		s.httpAddresses = []string{":http"}
 	}
-	if httpsAddress == "" {
-		httpsAddress = ":https"
+	if len(s.httpsAddresses) == 0 {
+		s.httpsAddresses = []string{":https"}
 	}
 
 	if certFile != "" {
@@ -81,7 +114,6 @@ func NewWebServer(
 				url := fmt.Sprintf("https://%s%s", req.Host, req.URL)
 				http.Redirect(w, req, url, http.StatusMovedPermanently)
 			}),
-			Addr:         httpAddress,
 			ReadTimeout:  readTimeout,
 			WriteTimeout: writeTimeout,
 		}
@@ -111,79 +143,113 @@ func NewWebServer(
 			},
 		}
 		s.httpsServer = &http.Server{
-			Handler:      http.HandlerFunc(s.requestHandler),
-			Addr:         httpsAddress,
+			Handler:      s.zoneHandler(""),
 			TLSConfig:    c,
 			ReadTimeout:  readTimeout,
 			WriteTimeout: writeTimeout,
 		}
 
-		if s.grpcServerAddress != "" {
+		if len(s.grpcServerAddresses) > 0 {
 			creds, err := credentials.NewServerTLSFromFile(s.certFile, s.keyFile)
 			if err != nil {
 				return nil, err
 			}
-			s.grpcServer = grpc.NewServer(grpc.Creds(creds))
+			s.grpcServer = grpc.NewServer(serverOptions(grpc.Creds(creds))...)
 		}
 	} else {
 		s.httpServer = &http.Server{
-			Handler:      http.HandlerFunc(s.requestHandler),
-			Addr:         httpAddress,
+			Handler:      s.zoneHandler(""),
 			ReadTimeout:  readTimeout,
 			WriteTimeout: writeTimeout,
 		}
-		if s.grpcServerAddress != "" {
-			s.grpcServer = grpc.NewServer()
+		if len(s.grpcServerAddresses) > 0 {
+			s.grpcServer = grpc.NewServer(serverOptions()...)
 		}
 	}
 	if s.grpcServer != nil {
 		s.grpcServiceServer = newManifestServiceServer(controller)
 		RegisterManifestServiceServer(s.grpcServer, s.grpcServiceServer)
+		s.RegisterProducer("manifest", DisplayZone,
+			controller.ServeWhenWarm(s.grpcServiceServer.ManifestHandler))
+		s.RegisterProducer("manifest_delta", DisplayZone,
+			controller.ServeWhenWarm(s.grpcServiceServer.DeltaHandler))
+		s.RegisterProducer("manifest_pb", DisplayZone,
+			controller.ServeWhenWarm(s.grpcServiceServer.ProtobufHandler))
+		s.RegisterProducer("winds", DisplayZone,
+			controller.ServeWhenWarm(s.grpcServiceServer.WindsHandler))
+		_ = s.SetRoute("/manifest.json", "manifest")
+		_ = s.SetRoute("/manifest/delta", "manifest_delta")
+		_ = s.SetRoute("/manifest.pb", "manifest_pb")
+		_ = s.SetRoute("/winds.json", "winds")
 	}
 
 	return s, nil
 }
 
-func (s *WebServer) Start() error {
-	if s.httpsServer != nil {
-		l, err := net.Listen("tcp", s.httpsServer.Addr)
+// listen opens a listener on each address and serves each of them with
+// serve. If any address cannot be listened on, the listeners already opened
+// are closed.
+func (s *WebServer) listen(addresses []string, serve func(net.Listener) error) error {
+	listeners := make([]net.Listener, 0, len(addresses))
+	for _, address := range addresses {
+		l, err := net.Listen("tcp", address)
 		if err != nil {
-			return err
+			for _, l = range listeners {
+				l.Close()
+			}
+			return fmt.Errorf("listen on %s: %w", address, err)
 		}
+		listeners = append(listeners, l)
+	}
 
+	for _, l := range listeners {
+		l := l
 		s.wg.Add(1)
 		go func() {
 			defer s.wg.Done()
-			_ = s.httpsServer.ServeTLS(l, s.certFile, s.keyFile)
+			_ = serve(l)
 		}()
 	}
+	return nil
+}
 
-	if s.httpServer != nil {
-		l, err := net.Listen("tcp", s.httpServer.Addr)
+func (s *WebServer) Start() error {
+	if s.httpsServer != nil {
+		err := s.listen(s.httpsAddresses, func(l net.Listener) error {
+			return s.httpsServer.ServeTLS(l, s.certFile, s.keyFile)
+		})
 		if err != nil {
 			return err
 		}
+	}
 
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			_ = s.httpServer.Serve(l)
-		}()
+	if s.httpServer != nil {
+		if err := s.listen(s.httpAddresses, s.httpServer.Serve); err != nil {
+			return err
+		}
 	}
 
-	if s.grpcServer != nil {
-		l, err := net.Listen("tcp", s.grpcServerAddress)
+	for _, z := range s.zones {
+		if z.server == nil {
+			continue
+		}
+		server := z.server
+		err := s.listen(z.addresses, func(l net.Listener) error {
+			if s.certFile != "" {
+				return server.ServeTLS(l, s.certFile, s.keyFile)
+			}
+			return server.Serve(l)
+		})
 		if err != nil {
 			return err
 		}
+	}
 
+	if s.grpcServer != nil {
 		s.grpcServiceServer.Start()
-
-		s.wg.Add(1)
-		go func() {
-			defer s.wg.Done()
-			_ = s.grpcServer.Serve(l)
-		}()
+		if err := s.listen(s.grpcServerAddresses, s.grpcServer.Serve); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -197,6 +263,11 @@ func (s *WebServer) Close() {
 	if s.httpsServer != nil {
 		_ = s.httpsServer.Shutdown(ctx)
 	}
+	for _, z := range s.zones {
+		if z.server != nil {
+			_ = z.server.Shutdown(ctx)
+		}
+	}
 	if s.grpcServer != nil {
 		s.grpcServer.GracefulStop()
 		s.grpcServiceServer.Stop()
@@ -204,13 +275,74 @@ func (s *WebServer) Close() {
 	s.wg.Wait()
 }
 
+// ConfigureZone sets the listener addresses and allowed networks for a
+// zone. If addresses is not empty, the zone's endpoints are served only on
+// those addresses. If allowed is not empty, requests from other networks are
+// refused. ConfigureZone must be called before Start.
+func (s *WebServer) ConfigureZone(zone string, addresses []string, allowed []*net.IPNet) {
+	z := &webZone{
+		addresses: addresses,
+		allowed:   allowed,
+	}
+	if len(addresses) > 0 {
+		z.server = &http.Server{
+			Handler:      s.zoneHandler(zone),
+			ReadTimeout:  readTimeout,
+			WriteTimeout: writeTimeout,
+		}
+		if s.httpsServer != nil {
+			z.server.TLSConfig = s.httpsServer.TLSConfig
+		}
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.zones[zone] = z
+}
+
+// RegisterProducer makes a content producer available to routes by name.
+func (s *WebServer) RegisterProducer(name, zone string, f WebContentFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.producers[name] = ContentProducer{
+		Zone: zone,
+		Func: f,
+	}
+}
+
+// SetRoute serves the named content producer at path.
+func (s *WebServer) SetRoute(path, name string) error {
+	s.lock.Lock()
+	p, ok := s.producers[name]
+	s.lock.Unlock()
+
+	if !ok {
+		return fmt.Errorf("unknown content for route %s: %q", path, name)
+	}
+	s.SetZoneContentFunc(p.Zone, path, p.Func)
+	return nil
+}
+
+// DisableRoute stops serving any content at path.
+func (s *WebServer) DisableRoute(path string) {
+	path = strings.TrimPrefix(path, "/")
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.content, path)
+}
+
 func (s *WebServer) SetContentFunc(path string, f WebContentFunc) {
+	s.SetZoneContentFunc(PublicZone, path, f)
+}
+
+func (s *WebServer) SetZoneContentFunc(zone, path string, f WebContentFunc) {
 	path = strings.TrimPrefix(path, "/")
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
 	s.content[path] = WebContent{
 		Func: f,
+		Zone: zone,
 	}
 }
 
@@ -232,6 +364,7 @@ func (s *WebServer) SetContentWithTime(
 		Content:     content,
 		ModifyTime:  modifyTime,
 		ContentType: contentType,
+		Zone:        PublicZone,
 	}
 }
 
@@ -246,14 +379,56 @@ func (s *WebServer) ContentModifyTime(path string) (time.Time, bool) {
 	return time.Now(), false
 }
 
-func (s *WebServer) requestHandler(w http.ResponseWriter, req *http.Request) {
+func (z *webZone) allows(remoteAddr string) bool {
+	if len(z.allowed) == 0 {
+		return true
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, n := range z.allowed {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// zoneHandler returns a handler serving the content in a zone. The empty
+// zone serves all content in zones that do not have their own listener.
+func (s *WebServer) zoneHandler(zone string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		s.requestHandler(zone, w, req)
+	})
+}
+
+func (s *WebServer) requestHandler(zone string, w http.ResponseWriter, req *http.Request) {
 	h := w.Header()
 	path := strings.TrimPrefix(req.URL.Path, "/")
 
 	s.lock.Lock()
 	content, ok := s.content[path]
+	z := s.zones[content.Zone]
 	s.lock.Unlock()
 
+	if ok {
+		if zone != "" {
+			ok = content.Zone == zone
+		} else if z != nil {
+			ok = z.server == nil
+		}
+	}
+	if ok && z != nil && !z.allows(req.RemoteAddr) {
+		h.Set("Connection", "close")
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+
 	if !ok {
 		h.Set("Connection", "close")
 		http.NotFound(w, req)
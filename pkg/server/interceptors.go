@@ -0,0 +1,72 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func peerAddress(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return "unknown"
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	fmt.Fprintf(os.Stderr, "RPC %s from %s: %s in %v\n", method,
+		peerAddress(ctx), status.Code(err), time.Since(start).Round(time.Millisecond))
+}
+
+// recoverRPC turns a panic in an RPC handler into an Internal error so that
+// one bad request can't take down the server.
+func recoverRPC(method string, err *error) {
+	if r := recover(); r != nil {
+		fmt.Fprintf(os.Stderr, "RPC %s panicked: %v\n%s", method, r, debug.Stack())
+		*err = status.Errorf(codes.Internal, "internal error")
+	}
+}
+
+func unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	start := time.Now()
+	defer func() {
+		logRPC(ctx, info.FullMethod, start, err)
+	}()
+	defer recoverRPC(info.FullMethod, &err)
+	return handler(ctx, req)
+}
+
+func streamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) (err error) {
+	start := time.Now()
+	defer func() {
+		logRPC(ss.Context(), info.FullMethod, start, err)
+	}()
+	defer recoverRPC(info.FullMethod, &err)
+	return handler(srv, ss)
+}
+
+// serverOptions returns the options common to all gRPC servers.
+func serverOptions(opts ...grpc.ServerOption) []grpc.ServerOption {
+	return append(opts,
+		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.StreamInterceptor(streamInterceptor))
+}
@@ -0,0 +1,52 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+// loadHeaderRows is the number of rows a display uses for a load's header:
+// the aircraft and load number, then the call time and slots.
+const loadHeaderRows = 2
+
+// loadRows returns the number of rows needed to display a load.
+func loadRows(l *Load) int {
+	rows := loadHeaderRows
+	for _, slot := range l.Slots {
+		rows++
+		if g := slot.GetGroup(); g != nil {
+			rows += len(g.Members)
+			if g.Header != "" {
+				rows++
+			}
+		}
+	}
+	return rows
+}
+
+// layoutLoads computes layout hints for each load so that every display
+// breaks loads across columns the same way. Each load starts in a new
+// column. If rowsPerColumn is positive, a load with more rows than that
+// continues into as many following columns as it needs. Loads that don't
+// fit in the available columns are given a column of -1.
+func layoutLoads(loads *Loads, rowsPerColumn int) {
+	if loads == nil {
+		return
+	}
+	column := 0
+	for _, l := range loads.Loads {
+		rows := loadRows(l)
+		span := 1
+		if rowsPerColumn > 0 && rows > rowsPerColumn {
+			span = (rows + rowsPerColumn - 1) / rowsPerColumn
+		}
+
+		hint := &LayoutHint{
+			Rows:       int32(rows),
+			Column:     int32(column),
+			ColumnSpan: int32(span),
+		}
+		if column+span > int(loads.ColumnCount) {
+			hint.Column = -1
+		}
+		l.Layout = hint
+		column += span
+	}
+}
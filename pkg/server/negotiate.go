@@ -0,0 +1,111 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type encoding int
+
+const (
+	compactJSON encoding = iota
+	prettyJSON
+	protobuf
+)
+
+// negotiate selects the encoding for a response from the request's Accept
+// header. Protobuf is selected by application/x-protobuf (or
+// application/protobuf), and pretty-printed JSON by a "pretty" parameter on
+// application/json or a pretty query parameter. Otherwise compact JSON is
+// used.
+func negotiate(req *http.Request) encoding {
+	if req.URL.Query().Get("pretty") != "" {
+		return prettyJSON
+	}
+	for _, accept := range strings.Split(req.Header.Get("Accept"), ",") {
+		// Parse parameters loosely since "pretty" has no value, which
+		// mime.ParseMediaType rejects.
+		params := strings.Split(accept, ";")
+		switch strings.ToLower(strings.TrimSpace(params[0])) {
+		case "application/x-protobuf", "application/protobuf":
+			return protobuf
+		case "application/json":
+			for _, param := range params[1:] {
+				name := strings.SplitN(param, "=", 2)[0]
+				if strings.EqualFold(strings.TrimSpace(name), "pretty") {
+					return prettyJSON
+				}
+			}
+			return compactJSON
+		}
+	}
+	return compactJSON
+}
+
+// writeMessage writes m to w using the encoding negotiated for req.
+func writeMessage(w http.ResponseWriter, req *http.Request, m proto.Message) {
+	writeMessageEncoding(w, req, m, negotiate(req))
+}
+
+// writeMessageEncoding writes m to w using encoding e.
+func writeMessageEncoding(w http.ResponseWriter, req *http.Request, m proto.Message, e encoding) {
+	var (
+		data        []byte
+		err         error
+		contentType string
+	)
+	switch e {
+	case protobuf:
+		data, err = proto.Marshal(m)
+		contentType = "application/x-protobuf"
+	case prettyJSON:
+		data, err = protojson.MarshalOptions{Multiline: true}.Marshal(m)
+		contentType = "application/json; charset=utf-8"
+	default:
+		data, err = protojson.Marshal(m)
+		contentType = "application/json; charset=utf-8"
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "cannot encode %s: %v\n", req.URL.Path, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError),
+			http.StatusInternalServerError)
+		return
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", contentType)
+	h.Set("Cache-Control", "no-cache")
+	h.Add("Vary", "Accept")
+	_, _ = w.Write(data)
+}
+
+// ManifestHandler serves the complete current ManifestUpdate.
+func (s *manifestServiceServer) ManifestHandler(w http.ResponseWriter, req *http.Request) {
+	d, ok := s.requestDeltaHTTP(w, req, 0)
+	if !ok {
+		return
+	}
+	channel := channelFromRequest(req)
+	writeMessage(w, req, s.forChannel(d.update, channel, s.privacyFromRequest(req, channel)))
+}
+
+// WindsHandler serves the current winds aloft.
+func (s *manifestServiceServer) WindsHandler(w http.ResponseWriter, req *http.Request) {
+	d, ok := s.requestDeltaHTTP(w, req, 0)
+	if !ok {
+		return
+	}
+	u := d.update
+	if u.WindsAloft == nil {
+		http.NotFound(w, req)
+		return
+	}
+	writeMessage(w, req, u.WindsAloft)
+}
@@ -0,0 +1,165 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// channelMetadataKey is the gRPC metadata key that clients use to name the
+// channel they display. HTTP clients use the channel query parameter.
+const channelMetadataKey = "x-manifest-channel"
+
+// sessionMetadataKey is the gRPC metadata key, and sessionHeader the HTTP
+// header, that clients use to pass the session ID of a signed in admin.
+const (
+	sessionMetadataKey = "x-manifest-session"
+	sessionHeader      = "X-Manifest-Session"
+)
+
+// defaultChannel is the channel assumed for clients that don't name one.
+const defaultChannel = "display"
+
+// privacyRank orders the name privacy modes from least to most private.
+var privacyRank = map[string]int{
+	settings.NamesFull:   0,
+	settings.NamesShort:  1,
+	settings.NamesHidden: 2,
+}
+
+func channelFromContext(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(channelMetadataKey); len(v) > 0 && v[0] != "" {
+			return strings.ToLower(v[0])
+		}
+	}
+	return defaultChannel
+}
+
+func channelFromRequest(req *http.Request) string {
+	if channel := req.URL.Query().Get("channel"); channel != "" {
+		return strings.ToLower(channel)
+	}
+	return defaultChannel
+}
+
+// namePrivacy returns the name privacy mode for a client on channel. Clients
+// choose their own channel, so a channel that shows more of the names than
+// the default channel does is only honored for a signed in admin.
+func (s *manifestServiceServer) namePrivacy(ctx context.Context, channel, sessionID string) string {
+	privacy := s.app.Settings().NamePrivacy(channel)
+	public := s.app.Settings().NamePrivacy(defaultChannel)
+	if privacyRank[privacy] >= privacyRank[public] {
+		return privacy
+	}
+	if sessionID != "" {
+		if ok, err := s.hasRole(ctx, sessionID, "admin"); err == nil && ok {
+			return privacy
+		}
+	}
+	return public
+}
+
+// privacyFromContext returns the name privacy mode for a gRPC client on
+// channel.
+func (s *manifestServiceServer) privacyFromContext(ctx context.Context, channel string) string {
+	var sessionID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(sessionMetadataKey); len(v) > 0 {
+			sessionID = v[0]
+		}
+	}
+	return s.namePrivacy(ctx, channel, sessionID)
+}
+
+// privacyFromRequest returns the name privacy mode for an HTTP client on
+// channel.
+func (s *manifestServiceServer) privacyFromRequest(req *http.Request, channel string) string {
+	return s.namePrivacy(req.Context(), channel, req.Header.Get(sessionHeader))
+}
+
+// shortName returns a name as a first name and last initial, e.g.
+// "Alex R." for "Alex Rivera".
+func shortName(name string) string {
+	fields := strings.Fields(name)
+	if len(fields) < 2 {
+		return name
+	}
+	last := []rune(fields[len(fields)-1])
+	return fmt.Sprintf("%s %c.", fields[0], last[0])
+}
+
+func redactJumper(j *Jumper, name string) {
+	if j == nil {
+		return
+	}
+	if j.Name != "" {
+		j.Repr = strings.Replace(j.Repr, j.Name, name, 1)
+	}
+	j.Name = name
+	j.Nickname = ""
+}
+
+func redactSlot(slot *LoadSlot, privacy string) {
+	switch s := slot.Slot.(type) {
+	case *LoadSlot_Jumper:
+		name := "Jumper"
+		if privacy == settings.NamesShort {
+			name = shortName(s.Jumper.Name)
+		}
+		redactJumper(s.Jumper, name)
+	case *LoadSlot_Group:
+		g := s.Group
+		if privacy == settings.NamesShort {
+			redactJumper(g.Leader, shortName(g.Leader.GetName()))
+			for _, m := range g.Members {
+				redactJumper(m, shortName(m.Name))
+			}
+			return
+		}
+		redactJumper(g.Leader, fmt.Sprintf("Group of %d", len(g.Members)+1))
+		g.Members = nil
+	}
+}
+
+// redactUpdate rewrites the jumper names in an update according to a name
+// privacy mode. The update is modified in place.
+func (s *manifestServiceServer) redactUpdate(u *ManifestUpdate, privacy string) *ManifestUpdate {
+	if u == nil || u.Loads == nil || privacy == settings.NamesFull {
+		return u
+	}
+	for _, l := range u.Loads.Loads {
+		for _, slot := range l.Slots {
+			redactSlot(slot, privacy)
+		}
+		for _, g := range l.ExitOrder {
+			switch {
+			case privacy == settings.NamesShort:
+				g.Name = shortName(g.Name)
+			case g.Size > 1:
+				g.Name = fmt.Sprintf("Group of %d", g.Size)
+			default:
+				g.Name = "Jumper"
+			}
+		}
+	}
+	for _, j := range u.Loads.Standby {
+		name := "Jumper"
+		if privacy == settings.NamesShort {
+			name = shortName(j.Name)
+		}
+		redactJumper(j, name)
+	}
+	if privacy == settings.NamesHidden {
+		// Groups have been collapsed, so they take fewer rows
+		layoutLoads(u.Loads, s.app.Settings().DisplayRows())
+	}
+	return u
+}
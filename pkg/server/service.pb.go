@@ -21,6 +21,52 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type HeadingReference int32
+
+const (
+	HeadingReference_MAGNETIC HeadingReference = 0
+	HeadingReference_TRUE     HeadingReference = 1
+)
+
+// Enum value maps for HeadingReference.
+var (
+	HeadingReference_name = map[int32]string{
+		0: "MAGNETIC",
+		1: "TRUE",
+	}
+	HeadingReference_value = map[string]int32{
+		"MAGNETIC": 0,
+		"TRUE":     1,
+	}
+)
+
+func (x HeadingReference) Enum() *HeadingReference {
+	p := new(HeadingReference)
+	*p = x
+	return p
+}
+
+func (x HeadingReference) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HeadingReference) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_server_service_proto_enumTypes[0].Descriptor()
+}
+
+func (HeadingReference) Type() protoreflect.EnumType {
+	return &file_pkg_server_service_proto_enumTypes[0]
+}
+
+func (x HeadingReference) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HeadingReference.Descriptor instead.
+func (HeadingReference) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{0}
+}
+
 type JumperType int32
 
 const (
@@ -69,11 +115,11 @@ func (x JumperType) String() string {
 }
 
 func (JumperType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_server_service_proto_enumTypes[0].Descriptor()
+	return file_pkg_server_service_proto_enumTypes[1].Descriptor()
 }
 
 func (JumperType) Type() protoreflect.EnumType {
-	return &file_pkg_server_service_proto_enumTypes[0]
+	return &file_pkg_server_service_proto_enumTypes[1]
 }
 
 func (x JumperType) Number() protoreflect.EnumNumber {
@@ -82,30 +128,255 @@ func (x JumperType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use JumperType.Descriptor instead.
 func (JumperType) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{1}
+}
+
+type CallState int32
+
+const (
+	CallState_MANIFESTING  CallState = 0
+	CallState_CALL         CallState = 1
+	CallState_BOARDING     CallState = 2
+	CallState_AIRBORNE     CallState = 3
+	CallState_JUMPERS_AWAY CallState = 4
+	CallState_LANDED       CallState = 5
+)
+
+// Enum value maps for CallState.
+var (
+	CallState_name = map[int32]string{
+		0: "MANIFESTING",
+		1: "CALL",
+		2: "BOARDING",
+		3: "AIRBORNE",
+		4: "JUMPERS_AWAY",
+		5: "LANDED",
+	}
+	CallState_value = map[string]int32{
+		"MANIFESTING":  0,
+		"CALL":         1,
+		"BOARDING":     2,
+		"AIRBORNE":     3,
+		"JUMPERS_AWAY": 4,
+		"LANDED":       5,
+	}
+)
+
+func (x CallState) Enum() *CallState {
+	p := new(CallState)
+	*p = x
+	return p
+}
+
+func (x CallState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CallState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_server_service_proto_enumTypes[2].Descriptor()
+}
+
+func (CallState) Type() protoreflect.EnumType {
+	return &file_pkg_server_service_proto_enumTypes[2]
+}
+
+func (x CallState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CallState.Descriptor instead.
+func (CallState) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{2}
+}
+
+type AlertPriority int32
+
+const (
+	AlertPriority_INFO     AlertPriority = 0
+	AlertPriority_WARNING  AlertPriority = 1
+	AlertPriority_CRITICAL AlertPriority = 2
+)
+
+// Enum value maps for AlertPriority.
+var (
+	AlertPriority_name = map[int32]string{
+		0: "INFO",
+		1: "WARNING",
+		2: "CRITICAL",
+	}
+	AlertPriority_value = map[string]int32{
+		"INFO":     0,
+		"WARNING":  1,
+		"CRITICAL": 2,
+	}
+)
+
+func (x AlertPriority) Enum() *AlertPriority {
+	p := new(AlertPriority)
+	*p = x
+	return p
+}
+
+func (x AlertPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AlertPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_server_service_proto_enumTypes[3].Descriptor()
+}
+
+func (AlertPriority) Type() protoreflect.EnumType {
+	return &file_pkg_server_service_proto_enumTypes[3]
+}
+
+func (x AlertPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AlertPriority.Descriptor instead.
+func (AlertPriority) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{3}
+}
+
+type SmoothedWind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Speed      float32 `protobuf:"fixed32,1,opt,name=speed,proto3" json:"speed,omitempty"`
+	Gust       float32 `protobuf:"fixed32,2,opt,name=gust,proto3" json:"gust,omitempty"`
+	Direction  int32   `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	GustFactor float32 `protobuf:"fixed32,4,opt,name=gust_factor,json=gustFactor,proto3" json:"gust_factor,omitempty"`
+	Samples    int32   `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *SmoothedWind) Reset() {
+	*x = SmoothedWind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmoothedWind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmoothedWind) ProtoMessage() {}
+
+func (x *SmoothedWind) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmoothedWind.ProtoReflect.Descriptor instead.
+func (*SmoothedWind) Descriptor() ([]byte, []int) {
 	return file_pkg_server_service_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *SmoothedWind) GetSpeed() float32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *SmoothedWind) GetGust() float32 {
+	if x != nil {
+		return x.Gust
+	}
+	return 0
+}
+
+func (x *SmoothedWind) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *SmoothedWind) GetGustFactor() float32 {
+	if x != nil {
+		return x.GustFactor
+	}
+	return 0
+}
+
+func (x *SmoothedWind) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
 type Status struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Winds            string `protobuf:"bytes,1,opt,name=winds,proto3" json:"winds,omitempty"`
-	WindsColor       uint32 `protobuf:"varint,2,opt,name=windsColor,proto3" json:"windsColor,omitempty"`
-	Clouds           string `protobuf:"bytes,3,opt,name=clouds,proto3" json:"clouds,omitempty"`
-	CloudsColor      uint32 `protobuf:"varint,4,opt,name=cloudsColor,proto3" json:"cloudsColor,omitempty"`
-	Weather          string `protobuf:"bytes,5,opt,name=weather,proto3" json:"weather,omitempty"`
-	WeatherColor     uint32 `protobuf:"varint,6,opt,name=weatherColor,proto3" json:"weatherColor,omitempty"`
-	Separation       string `protobuf:"bytes,7,opt,name=separation,proto3" json:"separation,omitempty"`
-	SeparationColor  uint32 `protobuf:"varint,8,opt,name=separationColor,proto3" json:"separationColor,omitempty"`
-	Temperature      string `protobuf:"bytes,9,opt,name=temperature,proto3" json:"temperature,omitempty"`
-	TemperatureColor uint32 `protobuf:"varint,10,opt,name=temperatureColor,proto3" json:"temperatureColor,omitempty"`
+	Winds                 string                `protobuf:"bytes,1,opt,name=winds,proto3" json:"winds,omitempty"`
+	WindsColor            uint32                `protobuf:"varint,2,opt,name=windsColor,proto3" json:"windsColor,omitempty"`
+	Clouds                string                `protobuf:"bytes,3,opt,name=clouds,proto3" json:"clouds,omitempty"`
+	CloudsColor           uint32                `protobuf:"varint,4,opt,name=cloudsColor,proto3" json:"cloudsColor,omitempty"`
+	Weather               string                `protobuf:"bytes,5,opt,name=weather,proto3" json:"weather,omitempty"`
+	WeatherColor          uint32                `protobuf:"varint,6,opt,name=weatherColor,proto3" json:"weatherColor,omitempty"`
+	Separation            string                `protobuf:"bytes,7,opt,name=separation,proto3" json:"separation,omitempty"`
+	SeparationColor       uint32                `protobuf:"varint,8,opt,name=separationColor,proto3" json:"separationColor,omitempty"`
+	Temperature           string                `protobuf:"bytes,9,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	TemperatureColor      uint32                `protobuf:"varint,10,opt,name=temperatureColor,proto3" json:"temperatureColor,omitempty"`
+	SmoothedWind          *SmoothedWind         `protobuf:"bytes,11,opt,name=smoothed_wind,json=smoothedWind,proto3,oneof" json:"smoothed_wind,omitempty"`
+	WindDirectionTrue     int32                 `protobuf:"varint,12,opt,name=wind_direction_true,json=windDirectionTrue,proto3" json:"wind_direction_true,omitempty"`
+	WindDirectionMagnetic int32                 `protobuf:"varint,13,opt,name=wind_direction_magnetic,json=windDirectionMagnetic,proto3" json:"wind_direction_magnetic,omitempty"`
+	Simulated             bool                  `protobuf:"varint,14,opt,name=simulated,proto3" json:"simulated,omitempty"`
+	LoadsRemaining        string                `protobuf:"bytes,15,opt,name=loads_remaining,json=loadsRemaining,proto3" json:"loads_remaining,omitempty"`
+	CanopyAdvisory        string                `protobuf:"bytes,16,opt,name=canopy_advisory,json=canopyAdvisory,proto3" json:"canopy_advisory,omitempty"`
+	PeakGust              string                `protobuf:"bytes,17,opt,name=peak_gust,json=peakGust,proto3" json:"peak_gust,omitempty"`
+	MetarStation          string                `protobuf:"bytes,18,opt,name=metar_station,json=metarStation,proto3" json:"metar_station,omitempty"` // the station the weather was reported by
+	DensityAltitude       string                `protobuf:"bytes,19,opt,name=density_altitude,json=densityAltitude,proto3" json:"density_altitude,omitempty"`
+	Dewpoint              string                `protobuf:"bytes,20,opt,name=dewpoint,proto3" json:"dewpoint,omitempty"`
+	RelativeHumidity      int32                 `protobuf:"varint,21,opt,name=relative_humidity,json=relativeHumidity,proto3" json:"relative_humidity,omitempty"` // percent; 0 if unknown
+	Altimeter             string                `protobuf:"bytes,22,opt,name=altimeter,proto3" json:"altimeter,omitempty"`
+	AltimeterInHg         float32               `protobuf:"fixed32,23,opt,name=altimeter_in_hg,json=altimeterInHg,proto3" json:"altimeter_in_hg,omitempty"` // 0 if unknown
+	AltimeterHpa          float32               `protobuf:"fixed32,24,opt,name=altimeter_hpa,json=altimeterHpa,proto3" json:"altimeter_hpa,omitempty"`      // QNH; 0 if unknown
+	Visibility            string                `protobuf:"bytes,25,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	VisibilitySm          float32               `protobuf:"fixed32,26,opt,name=visibility_sm,json=visibilitySm,proto3" json:"visibility_sm,omitempty"`     // statute miles; 0 if unknown
+	FlightCategory        string                `protobuf:"bytes,27,opt,name=flight_category,json=flightCategory,proto3" json:"flight_category,omitempty"` // VFR, MVFR, IFR, or LIFR; empty if unknown
+	FlightCategoryColor   uint32                `protobuf:"varint,28,opt,name=flight_category_color,json=flightCategoryColor,proto3" json:"flight_category_color,omitempty"`
+	ObservedAt            int64                 `protobuf:"varint,29,opt,name=observed_at,json=observedAt,proto3" json:"observed_at,omitempty"`                           // Unix time of the METAR report; 0 if unknown
+	WeatherStale          bool                  `protobuf:"varint,30,opt,name=weather_stale,json=weatherStale,proto3" json:"weather_stale,omitempty"`                     // the METAR report is older than configured
+	PressureTrend         string                `protobuf:"bytes,31,opt,name=pressure_trend,json=pressureTrend,proto3" json:"pressure_trend,omitempty"`                   // rising, falling, or steady; empty if unknown
+	WindTrend             string                `protobuf:"bytes,32,opt,name=wind_trend,json=windTrend,proto3" json:"wind_trend,omitempty"`                               // rising, falling, or steady; empty if unknown
+	TemperatureTrend      string                `protobuf:"bytes,33,opt,name=temperature_trend,json=temperatureTrend,proto3" json:"temperature_trend,omitempty"`          // rising, falling, or steady; empty if unknown
+	Trends                string                `protobuf:"bytes,34,opt,name=trends,proto3" json:"trends,omitempty"`                                                      // e.g. "Winds increasing, pressure falling"
+	Separations           []*AircraftSeparation `protobuf:"bytes,35,rep,name=separations,proto3" json:"separations,omitempty"`                                            // by aircraft with loads waiting
+	ApparentTemperature   string                `protobuf:"bytes,36,opt,name=apparent_temperature,json=apparentTemperature,proto3" json:"apparent_temperature,omitempty"` // wind chill or heat index, if enabled
+	TandemsOk             bool                  `protobuf:"varint,37,opt,name=tandems_ok,json=tandemsOk,proto3" json:"tandems_ok,omitempty"`                              // the ceiling is at or above the tandem minimum
+	StudentsOk            bool                  `protobuf:"varint,38,opt,name=students_ok,json=studentsOk,proto3" json:"students_ok,omitempty"`                           // the ceiling is at or above the student minimum
+	Observation           *Observation          `protobuf:"bytes,39,opt,name=observation,proto3,oneof" json:"observation,omitempty"`
+	WeatherDegraded       bool                  `protobuf:"varint,40,opt,name=weather_degraded,json=weatherDegraded,proto3" json:"weather_degraded,omitempty"`         // the last good report; the weather service can't be reached
+	FreezingLevel         string                `protobuf:"bytes,41,opt,name=freezing_level,json=freezingLevel,proto3" json:"freezing_level,omitempty"`                // e.g. "Freezing level 8,400 ft"
+	FreezingLevelFt       *int32                `protobuf:"varint,42,opt,name=freezing_level_ft,json=freezingLevelFt,proto3,oneof" json:"freezing_level_ft,omitempty"` // AGL; 0 if freezing at the surface
+	ExitTemperature       string                `protobuf:"bytes,43,opt,name=exit_temperature,json=exitTemperature,proto3" json:"exit_temperature,omitempty"`          // expected temperature at jump run altitude
+	ExitTemperatureC      *float32              `protobuf:"fixed32,44,opt,name=exit_temperature_c,json=exitTemperatureC,proto3,oneof" json:"exit_temperature_c,omitempty"`
+	Drift                 *Drift                `protobuf:"bytes,45,opt,name=drift,proto3,oneof" json:"drift,omitempty"`
+	CanopyWinds           string                `protobuf:"bytes,46,opt,name=canopy_winds,json=canopyWinds,proto3" json:"canopy_winds,omitempty"` // average wind from opening altitude to the ground
+	CanopyWindSpeedKt     *float32              `protobuf:"fixed32,47,opt,name=canopy_wind_speed_kt,json=canopyWindSpeedKt,proto3,oneof" json:"canopy_wind_speed_kt,omitempty"`
+	CanopyWindDirection   *int32                `protobuf:"varint,48,opt,name=canopy_wind_direction,json=canopyWindDirection,proto3,oneof" json:"canopy_wind_direction,omitempty"` // degrees, true or magnetic per settings
+	HighUppersAdvisory    string                `protobuf:"bytes,49,opt,name=high_uppers_advisory,json=highUppersAdvisory,proto3" json:"high_uppers_advisory,omitempty"`           // for the next departing load's aircraft
 }
 
 func (x *Status) Reset() {
 	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[0]
+		mi := &file_pkg_server_service_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -118,7 +389,7 @@ func (x *Status) String() string {
 func (*Status) ProtoMessage() {}
 
 func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[0]
+	mi := &file_pkg_server_service_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -131,7 +402,7 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Status.ProtoReflect.Descriptor instead.
 func (*Status) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{0}
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Status) GetWinds() string {
@@ -204,340 +475,314 @@ func (x *Status) GetTemperatureColor() uint32 {
 	return 0
 }
 
-type Options struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DisplayNicknames bool   `protobuf:"varint,2,opt,name=display_nicknames,json=displayNicknames,proto3" json:"display_nicknames,omitempty"`
-	DisplayWeather   bool   `protobuf:"varint,3,opt,name=display_weather,json=displayWeather,proto3" json:"display_weather,omitempty"`
-	DisplayWinds     bool   `protobuf:"varint,4,opt,name=display_winds,json=displayWinds,proto3" json:"display_winds,omitempty"`
-	Message          string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
-	MessageColor     uint32 `protobuf:"varint,6,opt,name=messageColor,proto3" json:"messageColor,omitempty"`
-	Sunrise          string `protobuf:"bytes,7,opt,name=sunrise,proto3" json:"sunrise,omitempty"`
-	Sunset           string `protobuf:"bytes,8,opt,name=sunset,proto3" json:"sunset,omitempty"`
-	FuelRequested    bool   `protobuf:"varint,9,opt,name=fuelRequested,proto3" json:"fuelRequested,omitempty"`
-}
-
-func (x *Options) Reset() {
-	*x = Options{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Status) GetSmoothedWind() *SmoothedWind {
+	if x != nil {
+		return x.SmoothedWind
 	}
+	return nil
 }
 
-func (x *Options) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Options) ProtoMessage() {}
-
-func (x *Options) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Status) GetWindDirectionTrue() int32 {
+	if x != nil {
+		return x.WindDirectionTrue
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Options.ProtoReflect.Descriptor instead.
-func (*Options) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{1}
+	return 0
 }
 
-func (x *Options) GetDisplayNicknames() bool {
+func (x *Status) GetWindDirectionMagnetic() int32 {
 	if x != nil {
-		return x.DisplayNicknames
+		return x.WindDirectionMagnetic
 	}
-	return false
+	return 0
 }
 
-func (x *Options) GetDisplayWeather() bool {
+func (x *Status) GetSimulated() bool {
 	if x != nil {
-		return x.DisplayWeather
+		return x.Simulated
 	}
 	return false
 }
 
-func (x *Options) GetDisplayWinds() bool {
+func (x *Status) GetLoadsRemaining() string {
 	if x != nil {
-		return x.DisplayWinds
+		return x.LoadsRemaining
 	}
-	return false
+	return ""
 }
 
-func (x *Options) GetMessage() string {
+func (x *Status) GetCanopyAdvisory() string {
 	if x != nil {
-		return x.Message
+		return x.CanopyAdvisory
 	}
 	return ""
 }
 
-func (x *Options) GetMessageColor() uint32 {
+func (x *Status) GetPeakGust() string {
 	if x != nil {
-		return x.MessageColor
+		return x.PeakGust
 	}
-	return 0
+	return ""
 }
 
-func (x *Options) GetSunrise() string {
+func (x *Status) GetMetarStation() string {
 	if x != nil {
-		return x.Sunrise
+		return x.MetarStation
 	}
 	return ""
 }
 
-func (x *Options) GetSunset() string {
+func (x *Status) GetDensityAltitude() string {
 	if x != nil {
-		return x.Sunset
+		return x.DensityAltitude
 	}
 	return ""
 }
 
-func (x *Options) GetFuelRequested() bool {
+func (x *Status) GetDewpoint() string {
 	if x != nil {
-		return x.FuelRequested
+		return x.Dewpoint
 	}
-	return false
-}
-
-type JumprunOrigin struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Latitude          string `protobuf:"bytes,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude         string `protobuf:"bytes,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	MagneticDeviation int32  `protobuf:"varint,3,opt,name=magnetic_deviation,json=magneticDeviation,proto3" json:"magnetic_deviation,omitempty"`
-	CameraHeight      int32  `protobuf:"varint,4,opt,name=camera_height,json=cameraHeight,proto3" json:"camera_height,omitempty"`
+	return ""
 }
 
-func (x *JumprunOrigin) Reset() {
-	*x = JumprunOrigin{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Status) GetRelativeHumidity() int32 {
+	if x != nil {
+		return x.RelativeHumidity
 	}
+	return 0
 }
 
-func (x *JumprunOrigin) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*JumprunOrigin) ProtoMessage() {}
-
-func (x *JumprunOrigin) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Status) GetAltimeter() string {
+	if x != nil {
+		return x.Altimeter
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use JumprunOrigin.ProtoReflect.Descriptor instead.
-func (*JumprunOrigin) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{2}
+func (x *Status) GetAltimeterInHg() float32 {
+	if x != nil {
+		return x.AltimeterInHg
+	}
+	return 0
 }
 
-func (x *JumprunOrigin) GetLatitude() string {
+func (x *Status) GetAltimeterHpa() float32 {
 	if x != nil {
-		return x.Latitude
+		return x.AltimeterHpa
 	}
-	return ""
+	return 0
 }
 
-func (x *JumprunOrigin) GetLongitude() string {
+func (x *Status) GetVisibility() string {
 	if x != nil {
-		return x.Longitude
+		return x.Visibility
 	}
 	return ""
 }
 
-func (x *JumprunOrigin) GetMagneticDeviation() int32 {
+func (x *Status) GetVisibilitySm() float32 {
 	if x != nil {
-		return x.MagneticDeviation
+		return x.VisibilitySm
 	}
 	return 0
 }
 
-func (x *JumprunOrigin) GetCameraHeight() int32 {
+func (x *Status) GetFlightCategory() string {
 	if x != nil {
-		return x.CameraHeight
+		return x.FlightCategory
 	}
-	return 0
+	return ""
 }
 
-type JumprunTurn struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Distance int32 `protobuf:"varint,1,opt,name=distance,proto3" json:"distance,omitempty"`
-	Heading  int32 `protobuf:"varint,2,opt,name=heading,proto3" json:"heading,omitempty"`
+func (x *Status) GetFlightCategoryColor() uint32 {
+	if x != nil {
+		return x.FlightCategoryColor
+	}
+	return 0
 }
 
-func (x *JumprunTurn) Reset() {
-	*x = JumprunTurn{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Status) GetObservedAt() int64 {
+	if x != nil {
+		return x.ObservedAt
 	}
+	return 0
 }
 
-func (x *JumprunTurn) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Status) GetWeatherStale() bool {
+	if x != nil {
+		return x.WeatherStale
+	}
+	return false
 }
 
-func (*JumprunTurn) ProtoMessage() {}
+func (x *Status) GetPressureTrend() string {
+	if x != nil {
+		return x.PressureTrend
+	}
+	return ""
+}
 
-func (x *JumprunTurn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Status) GetWindTrend() string {
+	if x != nil {
+		return x.WindTrend
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use JumprunTurn.ProtoReflect.Descriptor instead.
-func (*JumprunTurn) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{3}
+func (x *Status) GetTemperatureTrend() string {
+	if x != nil {
+		return x.TemperatureTrend
+	}
+	return ""
 }
 
-func (x *JumprunTurn) GetDistance() int32 {
+func (x *Status) GetTrends() string {
 	if x != nil {
-		return x.Distance
+		return x.Trends
 	}
-	return 0
+	return ""
 }
 
-func (x *JumprunTurn) GetHeading() int32 {
+func (x *Status) GetSeparations() []*AircraftSeparation {
 	if x != nil {
-		return x.Heading
+		return x.Separations
 	}
-	return 0
+	return nil
 }
 
-type JumprunPath struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Status) GetApparentTemperature() string {
+	if x != nil {
+		return x.ApparentTemperature
+	}
+	return ""
+}
 
-	Heading        int32          `protobuf:"varint,3,opt,name=heading,proto3" json:"heading,omitempty"`
-	ExitDistance   int32          `protobuf:"varint,4,opt,name=exit_distance,json=exitDistance,proto3" json:"exit_distance,omitempty"`
-	OffsetHeading  int32          `protobuf:"varint,5,opt,name=offset_heading,json=offsetHeading,proto3" json:"offset_heading,omitempty"`
-	OffsetDistance int32          `protobuf:"varint,6,opt,name=offset_distance,json=offsetDistance,proto3" json:"offset_distance,omitempty"`
-	Turns          []*JumprunTurn `protobuf:"bytes,7,rep,name=turns,proto3" json:"turns,omitempty"`
+func (x *Status) GetTandemsOk() bool {
+	if x != nil {
+		return x.TandemsOk
+	}
+	return false
 }
 
-func (x *JumprunPath) Reset() {
-	*x = JumprunPath{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Status) GetStudentsOk() bool {
+	if x != nil {
+		return x.StudentsOk
 	}
+	return false
 }
 
-func (x *JumprunPath) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Status) GetObservation() *Observation {
+	if x != nil {
+		return x.Observation
+	}
+	return nil
 }
 
-func (*JumprunPath) ProtoMessage() {}
+func (x *Status) GetWeatherDegraded() bool {
+	if x != nil {
+		return x.WeatherDegraded
+	}
+	return false
+}
 
-func (x *JumprunPath) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Status) GetFreezingLevel() string {
+	if x != nil {
+		return x.FreezingLevel
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use JumprunPath.ProtoReflect.Descriptor instead.
-func (*JumprunPath) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{4}
+func (x *Status) GetFreezingLevelFt() int32 {
+	if x != nil && x.FreezingLevelFt != nil {
+		return *x.FreezingLevelFt
+	}
+	return 0
 }
 
-func (x *JumprunPath) GetHeading() int32 {
+func (x *Status) GetExitTemperature() string {
 	if x != nil {
-		return x.Heading
+		return x.ExitTemperature
+	}
+	return ""
+}
+
+func (x *Status) GetExitTemperatureC() float32 {
+	if x != nil && x.ExitTemperatureC != nil {
+		return *x.ExitTemperatureC
 	}
 	return 0
 }
 
-func (x *JumprunPath) GetExitDistance() int32 {
+func (x *Status) GetDrift() *Drift {
 	if x != nil {
-		return x.ExitDistance
+		return x.Drift
 	}
-	return 0
+	return nil
 }
 
-func (x *JumprunPath) GetOffsetHeading() int32 {
+func (x *Status) GetCanopyWinds() string {
 	if x != nil {
-		return x.OffsetHeading
+		return x.CanopyWinds
+	}
+	return ""
+}
+
+func (x *Status) GetCanopyWindSpeedKt() float32 {
+	if x != nil && x.CanopyWindSpeedKt != nil {
+		return *x.CanopyWindSpeedKt
 	}
 	return 0
 }
 
-func (x *JumprunPath) GetOffsetDistance() int32 {
-	if x != nil {
-		return x.OffsetDistance
+func (x *Status) GetCanopyWindDirection() int32 {
+	if x != nil && x.CanopyWindDirection != nil {
+		return *x.CanopyWindDirection
 	}
 	return 0
 }
 
-func (x *JumprunPath) GetTurns() []*JumprunTurn {
+func (x *Status) GetHighUppersAdvisory() string {
 	if x != nil {
-		return x.Turns
+		return x.HighUppersAdvisory
 	}
-	return nil
+	return ""
 }
 
-type Jumprun struct {
+// Observation is the current METAR report as numbers, for clients that do
+// their own formatting and unit conversion. Fields that weren't reported
+// are not set.
+type Observation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Origin *JumprunOrigin `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
-	Path   *JumprunPath   `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	WindSpeedKt      *float32 `protobuf:"fixed32,1,opt,name=wind_speed_kt,json=windSpeedKt,proto3,oneof" json:"wind_speed_kt,omitempty"`
+	WindGustKt       *float32 `protobuf:"fixed32,2,opt,name=wind_gust_kt,json=windGustKt,proto3,oneof" json:"wind_gust_kt,omitempty"`
+	WindDirectionDeg *int32   `protobuf:"varint,3,opt,name=wind_direction_deg,json=windDirectionDeg,proto3,oneof" json:"wind_direction_deg,omitempty"` // true
+	TemperatureC     *float32 `protobuf:"fixed32,4,opt,name=temperature_c,json=temperatureC,proto3,oneof" json:"temperature_c,omitempty"`
+	DewpointC        *float32 `protobuf:"fixed32,5,opt,name=dewpoint_c,json=dewpointC,proto3,oneof" json:"dewpoint_c,omitempty"`
+	CeilingFt        *int32   `protobuf:"varint,6,opt,name=ceiling_ft,json=ceilingFt,proto3,oneof" json:"ceiling_ft,omitempty"` // AGL; not set when there is no ceiling
+	VisibilitySm     *float32 `protobuf:"fixed32,7,opt,name=visibility_sm,json=visibilitySm,proto3,oneof" json:"visibility_sm,omitempty"`
+	AltimeterInHg    *float32 `protobuf:"fixed32,8,opt,name=altimeter_in_hg,json=altimeterInHg,proto3,oneof" json:"altimeter_in_hg,omitempty"`
 }
 
-func (x *Jumprun) Reset() {
-	*x = Jumprun{}
+func (x *Observation) Reset() {
+	*x = Observation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[5]
+		mi := &file_pkg_server_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Jumprun) String() string {
+func (x *Observation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Jumprun) ProtoMessage() {}
+func (*Observation) ProtoMessage() {}
 
-func (x *Jumprun) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[5]
+func (x *Observation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,54 +793,99 @@ func (x *Jumprun) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Jumprun.ProtoReflect.Descriptor instead.
-func (*Jumprun) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Observation.ProtoReflect.Descriptor instead.
+func (*Observation) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Jumprun) GetOrigin() *JumprunOrigin {
-	if x != nil {
-		return x.Origin
+func (x *Observation) GetWindSpeedKt() float32 {
+	if x != nil && x.WindSpeedKt != nil {
+		return *x.WindSpeedKt
 	}
-	return nil
+	return 0
 }
 
-func (x *Jumprun) GetPath() *JumprunPath {
-	if x != nil {
-		return x.Path
+func (x *Observation) GetWindGustKt() float32 {
+	if x != nil && x.WindGustKt != nil {
+		return *x.WindGustKt
 	}
-	return nil
+	return 0
 }
 
-type WindsAloftSample struct {
+func (x *Observation) GetWindDirectionDeg() int32 {
+	if x != nil && x.WindDirectionDeg != nil {
+		return *x.WindDirectionDeg
+	}
+	return 0
+}
+
+func (x *Observation) GetTemperatureC() float32 {
+	if x != nil && x.TemperatureC != nil {
+		return *x.TemperatureC
+	}
+	return 0
+}
+
+func (x *Observation) GetDewpointC() float32 {
+	if x != nil && x.DewpointC != nil {
+		return *x.DewpointC
+	}
+	return 0
+}
+
+func (x *Observation) GetCeilingFt() int32 {
+	if x != nil && x.CeilingFt != nil {
+		return *x.CeilingFt
+	}
+	return 0
+}
+
+func (x *Observation) GetVisibilitySm() float32 {
+	if x != nil && x.VisibilitySm != nil {
+		return *x.VisibilitySm
+	}
+	return 0
+}
+
+func (x *Observation) GetAltimeterInHg() float32 {
+	if x != nil && x.AltimeterInHg != nil {
+		return *x.AltimeterInHg
+	}
+	return 0
+}
+
+// Separation for an aircraft, since each flies jump run at its own airspeed.
+// The status separation line is the one for the channel's aircraft.
+type AircraftSeparation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Altitude    int32 `protobuf:"varint,1,opt,name=altitude,proto3" json:"altitude,omitempty"`
-	Heading     int32 `protobuf:"varint,2,opt,name=heading,proto3" json:"heading,omitempty"`
-	Speed       int32 `protobuf:"varint,3,opt,name=speed,proto3" json:"speed,omitempty"`
-	Temperature int32 `protobuf:"varint,4,opt,name=temperature,proto3" json:"temperature,omitempty"`
-	Variable    bool  `protobuf:"varint,5,opt,name=variable,proto3" json:"variable,omitempty"`
+	Aircraft          string  `protobuf:"bytes,1,opt,name=aircraft,proto3" json:"aircraft,omitempty"`
+	Separation        string  `protobuf:"bytes,2,opt,name=separation,proto3" json:"separation,omitempty"`
+	Color             uint32  `protobuf:"varint,3,opt,name=color,proto3" json:"color,omitempty"`
+	SeparationSeconds int32   `protobuf:"varint,4,opt,name=separation_seconds,json=separationSeconds,proto3" json:"separation_seconds,omitempty"` // 0 if jump run can't make progress
+	GroundSpeedKt     float32 `protobuf:"fixed32,5,opt,name=ground_speed_kt,json=groundSpeedKt,proto3" json:"ground_speed_kt,omitempty"`          // on jump run at exit altitude
+	ExitAltitude      int32   `protobuf:"varint,6,opt,name=exit_altitude,json=exitAltitude,proto3" json:"exit_altitude,omitempty"`                // feet AGL
 }
 
-func (x *WindsAloftSample) Reset() {
-	*x = WindsAloftSample{}
+func (x *AircraftSeparation) Reset() {
+	*x = AircraftSeparation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[6]
+		mi := &file_pkg_server_service_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WindsAloftSample) String() string {
+func (x *AircraftSeparation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindsAloftSample) ProtoMessage() {}
+func (*AircraftSeparation) ProtoMessage() {}
 
-func (x *WindsAloftSample) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[6]
+func (x *AircraftSeparation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -606,71 +896,90 @@ func (x *WindsAloftSample) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindsAloftSample.ProtoReflect.Descriptor instead.
-func (*WindsAloftSample) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use AircraftSeparation.ProtoReflect.Descriptor instead.
+func (*AircraftSeparation) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *WindsAloftSample) GetAltitude() int32 {
+func (x *AircraftSeparation) GetAircraft() string {
 	if x != nil {
-		return x.Altitude
+		return x.Aircraft
 	}
-	return 0
+	return ""
 }
 
-func (x *WindsAloftSample) GetHeading() int32 {
+func (x *AircraftSeparation) GetSeparation() string {
 	if x != nil {
-		return x.Heading
+		return x.Separation
+	}
+	return ""
+}
+
+func (x *AircraftSeparation) GetColor() uint32 {
+	if x != nil {
+		return x.Color
 	}
 	return 0
 }
 
-func (x *WindsAloftSample) GetSpeed() int32 {
+func (x *AircraftSeparation) GetSeparationSeconds() int32 {
 	if x != nil {
-		return x.Speed
+		return x.SeparationSeconds
 	}
 	return 0
 }
 
-func (x *WindsAloftSample) GetTemperature() int32 {
+func (x *AircraftSeparation) GetGroundSpeedKt() float32 {
 	if x != nil {
-		return x.Temperature
+		return x.GroundSpeedKt
 	}
 	return 0
 }
 
-func (x *WindsAloftSample) GetVariable() bool {
+func (x *AircraftSeparation) GetExitAltitude() int32 {
 	if x != nil {
-		return x.Variable
+		return x.ExitAltitude
 	}
-	return false
+	return 0
 }
 
-type WindsAloft struct {
+// Drift is how far a jumper drifts through the winds aloft and the
+// separation between groups for the next aircraft. Distances are in feet
+// and bearings are the direction of the drift in degrees true.
+type Drift struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Samples []*WindsAloftSample `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	FreefallFt        float32 `protobuf:"fixed32,1,opt,name=freefall_ft,json=freefallFt,proto3" json:"freefall_ft,omitempty"`
+	FreefallBearing   int32   `protobuf:"varint,2,opt,name=freefall_bearing,json=freefallBearing,proto3" json:"freefall_bearing,omitempty"`
+	CanopyFt          float32 `protobuf:"fixed32,3,opt,name=canopy_ft,json=canopyFt,proto3" json:"canopy_ft,omitempty"`
+	CanopyBearing     int32   `protobuf:"varint,4,opt,name=canopy_bearing,json=canopyBearing,proto3" json:"canopy_bearing,omitempty"`
+	TotalFt           float32 `protobuf:"fixed32,5,opt,name=total_ft,json=totalFt,proto3" json:"total_ft,omitempty"`
+	TotalBearing      int32   `protobuf:"varint,6,opt,name=total_bearing,json=totalBearing,proto3" json:"total_bearing,omitempty"`
+	FreefallSeconds   float32 `protobuf:"fixed32,7,opt,name=freefall_seconds,json=freefallSeconds,proto3" json:"freefall_seconds,omitempty"`
+	CanopySeconds     float32 `protobuf:"fixed32,8,opt,name=canopy_seconds,json=canopySeconds,proto3" json:"canopy_seconds,omitempty"`
+	GroundSpeedKt     float32 `protobuf:"fixed32,9,opt,name=ground_speed_kt,json=groundSpeedKt,proto3" json:"ground_speed_kt,omitempty"`
+	SeparationSeconds int32   `protobuf:"varint,10,opt,name=separation_seconds,json=separationSeconds,proto3" json:"separation_seconds,omitempty"`
 }
 
-func (x *WindsAloft) Reset() {
-	*x = WindsAloft{}
+func (x *Drift) Reset() {
+	*x = Drift{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[7]
+		mi := &file_pkg_server_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WindsAloft) String() string {
+func (x *Drift) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WindsAloft) ProtoMessage() {}
+func (*Drift) ProtoMessage() {}
 
-func (x *WindsAloft) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[7]
+func (x *Drift) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -681,147 +990,116 @@ func (x *WindsAloft) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WindsAloft.ProtoReflect.Descriptor instead.
-func (*WindsAloft) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Drift.ProtoReflect.Descriptor instead.
+func (*Drift) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *WindsAloft) GetSamples() []*WindsAloftSample {
+func (x *Drift) GetFreefallFt() float32 {
 	if x != nil {
-		return x.Samples
+		return x.FreefallFt
 	}
-	return nil
+	return 0
 }
 
-type Jumper struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Drift) GetFreefallBearing() int32 {
+	if x != nil {
+		return x.FreefallBearing
+	}
+	return 0
+}
 
-	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type      JumperType `protobuf:"varint,2,opt,name=type,proto3,enum=manifest.JumperType" json:"type,omitempty"`
-	Name      string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Nickname  string     `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	ShortName string     `protobuf:"bytes,5,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
-	Color     uint32     `protobuf:"varint,6,opt,name=color,proto3" json:"color,omitempty"`
-	Repr      string     `protobuf:"bytes,7,opt,name=repr,proto3" json:"repr,omitempty"`
-	RigName   string     `protobuf:"bytes,8,opt,name=rig_name,json=rigName,proto3" json:"rig_name,omitempty"`
+func (x *Drift) GetCanopyFt() float32 {
+	if x != nil {
+		return x.CanopyFt
+	}
+	return 0
 }
 
-func (x *Jumper) Reset() {
-	*x = Jumper{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Drift) GetCanopyBearing() int32 {
+	if x != nil {
+		return x.CanopyBearing
 	}
+	return 0
 }
 
-func (x *Jumper) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Drift) GetTotalFt() float32 {
+	if x != nil {
+		return x.TotalFt
+	}
+	return 0
 }
 
-func (*Jumper) ProtoMessage() {}
+func (x *Drift) GetTotalBearing() int32 {
+	if x != nil {
+		return x.TotalBearing
+	}
+	return 0
+}
 
-func (x *Jumper) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Jumper.ProtoReflect.Descriptor instead.
-func (*Jumper) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *Jumper) GetId() uint64 {
+func (x *Drift) GetFreefallSeconds() float32 {
 	if x != nil {
-		return x.Id
+		return x.FreefallSeconds
 	}
 	return 0
 }
 
-func (x *Jumper) GetType() JumperType {
-	if x != nil {
-		return x.Type
-	}
-	return JumperType_EXPERIENCED
-}
-
-func (x *Jumper) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Jumper) GetNickname() string {
-	if x != nil {
-		return x.Nickname
-	}
-	return ""
-}
-
-func (x *Jumper) GetShortName() string {
+func (x *Drift) GetCanopySeconds() float32 {
 	if x != nil {
-		return x.ShortName
-	}
-	return ""
-}
-
-func (x *Jumper) GetColor() uint32 {
-	if x != nil {
-		return x.Color
+		return x.CanopySeconds
 	}
 	return 0
 }
 
-func (x *Jumper) GetRepr() string {
+func (x *Drift) GetGroundSpeedKt() float32 {
 	if x != nil {
-		return x.Repr
+		return x.GroundSpeedKt
 	}
-	return ""
+	return 0
 }
 
-func (x *Jumper) GetRigName() string {
+func (x *Drift) GetSeparationSeconds() int32 {
 	if x != nil {
-		return x.RigName
+		return x.SeparationSeconds
 	}
-	return ""
+	return 0
 }
 
-type JumperGroup struct {
+// Display hints manage the kiosk clients on a channel. Clients should dim
+// when the current time is before dim_end or at or after dim_start, which
+// are today's sunrise and sunset, and sleep between sleep_start and
+// sleep_end, which are local times (HH:MM) that may span midnight.
+type DisplayHints struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Leader  *Jumper   `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
-	Members []*Jumper `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	DimAtDusk     bool   `protobuf:"varint,1,opt,name=dim_at_dusk,json=dimAtDusk,proto3" json:"dim_at_dusk,omitempty"`
+	DimBrightness int32  `protobuf:"varint,2,opt,name=dim_brightness,json=dimBrightness,proto3" json:"dim_brightness,omitempty"`
+	DimStart      int64  `protobuf:"varint,3,opt,name=dim_start,json=dimStart,proto3" json:"dim_start,omitempty"`
+	DimEnd        int64  `protobuf:"varint,4,opt,name=dim_end,json=dimEnd,proto3" json:"dim_end,omitempty"`
+	SleepStart    string `protobuf:"bytes,5,opt,name=sleep_start,json=sleepStart,proto3" json:"sleep_start,omitempty"`
+	SleepEnd      string `protobuf:"bytes,6,opt,name=sleep_end,json=sleepEnd,proto3" json:"sleep_end,omitempty"`
+	RotateSeconds int32  `protobuf:"varint,7,opt,name=rotate_seconds,json=rotateSeconds,proto3" json:"rotate_seconds,omitempty"`
 }
 
-func (x *JumperGroup) Reset() {
-	*x = JumperGroup{}
+func (x *DisplayHints) Reset() {
+	*x = DisplayHints{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[9]
+		mi := &file_pkg_server_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JumperGroup) String() string {
+func (x *DisplayHints) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JumperGroup) ProtoMessage() {}
+func (*DisplayHints) ProtoMessage() {}
 
-func (x *JumperGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[9]
+func (x *DisplayHints) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,140 +1110,98 @@ func (x *JumperGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JumperGroup.ProtoReflect.Descriptor instead.
-func (*JumperGroup) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use DisplayHints.ProtoReflect.Descriptor instead.
+func (*DisplayHints) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *JumperGroup) GetLeader() *Jumper {
+func (x *DisplayHints) GetDimAtDusk() bool {
 	if x != nil {
-		return x.Leader
+		return x.DimAtDusk
 	}
-	return nil
+	return false
 }
 
-func (x *JumperGroup) GetMembers() []*Jumper {
+func (x *DisplayHints) GetDimBrightness() int32 {
 	if x != nil {
-		return x.Members
+		return x.DimBrightness
 	}
-	return nil
-}
-
-type LoadSlot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Slot:
-	//	*LoadSlot_Jumper
-	//	*LoadSlot_Group
-	Slot isLoadSlot_Slot `protobuf_oneof:"slot"`
+	return 0
 }
 
-func (x *LoadSlot) Reset() {
-	*x = LoadSlot{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *DisplayHints) GetDimStart() int64 {
+	if x != nil {
+		return x.DimStart
 	}
+	return 0
 }
 
-func (x *LoadSlot) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*LoadSlot) ProtoMessage() {}
-
-func (x *LoadSlot) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *DisplayHints) GetDimEnd() int64 {
+	if x != nil {
+		return x.DimEnd
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use LoadSlot.ProtoReflect.Descriptor instead.
-func (*LoadSlot) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{10}
+	return 0
 }
 
-func (m *LoadSlot) GetSlot() isLoadSlot_Slot {
-	if m != nil {
-		return m.Slot
+func (x *DisplayHints) GetSleepStart() string {
+	if x != nil {
+		return x.SleepStart
 	}
-	return nil
+	return ""
 }
 
-func (x *LoadSlot) GetJumper() *Jumper {
-	if x, ok := x.GetSlot().(*LoadSlot_Jumper); ok {
-		return x.Jumper
+func (x *DisplayHints) GetSleepEnd() string {
+	if x != nil {
+		return x.SleepEnd
 	}
-	return nil
+	return ""
 }
 
-func (x *LoadSlot) GetGroup() *JumperGroup {
-	if x, ok := x.GetSlot().(*LoadSlot_Group); ok {
-		return x.Group
+func (x *DisplayHints) GetRotateSeconds() int32 {
+	if x != nil {
+		return x.RotateSeconds
 	}
-	return nil
-}
-
-type isLoadSlot_Slot interface {
-	isLoadSlot_Slot()
-}
-
-type LoadSlot_Jumper struct {
-	Jumper *Jumper `protobuf:"bytes,1,opt,name=jumper,proto3,oneof"`
-}
-
-type LoadSlot_Group struct {
-	Group *JumperGroup `protobuf:"bytes,2,opt,name=group,proto3,oneof"`
+	return 0
 }
 
-func (*LoadSlot_Jumper) isLoadSlot_Slot() {}
-
-func (*LoadSlot_Group) isLoadSlot_Slot() {}
-
-type Load struct {
+type Options struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                   uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	AircraftName         string      `protobuf:"bytes,2,opt,name=aircraft_name,json=aircraftName,proto3" json:"aircraft_name,omitempty"`
-	LoadNumber           string      `protobuf:"bytes,3,opt,name=load_number,json=loadNumber,proto3" json:"load_number,omitempty"`
-	CallMinutes          int32       `protobuf:"varint,4,opt,name=call_minutes,json=callMinutes,proto3" json:"call_minutes,omitempty"`
-	CallMinutesString    string      `protobuf:"bytes,5,opt,name=call_minutes_string,json=callMinutesString,proto3" json:"call_minutes_string,omitempty"`
-	SlotsAvailable       int32       `protobuf:"varint,6,opt,name=slots_available,json=slotsAvailable,proto3" json:"slots_available,omitempty"`
-	SlotsAvailableString string      `protobuf:"bytes,7,opt,name=slots_available_string,json=slotsAvailableString,proto3" json:"slots_available_string,omitempty"`
-	IsFueling            bool        `protobuf:"varint,8,opt,name=is_fueling,json=isFueling,proto3" json:"is_fueling,omitempty"`
-	IsTurning            bool        `protobuf:"varint,9,opt,name=is_turning,json=isTurning,proto3" json:"is_turning,omitempty"`
-	IsNoTime             bool        `protobuf:"varint,10,opt,name=is_no_time,json=isNoTime,proto3" json:"is_no_time,omitempty"`
-	Slots                []*LoadSlot `protobuf:"bytes,11,rep,name=slots,proto3" json:"slots,omitempty"`
+	DisplayNicknames bool             `protobuf:"varint,2,opt,name=display_nicknames,json=displayNicknames,proto3" json:"display_nicknames,omitempty"`
+	DisplayWeather   bool             `protobuf:"varint,3,opt,name=display_weather,json=displayWeather,proto3" json:"display_weather,omitempty"`
+	DisplayWinds     bool             `protobuf:"varint,4,opt,name=display_winds,json=displayWinds,proto3" json:"display_winds,omitempty"`
+	Message          string           `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	MessageColor     uint32           `protobuf:"varint,6,opt,name=messageColor,proto3" json:"messageColor,omitempty"`
+	Sunrise          string           `protobuf:"bytes,7,opt,name=sunrise,proto3" json:"sunrise,omitempty"`
+	Sunset           string           `protobuf:"bytes,8,opt,name=sunset,proto3" json:"sunset,omitempty"`
+	FuelRequested    bool             `protobuf:"varint,9,opt,name=fuelRequested,proto3" json:"fuelRequested,omitempty"`
+	HeadingReference HeadingReference `protobuf:"varint,10,opt,name=heading_reference,json=headingReference,proto3,enum=manifest.HeadingReference" json:"heading_reference,omitempty"`
+	DisplayHints     *DisplayHints    `protobuf:"bytes,11,opt,name=display_hints,json=displayHints,proto3" json:"display_hints,omitempty"` // specific to the client's channel
+	DisplayStandby   bool             `protobuf:"varint,12,opt,name=display_standby,json=displayStandby,proto3" json:"display_standby,omitempty"`
+	WeatherHold      bool             `protobuf:"varint,13,opt,name=weather_hold,json=weatherHold,proto3" json:"weather_hold,omitempty"`
+	Units            string           `protobuf:"bytes,14,opt,name=units,proto3" json:"units,omitempty"`            // imperial, metric, or both; for formatting numeric fields
+	Generation       uint64           `protobuf:"varint,15,opt,name=generation,proto3" json:"generation,omitempty"` // increases whenever the options change
 }
 
-func (x *Load) Reset() {
-	*x = Load{}
+func (x *Options) Reset() {
+	*x = Options{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[11]
+		mi := &file_pkg_server_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Load) String() string {
+func (x *Options) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Load) ProtoMessage() {}
+func (*Options) ProtoMessage() {}
 
-func (x *Load) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[11]
+func (x *Options) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -976,114 +1212,138 @@ func (x *Load) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Load.ProtoReflect.Descriptor instead.
-func (*Load) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Options.ProtoReflect.Descriptor instead.
+func (*Options) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Load) GetId() uint64 {
+func (x *Options) GetDisplayNicknames() bool {
 	if x != nil {
-		return x.Id
+		return x.DisplayNicknames
 	}
-	return 0
+	return false
 }
 
-func (x *Load) GetAircraftName() string {
+func (x *Options) GetDisplayWeather() bool {
 	if x != nil {
-		return x.AircraftName
+		return x.DisplayWeather
 	}
-	return ""
+	return false
 }
 
-func (x *Load) GetLoadNumber() string {
+func (x *Options) GetDisplayWinds() bool {
 	if x != nil {
-		return x.LoadNumber
+		return x.DisplayWinds
+	}
+	return false
+}
+
+func (x *Options) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Load) GetCallMinutes() int32 {
+func (x *Options) GetMessageColor() uint32 {
 	if x != nil {
-		return x.CallMinutes
+		return x.MessageColor
 	}
 	return 0
 }
 
-func (x *Load) GetCallMinutesString() string {
+func (x *Options) GetSunrise() string {
 	if x != nil {
-		return x.CallMinutesString
+		return x.Sunrise
 	}
 	return ""
 }
 
-func (x *Load) GetSlotsAvailable() int32 {
+func (x *Options) GetSunset() string {
 	if x != nil {
-		return x.SlotsAvailable
+		return x.Sunset
 	}
-	return 0
+	return ""
 }
 
-func (x *Load) GetSlotsAvailableString() string {
+func (x *Options) GetFuelRequested() bool {
 	if x != nil {
-		return x.SlotsAvailableString
+		return x.FuelRequested
 	}
-	return ""
+	return false
 }
 
-func (x *Load) GetIsFueling() bool {
+func (x *Options) GetHeadingReference() HeadingReference {
 	if x != nil {
-		return x.IsFueling
+		return x.HeadingReference
 	}
-	return false
+	return HeadingReference_MAGNETIC
 }
 
-func (x *Load) GetIsTurning() bool {
+func (x *Options) GetDisplayHints() *DisplayHints {
 	if x != nil {
-		return x.IsTurning
+		return x.DisplayHints
+	}
+	return nil
+}
+
+func (x *Options) GetDisplayStandby() bool {
+	if x != nil {
+		return x.DisplayStandby
 	}
 	return false
 }
 
-func (x *Load) GetIsNoTime() bool {
+func (x *Options) GetWeatherHold() bool {
 	if x != nil {
-		return x.IsNoTime
+		return x.WeatherHold
 	}
 	return false
 }
 
-func (x *Load) GetSlots() []*LoadSlot {
+func (x *Options) GetUnits() string {
 	if x != nil {
-		return x.Slots
+		return x.Units
 	}
-	return nil
+	return ""
 }
 
-type Loads struct {
+func (x *Options) GetGeneration() uint64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+type JumprunOrigin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ColumnCount int32   `protobuf:"varint,1,opt,name=column_count,json=columnCount,proto3" json:"column_count,omitempty"`
-	Loads       []*Load `protobuf:"bytes,2,rep,name=loads,proto3" json:"loads,omitempty"`
+	Latitude          string `protobuf:"bytes,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude         string `protobuf:"bytes,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	MagneticDeviation int32  `protobuf:"varint,3,opt,name=magnetic_deviation,json=magneticDeviation,proto3" json:"magnetic_deviation,omitempty"`
+	CameraHeight      int32  `protobuf:"varint,4,opt,name=camera_height,json=cameraHeight,proto3" json:"camera_height,omitempty"`
+	ViewWidth         int32  `protobuf:"varint,5,opt,name=view_width,json=viewWidth,proto3" json:"view_width,omitempty"`
 }
 
-func (x *Loads) Reset() {
-	*x = Loads{}
+func (x *JumprunOrigin) Reset() {
+	*x = JumprunOrigin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[12]
+		mi := &file_pkg_server_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Loads) String() string {
+func (x *JumprunOrigin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Loads) ProtoMessage() {}
+func (*JumprunOrigin) ProtoMessage() {}
 
-func (x *Loads) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[12]
+func (x *JumprunOrigin) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,54 +1354,74 @@ func (x *Loads) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Loads.ProtoReflect.Descriptor instead.
-func (*Loads) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use JumprunOrigin.ProtoReflect.Descriptor instead.
+func (*JumprunOrigin) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Loads) GetColumnCount() int32 {
+func (x *JumprunOrigin) GetLatitude() string {
 	if x != nil {
-		return x.ColumnCount
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *JumprunOrigin) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+func (x *JumprunOrigin) GetMagneticDeviation() int32 {
+	if x != nil {
+		return x.MagneticDeviation
 	}
 	return 0
 }
 
-func (x *Loads) GetLoads() []*Load {
+func (x *JumprunOrigin) GetCameraHeight() int32 {
 	if x != nil {
-		return x.Loads
+		return x.CameraHeight
 	}
-	return nil
+	return 0
 }
 
-type ManifestUpdate struct {
+func (x *JumprunOrigin) GetViewWidth() int32 {
+	if x != nil {
+		return x.ViewWidth
+	}
+	return 0
+}
+
+type JumprunTurn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     *Status     `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
-	Options    *Options    `protobuf:"bytes,2,opt,name=options,proto3,oneof" json:"options,omitempty"`
-	Jumprun    *Jumprun    `protobuf:"bytes,3,opt,name=jumprun,proto3,oneof" json:"jumprun,omitempty"`
-	WindsAloft *WindsAloft `protobuf:"bytes,4,opt,name=winds_aloft,json=windsAloft,proto3,oneof" json:"winds_aloft,omitempty"`
-	Loads      *Loads      `protobuf:"bytes,5,opt,name=loads,proto3,oneof" json:"loads,omitempty"`
+	Distance        int32 `protobuf:"varint,1,opt,name=distance,proto3" json:"distance,omitempty"`
+	Heading         int32 `protobuf:"varint,2,opt,name=heading,proto3" json:"heading,omitempty"`
+	TrueHeading     int32 `protobuf:"varint,3,opt,name=true_heading,json=trueHeading,proto3" json:"true_heading,omitempty"`
+	MagneticHeading int32 `protobuf:"varint,4,opt,name=magnetic_heading,json=magneticHeading,proto3" json:"magnetic_heading,omitempty"`
 }
 
-func (x *ManifestUpdate) Reset() {
-	*x = ManifestUpdate{}
+func (x *JumprunTurn) Reset() {
+	*x = JumprunTurn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[13]
+		mi := &file_pkg_server_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ManifestUpdate) String() string {
+func (x *JumprunTurn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ManifestUpdate) ProtoMessage() {}
+func (*JumprunTurn) ProtoMessage() {}
 
-func (x *ManifestUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[13]
+func (x *JumprunTurn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,76 +1432,67 @@ func (x *ManifestUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ManifestUpdate.ProtoReflect.Descriptor instead.
-func (*ManifestUpdate) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ManifestUpdate) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+// Deprecated: Use JumprunTurn.ProtoReflect.Descriptor instead.
+func (*JumprunTurn) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ManifestUpdate) GetOptions() *Options {
+func (x *JumprunTurn) GetDistance() int32 {
 	if x != nil {
-		return x.Options
+		return x.Distance
 	}
-	return nil
+	return 0
 }
 
-func (x *ManifestUpdate) GetJumprun() *Jumprun {
+func (x *JumprunTurn) GetHeading() int32 {
 	if x != nil {
-		return x.Jumprun
+		return x.Heading
 	}
-	return nil
+	return 0
 }
 
-func (x *ManifestUpdate) GetWindsAloft() *WindsAloft {
+func (x *JumprunTurn) GetTrueHeading() int32 {
 	if x != nil {
-		return x.WindsAloft
+		return x.TrueHeading
 	}
-	return nil
+	return 0
 }
 
-func (x *ManifestUpdate) GetLoads() *Loads {
+func (x *JumprunTurn) GetMagneticHeading() int32 {
 	if x != nil {
-		return x.Loads
+		return x.MagneticHeading
 	}
-	return nil
+	return 0
 }
 
-type SignInWithAppleRequest struct {
+type JumprunPoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BundleId          string `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
-	Nonce             string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	IdentityToken     string `protobuf:"bytes,3,opt,name=identity_token,json=identityToken,proto3" json:"identity_token,omitempty"`
-	AuthorizationCode string `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code,omitempty"`
-	GivenName         string `protobuf:"bytes,5,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
-	FamilyName        string `protobuf:"bytes,6,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	X         int32   `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y         int32   `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
 }
 
-func (x *SignInWithAppleRequest) Reset() {
-	*x = SignInWithAppleRequest{}
+func (x *JumprunPoint) Reset() {
+	*x = JumprunPoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[14]
+		mi := &file_pkg_server_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignInWithAppleRequest) String() string {
+func (x *JumprunPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignInWithAppleRequest) ProtoMessage() {}
+func (*JumprunPoint) ProtoMessage() {}
 
-func (x *SignInWithAppleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[14]
+func (x *JumprunPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,83 +1503,80 @@ func (x *SignInWithAppleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignInWithAppleRequest.ProtoReflect.Descriptor instead.
-func (*SignInWithAppleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *SignInWithAppleRequest) GetBundleId() string {
-	if x != nil {
-		return x.BundleId
-	}
-	return ""
-}
-
-func (x *SignInWithAppleRequest) GetNonce() string {
-	if x != nil {
-		return x.Nonce
-	}
-	return ""
+// Deprecated: Use JumprunPoint.ProtoReflect.Descriptor instead.
+func (*JumprunPoint) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SignInWithAppleRequest) GetIdentityToken() string {
+func (x *JumprunPoint) GetLatitude() float64 {
 	if x != nil {
-		return x.IdentityToken
+		return x.Latitude
 	}
-	return ""
+	return 0
 }
 
-func (x *SignInWithAppleRequest) GetAuthorizationCode() string {
+func (x *JumprunPoint) GetLongitude() float64 {
 	if x != nil {
-		return x.AuthorizationCode
+		return x.Longitude
 	}
-	return ""
+	return 0
 }
 
-func (x *SignInWithAppleRequest) GetGivenName() string {
+func (x *JumprunPoint) GetX() int32 {
 	if x != nil {
-		return x.GivenName
+		return x.X
 	}
-	return ""
+	return 0
 }
 
-func (x *SignInWithAppleRequest) GetFamilyName() string {
+func (x *JumprunPoint) GetY() int32 {
 	if x != nil {
-		return x.FamilyName
+		return x.Y
 	}
-	return ""
+	return 0
 }
 
-type SignInResponse struct {
+type JumprunPath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId         string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	SessionExpiration int64    `protobuf:"varint,2,opt,name=session_expiration,json=sessionExpiration,proto3" json:"session_expiration,omitempty"`
-	IsValid           bool     `protobuf:"varint,3,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	Roles             []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
-	ErrorMessage      string   `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	SessionDeleted    bool     `protobuf:"varint,6,opt,name=session_deleted,json=sessionDeleted,proto3" json:"session_deleted,omitempty"`
+	Heading               int32           `protobuf:"varint,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	ExitDistance          int32           `protobuf:"varint,4,opt,name=exit_distance,json=exitDistance,proto3" json:"exit_distance,omitempty"`
+	OffsetHeading         int32           `protobuf:"varint,5,opt,name=offset_heading,json=offsetHeading,proto3" json:"offset_heading,omitempty"`
+	OffsetDistance        int32           `protobuf:"varint,6,opt,name=offset_distance,json=offsetDistance,proto3" json:"offset_distance,omitempty"`
+	Turns                 []*JumprunTurn  `protobuf:"bytes,7,rep,name=turns,proto3" json:"turns,omitempty"`
+	GroundTrack           []*JumprunPoint `protobuf:"bytes,8,rep,name=ground_track,json=groundTrack,proto3" json:"ground_track,omitempty"`
+	TrueHeading           int32           `protobuf:"varint,9,opt,name=true_heading,json=trueHeading,proto3" json:"true_heading,omitempty"`
+	MagneticHeading       int32           `protobuf:"varint,10,opt,name=magnetic_heading,json=magneticHeading,proto3" json:"magnetic_heading,omitempty"`
+	OffsetTrueHeading     int32           `protobuf:"varint,11,opt,name=offset_true_heading,json=offsetTrueHeading,proto3" json:"offset_true_heading,omitempty"`
+	OffsetMagneticHeading int32           `protobuf:"varint,12,opt,name=offset_magnetic_heading,json=offsetMagneticHeading,proto3" json:"offset_magnetic_heading,omitempty"`
+	// The heading to fly to make good the jumprun heading in the winds
+	// at jump run altitude, if known.
+	WindCorrected            bool  `protobuf:"varint,13,opt,name=wind_corrected,json=windCorrected,proto3" json:"wind_corrected,omitempty"`
+	CorrectedHeading         int32 `protobuf:"varint,14,opt,name=corrected_heading,json=correctedHeading,proto3" json:"corrected_heading,omitempty"`
+	CorrectedTrueHeading     int32 `protobuf:"varint,15,opt,name=corrected_true_heading,json=correctedTrueHeading,proto3" json:"corrected_true_heading,omitempty"`
+	CorrectedMagneticHeading int32 `protobuf:"varint,16,opt,name=corrected_magnetic_heading,json=correctedMagneticHeading,proto3" json:"corrected_magnetic_heading,omitempty"`
+	GroundSpeed              int32 `protobuf:"varint,17,opt,name=ground_speed,json=groundSpeed,proto3" json:"ground_speed,omitempty"`
 }
 
-func (x *SignInResponse) Reset() {
-	*x = SignInResponse{}
+func (x *JumprunPath) Reset() {
+	*x = JumprunPath{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[15]
+		mi := &file_pkg_server_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignInResponse) String() string {
+func (x *JumprunPath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignInResponse) ProtoMessage() {}
+func (*JumprunPath) ProtoMessage() {}
 
-func (x *SignInResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[15]
+func (x *JumprunPath) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,78 +1587,149 @@ func (x *SignInResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignInResponse.ProtoReflect.Descriptor instead.
-func (*SignInResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use JumprunPath.ProtoReflect.Descriptor instead.
+func (*JumprunPath) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SignInResponse) GetSessionId() string {
+func (x *JumprunPath) GetHeading() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.Heading
 	}
-	return ""
+	return 0
 }
 
-func (x *SignInResponse) GetSessionExpiration() int64 {
+func (x *JumprunPath) GetExitDistance() int32 {
 	if x != nil {
-		return x.SessionExpiration
+		return x.ExitDistance
 	}
 	return 0
 }
 
-func (x *SignInResponse) GetIsValid() bool {
+func (x *JumprunPath) GetOffsetHeading() int32 {
 	if x != nil {
-		return x.IsValid
+		return x.OffsetHeading
 	}
-	return false
+	return 0
 }
 
-func (x *SignInResponse) GetRoles() []string {
+func (x *JumprunPath) GetOffsetDistance() int32 {
 	if x != nil {
-		return x.Roles
+		return x.OffsetDistance
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetTurns() []*JumprunTurn {
+	if x != nil {
+		return x.Turns
 	}
 	return nil
 }
 
-func (x *SignInResponse) GetErrorMessage() string {
+func (x *JumprunPath) GetGroundTrack() []*JumprunPoint {
 	if x != nil {
-		return x.ErrorMessage
+		return x.GroundTrack
 	}
-	return ""
+	return nil
 }
 
-func (x *SignInResponse) GetSessionDeleted() bool {
+func (x *JumprunPath) GetTrueHeading() int32 {
 	if x != nil {
-		return x.SessionDeleted
+		return x.TrueHeading
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetMagneticHeading() int32 {
+	if x != nil {
+		return x.MagneticHeading
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetOffsetTrueHeading() int32 {
+	if x != nil {
+		return x.OffsetTrueHeading
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetOffsetMagneticHeading() int32 {
+	if x != nil {
+		return x.OffsetMagneticHeading
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetWindCorrected() bool {
+	if x != nil {
+		return x.WindCorrected
 	}
 	return false
 }
 
-type SignOutRequest struct {
+func (x *JumprunPath) GetCorrectedHeading() int32 {
+	if x != nil {
+		return x.CorrectedHeading
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetCorrectedTrueHeading() int32 {
+	if x != nil {
+		return x.CorrectedTrueHeading
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetCorrectedMagneticHeading() int32 {
+	if x != nil {
+		return x.CorrectedMagneticHeading
+	}
+	return 0
+}
+
+func (x *JumprunPath) GetGroundSpeed() int32 {
+	if x != nil {
+		return x.GroundSpeed
+	}
+	return 0
+}
+
+// JumprunSpot is the recommended exit spot, upwind of the target by the
+// drift through the winds aloft.
+type JumprunSpot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	DistanceFt      int32   `protobuf:"varint,1,opt,name=distance_ft,json=distanceFt,proto3" json:"distance_ft,omitempty"` // from the target
+	Heading         int32   `protobuf:"varint,2,opt,name=heading,proto3" json:"heading,omitempty"`                         // from the target
+	TrueHeading     int32   `protobuf:"varint,3,opt,name=true_heading,json=trueHeading,proto3" json:"true_heading,omitempty"`
+	MagneticHeading int32   `protobuf:"varint,4,opt,name=magnetic_heading,json=magneticHeading,proto3" json:"magnetic_heading,omitempty"`
+	Latitude        float64 `protobuf:"fixed64,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude       float64 `protobuf:"fixed64,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Description     string  `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"` // e.g. "Spot 0.3 nm upwind on heading 270"
 }
 
-func (x *SignOutRequest) Reset() {
-	*x = SignOutRequest{}
+func (x *JumprunSpot) Reset() {
+	*x = JumprunSpot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[16]
+		mi := &file_pkg_server_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignOutRequest) String() string {
+func (x *JumprunSpot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignOutRequest) ProtoMessage() {}
+func (*JumprunSpot) ProtoMessage() {}
 
-func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[16]
+func (x *JumprunSpot) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1401,43 +1740,87 @@ func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
-func (*SignOutRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use JumprunSpot.ProtoReflect.Descriptor instead.
+func (*JumprunSpot) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SignOutRequest) GetSessionId() string {
+func (x *JumprunSpot) GetDistanceFt() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.DistanceFt
+	}
+	return 0
+}
+
+func (x *JumprunSpot) GetHeading() int32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *JumprunSpot) GetTrueHeading() int32 {
+	if x != nil {
+		return x.TrueHeading
+	}
+	return 0
+}
+
+func (x *JumprunSpot) GetMagneticHeading() int32 {
+	if x != nil {
+		return x.MagneticHeading
+	}
+	return 0
+}
+
+func (x *JumprunSpot) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *JumprunSpot) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *JumprunSpot) GetDescription() string {
+	if x != nil {
+		return x.Description
 	}
 	return ""
 }
 
-type SignOutResponse struct {
+type Jumprun struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Origin *JumprunOrigin `protobuf:"bytes,1,opt,name=origin,proto3" json:"origin,omitempty"`
+	Path   *JumprunPath   `protobuf:"bytes,2,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	Spot   *JumprunSpot   `protobuf:"bytes,3,opt,name=spot,proto3,oneof" json:"spot,omitempty"`
 }
 
-func (x *SignOutResponse) Reset() {
-	*x = SignOutResponse{}
+func (x *Jumprun) Reset() {
+	*x = Jumprun{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[17]
+		mi := &file_pkg_server_service_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SignOutResponse) String() string {
+func (x *Jumprun) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignOutResponse) ProtoMessage() {}
+func (*Jumprun) ProtoMessage() {}
 
-func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[17]
+func (x *Jumprun) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,43 +1831,76 @@ func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignOutResponse.ProtoReflect.Descriptor instead.
-func (*SignOutResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use Jumprun.ProtoReflect.Descriptor instead.
+func (*Jumprun) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SignOutResponse) GetSessionId() string {
+func (x *Jumprun) GetOrigin() *JumprunOrigin {
 	if x != nil {
-		return x.SessionId
+		return x.Origin
 	}
-	return ""
+	return nil
 }
 
-type VerifySessionRequest struct {
+func (x *Jumprun) GetPath() *JumprunPath {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Jumprun) GetSpot() *JumprunSpot {
+	if x != nil {
+		return x.Spot
+	}
+	return nil
+}
+
+type WindsAloftSample struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Altitude              int32 `protobuf:"varint,1,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	Heading               int32 `protobuf:"varint,2,opt,name=heading,proto3" json:"heading,omitempty"`
+	Speed                 int32 `protobuf:"varint,3,opt,name=speed,proto3" json:"speed,omitempty"`
+	Temperature           int32 `protobuf:"varint,4,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Variable              bool  `protobuf:"varint,5,opt,name=variable,proto3" json:"variable,omitempty"`
+	TrueHeading           int32 `protobuf:"varint,6,opt,name=true_heading,json=trueHeading,proto3" json:"true_heading,omitempty"`
+	MagneticHeading       int32 `protobuf:"varint,7,opt,name=magnetic_heading,json=magneticHeading,proto3" json:"magnetic_heading,omitempty"`
+	TemperatureFahrenheit int32 `protobuf:"varint,8,opt,name=temperature_fahrenheit,json=temperatureFahrenheit,proto3" json:"temperature_fahrenheit,omitempty"`
+	FeelsLike             int32 `protobuf:"varint,9,opt,name=feels_like,json=feelsLike,proto3" json:"feels_like,omitempty"`
+	FeelsLikeFahrenheit   int32 `protobuf:"varint,10,opt,name=feels_like_fahrenheit,json=feelsLikeFahrenheit,proto3" json:"feels_like_fahrenheit,omitempty"`
+	DirectionShear        int32 `protobuf:"varint,11,opt,name=direction_shear,json=directionShear,proto3" json:"direction_shear,omitempty"`
+	SpeedShear            int32 `protobuf:"varint,12,opt,name=speed_shear,json=speedShear,proto3" json:"speed_shear,omitempty"`
+	ShearWarning          bool  `protobuf:"varint,13,opt,name=shear_warning,json=shearWarning,proto3" json:"shear_warning,omitempty"`
+	// How the wind has changed over the last several refreshes; only for
+	// the current samples.
+	SpeedChange     int32  `protobuf:"varint,14,opt,name=speed_change,json=speedChange,proto3" json:"speed_change,omitempty"`             // knots; positive is stronger
+	DirectionChange int32  `protobuf:"varint,15,opt,name=direction_change,json=directionChange,proto3" json:"direction_change,omitempty"` // degrees; positive is clockwise
+	SpeedTrend      string `protobuf:"bytes,16,opt,name=speed_trend,json=speedTrend,proto3" json:"speed_trend,omitempty"`                 // steady, building, or easing
+	DirectionTrend  string `protobuf:"bytes,17,opt,name=direction_trend,json=directionTrend,proto3" json:"direction_trend,omitempty"`     // steady, veering, or backing
+	AltitudeMsl     int32  `protobuf:"varint,18,opt,name=altitude_msl,json=altitudeMsl,proto3" json:"altitude_msl,omitempty"`             // altitude is AGL; displays may show either
 }
 
-func (x *VerifySessionRequest) Reset() {
-	*x = VerifySessionRequest{}
+func (x *WindsAloftSample) Reset() {
+	*x = WindsAloftSample{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[18]
+		mi := &file_pkg_server_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerifySessionRequest) String() string {
+func (x *WindsAloftSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifySessionRequest) ProtoMessage() {}
+func (*WindsAloftSample) ProtoMessage() {}
 
-func (x *VerifySessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[18]
+func (x *WindsAloftSample) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,43 +1911,170 @@ func (x *VerifySessionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifySessionRequest.ProtoReflect.Descriptor instead.
-func (*VerifySessionRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use WindsAloftSample.ProtoReflect.Descriptor instead.
+func (*WindsAloftSample) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *VerifySessionRequest) GetSessionId() string {
+func (x *WindsAloftSample) GetAltitude() int32 {
 	if x != nil {
-		return x.SessionId
+		return x.Altitude
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetHeading() int32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetSpeed() int32 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetTemperature() int32 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetVariable() bool {
+	if x != nil {
+		return x.Variable
+	}
+	return false
+}
+
+func (x *WindsAloftSample) GetTrueHeading() int32 {
+	if x != nil {
+		return x.TrueHeading
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetMagneticHeading() int32 {
+	if x != nil {
+		return x.MagneticHeading
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetTemperatureFahrenheit() int32 {
+	if x != nil {
+		return x.TemperatureFahrenheit
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetFeelsLike() int32 {
+	if x != nil {
+		return x.FeelsLike
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetFeelsLikeFahrenheit() int32 {
+	if x != nil {
+		return x.FeelsLikeFahrenheit
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetDirectionShear() int32 {
+	if x != nil {
+		return x.DirectionShear
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetSpeedShear() int32 {
+	if x != nil {
+		return x.SpeedShear
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetShearWarning() bool {
+	if x != nil {
+		return x.ShearWarning
+	}
+	return false
+}
+
+func (x *WindsAloftSample) GetSpeedChange() int32 {
+	if x != nil {
+		return x.SpeedChange
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetDirectionChange() int32 {
+	if x != nil {
+		return x.DirectionChange
+	}
+	return 0
+}
+
+func (x *WindsAloftSample) GetSpeedTrend() string {
+	if x != nil {
+		return x.SpeedTrend
 	}
 	return ""
 }
 
-type ToggleFuelRequestedRequest struct {
+func (x *WindsAloftSample) GetDirectionTrend() string {
+	if x != nil {
+		return x.DirectionTrend
+	}
+	return ""
+}
+
+func (x *WindsAloftSample) GetAltitudeMsl() int32 {
+	if x != nil {
+		return x.AltitudeMsl
+	}
+	return 0
+}
+
+type WindsAloft struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Samples        []*WindsAloftSample   `protobuf:"bytes,1,rep,name=samples,proto3" json:"samples,omitempty"`
+	Simulated      bool                  `protobuf:"varint,2,opt,name=simulated,proto3" json:"simulated,omitempty"`
+	ShearWarning   bool                  `protobuf:"varint,3,opt,name=shear_warning,json=shearWarning,proto3" json:"shear_warning,omitempty"`
+	ExitAltitude   int32                 `protobuf:"varint,4,opt,name=exit_altitude,json=exitAltitude,proto3" json:"exit_altitude,omitempty"`       // feet AGL for the next departing load's aircraft
+	Forecasts      []*WindsAloftForecast `protobuf:"bytes,5,rep,name=forecasts,proto3" json:"forecasts,omitempty"`                                  // for hours after now
+	FetchTime      int64                 `protobuf:"varint,6,opt,name=fetch_time,json=fetchTime,proto3" json:"fetch_time,omitempty"`                // Unix time last retrieved; 0 if never
+	Stale          bool                  `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`                                         // not retrieved recently; displays may gray it out
+	FieldElevation int32                 `protobuf:"varint,8,opt,name=field_elevation,json=fieldElevation,proto3" json:"field_elevation,omitempty"` // feet MSL; sample altitude_msl less altitude
+	Degraded       bool                  `protobuf:"varint,9,opt,name=degraded,proto3" json:"degraded,omitempty"`                                   // the last good samples; the data source can't be reached
 }
 
-func (x *ToggleFuelRequestedRequest) Reset() {
-	*x = ToggleFuelRequestedRequest{}
+func (x *WindsAloft) Reset() {
+	*x = WindsAloft{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[19]
+		mi := &file_pkg_server_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ToggleFuelRequestedRequest) String() string {
+func (x *WindsAloft) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleFuelRequestedRequest) ProtoMessage() {}
+func (*WindsAloft) ProtoMessage() {}
 
-func (x *ToggleFuelRequestedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[19]
+func (x *WindsAloft) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1542,517 +2085,5400 @@ func (x *ToggleFuelRequestedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleFuelRequestedRequest.ProtoReflect.Descriptor instead.
-func (*ToggleFuelRequestedRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use WindsAloft.ProtoReflect.Descriptor instead.
+func (*WindsAloft) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ToggleFuelRequestedRequest) GetSessionId() string {
+func (x *WindsAloft) GetSamples() []*WindsAloftSample {
 	if x != nil {
-		return x.SessionId
+		return x.Samples
 	}
-	return ""
+	return nil
 }
 
-type ToggleFuelRequestedResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *WindsAloft) GetSimulated() bool {
+	if x != nil {
+		return x.Simulated
+	}
+	return false
+}
 
-	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+func (x *WindsAloft) GetShearWarning() bool {
+	if x != nil {
+		return x.ShearWarning
+	}
+	return false
 }
 
-func (x *ToggleFuelRequestedResponse) Reset() {
-	*x = ToggleFuelRequestedResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WindsAloft) GetExitAltitude() int32 {
+	if x != nil {
+		return x.ExitAltitude
 	}
+	return 0
 }
 
-func (x *ToggleFuelRequestedResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WindsAloft) GetForecasts() []*WindsAloftForecast {
+	if x != nil {
+		return x.Forecasts
+	}
+	return nil
 }
 
-func (*ToggleFuelRequestedResponse) ProtoMessage() {}
+func (x *WindsAloft) GetFetchTime() int64 {
+	if x != nil {
+		return x.FetchTime
+	}
+	return 0
+}
 
-func (x *ToggleFuelRequestedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[20]
+func (x *WindsAloft) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+func (x *WindsAloft) GetFieldElevation() int32 {
+	if x != nil {
+		return x.FieldElevation
+	}
+	return 0
+}
+
+func (x *WindsAloft) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+type WindsAloftForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hour         int32               `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`                            // hours after now
+	ValidTime    int64               `protobuf:"varint,2,opt,name=valid_time,json=validTime,proto3" json:"valid_time,omitempty"` // Unix time
+	Samples      []*WindsAloftSample `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`
+	ShearWarning bool                `protobuf:"varint,4,opt,name=shear_warning,json=shearWarning,proto3" json:"shear_warning,omitempty"`
+}
+
+func (x *WindsAloftForecast) Reset() {
+	*x = WindsAloftForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindsAloftForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindsAloftForecast) ProtoMessage() {}
+
+func (x *WindsAloftForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindsAloftForecast.ProtoReflect.Descriptor instead.
+func (*WindsAloftForecast) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WindsAloftForecast) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *WindsAloftForecast) GetValidTime() int64 {
+	if x != nil {
+		return x.ValidTime
+	}
+	return 0
+}
+
+func (x *WindsAloftForecast) GetSamples() []*WindsAloftSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *WindsAloftForecast) GetShearWarning() bool {
+	if x != nil {
+		return x.ShearWarning
+	}
+	return false
+}
+
+type Jumper struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type         JumperType `protobuf:"varint,2,opt,name=type,proto3,enum=manifest.JumperType" json:"type,omitempty"`
+	Name         string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Nickname     string     `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	ShortName    string     `protobuf:"bytes,5,opt,name=short_name,json=shortName,proto3" json:"short_name,omitempty"`
+	Color        uint32     `protobuf:"varint,6,opt,name=color,proto3" json:"color,omitempty"`
+	Repr         string     `protobuf:"bytes,7,opt,name=repr,proto3" json:"repr,omitempty"`
+	RigName      string     `protobuf:"bytes,8,opt,name=rig_name,json=rigName,proto3" json:"rig_name,omitempty"`
+	StableId     string     `protobuf:"bytes,9,opt,name=stable_id,json=stableId,proto3" json:"stable_id,omitempty"`
+	PullAltitude int32      `protobuf:"varint,10,opt,name=pull_altitude,json=pullAltitude,proto3" json:"pull_altitude,omitempty"`
+}
+
+func (x *Jumper) Reset() {
+	*x = Jumper{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Jumper) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jumper) ProtoMessage() {}
+
+func (x *Jumper) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jumper.ProtoReflect.Descriptor instead.
+func (*Jumper) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Jumper) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Jumper) GetType() JumperType {
+	if x != nil {
+		return x.Type
+	}
+	return JumperType_EXPERIENCED
+}
+
+func (x *Jumper) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Jumper) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *Jumper) GetShortName() string {
+	if x != nil {
+		return x.ShortName
+	}
+	return ""
+}
+
+func (x *Jumper) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *Jumper) GetRepr() string {
+	if x != nil {
+		return x.Repr
+	}
+	return ""
+}
+
+func (x *Jumper) GetRigName() string {
+	if x != nil {
+		return x.RigName
+	}
+	return ""
+}
+
+func (x *Jumper) GetStableId() string {
+	if x != nil {
+		return x.StableId
+	}
+	return ""
+}
+
+func (x *Jumper) GetPullAltitude() int32 {
+	if x != nil {
+		return x.PullAltitude
+	}
+	return 0
+}
+
+type JumperGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leader   *Jumper   `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+	Members  []*Jumper `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	TeamName string    `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"` // for competition loads; empty if not a team
+	Header   string    `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`                     // e.g. "Team Chaos (4-way)"; shown above the group
+}
+
+func (x *JumperGroup) Reset() {
+	*x = JumperGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumperGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumperGroup) ProtoMessage() {}
+
+func (x *JumperGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ToggleFuelRequestedResponse.ProtoReflect.Descriptor instead.
-func (*ToggleFuelRequestedResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ToggleFuelRequestedResponse) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
-type RestartServerRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-}
-
-func (x *RestartServerRequest) Reset() {
-	*x = RestartServerRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RestartServerRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RestartServerRequest) ProtoMessage() {}
-
-func (x *RestartServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumperGroup.ProtoReflect.Descriptor instead.
+func (*JumperGroup) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *JumperGroup) GetLeader() *Jumper {
+	if x != nil {
+		return x.Leader
+	}
+	return nil
+}
+
+func (x *JumperGroup) GetMembers() []*Jumper {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *JumperGroup) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *JumperGroup) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+type LoadSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Slot:
+	//	*LoadSlot_Jumper
+	//	*LoadSlot_Group
+	Slot isLoadSlot_Slot `protobuf_oneof:"slot"`
+}
+
+func (x *LoadSlot) Reset() {
+	*x = LoadSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadSlot) ProtoMessage() {}
+
+func (x *LoadSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadSlot.ProtoReflect.Descriptor instead.
+func (*LoadSlot) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (m *LoadSlot) GetSlot() isLoadSlot_Slot {
+	if m != nil {
+		return m.Slot
+	}
+	return nil
+}
+
+func (x *LoadSlot) GetJumper() *Jumper {
+	if x, ok := x.GetSlot().(*LoadSlot_Jumper); ok {
+		return x.Jumper
+	}
+	return nil
+}
+
+func (x *LoadSlot) GetGroup() *JumperGroup {
+	if x, ok := x.GetSlot().(*LoadSlot_Group); ok {
+		return x.Group
+	}
+	return nil
+}
+
+type isLoadSlot_Slot interface {
+	isLoadSlot_Slot()
+}
+
+type LoadSlot_Jumper struct {
+	Jumper *Jumper `protobuf:"bytes,1,opt,name=jumper,proto3,oneof"`
+}
+
+type LoadSlot_Group struct {
+	Group *JumperGroup `protobuf:"bytes,2,opt,name=group,proto3,oneof"`
+}
+
+func (*LoadSlot_Jumper) isLoadSlot_Slot() {}
+
+func (*LoadSlot_Group) isLoadSlot_Slot() {}
+
+// LayoutHint describes how a load should be placed in the manifest's
+// columns. column is -1 if the load doesn't fit.
+type LayoutHint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows       int32 `protobuf:"varint,1,opt,name=rows,proto3" json:"rows,omitempty"`
+	Column     int32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	ColumnSpan int32 `protobuf:"varint,3,opt,name=column_span,json=columnSpan,proto3" json:"column_span,omitempty"`
+}
+
+func (x *LayoutHint) Reset() {
+	*x = LayoutHint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LayoutHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LayoutHint) ProtoMessage() {}
+
+func (x *LayoutHint) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LayoutHint.ProtoReflect.Descriptor instead.
+func (*LayoutHint) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LayoutHint) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *LayoutHint) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *LayoutHint) GetColumnSpan() int32 {
+	if x != nil {
+		return x.ColumnSpan
+	}
+	return 0
+}
+
+// ExitGroup is a group in a load's exit order.
+type ExitGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderId          string `protobuf:"bytes,1,opt,name=leader_id,json=leaderId,proto3" json:"leader_id,omitempty"` // the leader's stable ID
+	Name              string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Discipline        string `protobuf:"bytes,3,opt,name=discipline,proto3" json:"discipline,omitempty"`
+	Size              int32  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	SeparationSeconds int32  `protobuf:"varint,5,opt,name=separation_seconds,json=separationSeconds,proto3" json:"separation_seconds,omitempty"` // after the previous group
+}
+
+func (x *ExitGroup) Reset() {
+	*x = ExitGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExitGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExitGroup) ProtoMessage() {}
+
+func (x *ExitGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExitGroup.ProtoReflect.Descriptor instead.
+func (*ExitGroup) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExitGroup) GetLeaderId() string {
+	if x != nil {
+		return x.LeaderId
+	}
+	return ""
+}
+
+func (x *ExitGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExitGroup) GetDiscipline() string {
+	if x != nil {
+		return x.Discipline
+	}
+	return ""
+}
+
+func (x *ExitGroup) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ExitGroup) GetSeparationSeconds() int32 {
+	if x != nil {
+		return x.SeparationSeconds
+	}
+	return 0
+}
+
+type Load struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AircraftName         string       `protobuf:"bytes,2,opt,name=aircraft_name,json=aircraftName,proto3" json:"aircraft_name,omitempty"`
+	LoadNumber           string       `protobuf:"bytes,3,opt,name=load_number,json=loadNumber,proto3" json:"load_number,omitempty"`
+	CallMinutes          int32        `protobuf:"varint,4,opt,name=call_minutes,json=callMinutes,proto3" json:"call_minutes,omitempty"`
+	CallMinutesString    string       `protobuf:"bytes,5,opt,name=call_minutes_string,json=callMinutesString,proto3" json:"call_minutes_string,omitempty"`
+	SlotsAvailable       int32        `protobuf:"varint,6,opt,name=slots_available,json=slotsAvailable,proto3" json:"slots_available,omitempty"`
+	SlotsAvailableString string       `protobuf:"bytes,7,opt,name=slots_available_string,json=slotsAvailableString,proto3" json:"slots_available_string,omitempty"`
+	IsFueling            bool         `protobuf:"varint,8,opt,name=is_fueling,json=isFueling,proto3" json:"is_fueling,omitempty"`
+	IsTurning            bool         `protobuf:"varint,9,opt,name=is_turning,json=isTurning,proto3" json:"is_turning,omitempty"`
+	IsNoTime             bool         `protobuf:"varint,10,opt,name=is_no_time,json=isNoTime,proto3" json:"is_no_time,omitempty"`
+	Slots                []*LoadSlot  `protobuf:"bytes,11,rep,name=slots,proto3" json:"slots,omitempty"`
+	State                CallState    `protobuf:"varint,12,opt,name=state,proto3,enum=manifest.CallState" json:"state,omitempty"`
+	Layout               *LayoutHint  `protobuf:"bytes,13,opt,name=layout,proto3" json:"layout,omitempty"`
+	IsVolatile           bool         `protobuf:"varint,14,opt,name=is_volatile,json=isVolatile,proto3" json:"is_volatile,omitempty"`                        // jumpers are churning close to the call
+	ExitOrder            []*ExitGroup `protobuf:"bytes,15,rep,name=exit_order,json=exitOrder,proto3" json:"exit_order,omitempty"`                            // empty unless exit orders are enabled
+	ExitOrderAccepted    bool         `protobuf:"varint,16,opt,name=exit_order_accepted,json=exitOrderAccepted,proto3" json:"exit_order_accepted,omitempty"` // by a load organizer
+}
+
+func (x *Load) Reset() {
+	*x = Load{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Load) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Load) ProtoMessage() {}
+
+func (x *Load) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Load.ProtoReflect.Descriptor instead.
+func (*Load) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Load) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Load) GetAircraftName() string {
+	if x != nil {
+		return x.AircraftName
+	}
+	return ""
+}
+
+func (x *Load) GetLoadNumber() string {
+	if x != nil {
+		return x.LoadNumber
+	}
+	return ""
+}
+
+func (x *Load) GetCallMinutes() int32 {
+	if x != nil {
+		return x.CallMinutes
+	}
+	return 0
+}
+
+func (x *Load) GetCallMinutesString() string {
+	if x != nil {
+		return x.CallMinutesString
+	}
+	return ""
+}
+
+func (x *Load) GetSlotsAvailable() int32 {
+	if x != nil {
+		return x.SlotsAvailable
+	}
+	return 0
+}
+
+func (x *Load) GetSlotsAvailableString() string {
+	if x != nil {
+		return x.SlotsAvailableString
+	}
+	return ""
+}
+
+func (x *Load) GetIsFueling() bool {
+	if x != nil {
+		return x.IsFueling
+	}
+	return false
+}
+
+func (x *Load) GetIsTurning() bool {
+	if x != nil {
+		return x.IsTurning
+	}
+	return false
+}
+
+func (x *Load) GetIsNoTime() bool {
+	if x != nil {
+		return x.IsNoTime
+	}
+	return false
+}
+
+func (x *Load) GetSlots() []*LoadSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *Load) GetState() CallState {
+	if x != nil {
+		return x.State
+	}
+	return CallState_MANIFESTING
+}
+
+func (x *Load) GetLayout() *LayoutHint {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+func (x *Load) GetIsVolatile() bool {
+	if x != nil {
+		return x.IsVolatile
+	}
+	return false
+}
+
+func (x *Load) GetExitOrder() []*ExitGroup {
+	if x != nil {
+		return x.ExitOrder
+	}
+	return nil
+}
+
+func (x *Load) GetExitOrderAccepted() bool {
+	if x != nil {
+		return x.ExitOrderAccepted
+	}
+	return false
+}
+
+type Loads struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ColumnCount   int32     `protobuf:"varint,1,opt,name=column_count,json=columnCount,proto3" json:"column_count,omitempty"`
+	Loads         []*Load   `protobuf:"bytes,2,rep,name=loads,proto3" json:"loads,omitempty"`
+	ClosedMessage string    `protobuf:"bytes,3,opt,name=closed_message,json=closedMessage,proto3" json:"closed_message,omitempty"`
+	Standby       []*Jumper `protobuf:"bytes,4,rep,name=standby,proto3" json:"standby,omitempty"` // in the order they'll be placed
+}
+
+func (x *Loads) Reset() {
+	*x = Loads{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loads) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loads) ProtoMessage() {}
+
+func (x *Loads) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loads.ProtoReflect.Descriptor instead.
+func (*Loads) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Loads) GetColumnCount() int32 {
+	if x != nil {
+		return x.ColumnCount
+	}
+	return 0
+}
+
+func (x *Loads) GetLoads() []*Load {
+	if x != nil {
+		return x.Loads
+	}
+	return nil
+}
+
+func (x *Loads) GetClosedMessage() string {
+	if x != nil {
+		return x.ClosedMessage
+	}
+	return ""
+}
+
+func (x *Loads) GetStandby() []*Jumper {
+	if x != nil {
+		return x.Standby
+	}
+	return nil
+}
+
+type Alert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Class    string        `protobuf:"bytes,2,opt,name=class,proto3" json:"class,omitempty"`
+	Priority AlertPriority `protobuf:"varint,3,opt,name=priority,proto3,enum=manifest.AlertPriority" json:"priority,omitempty"`
+	Message  string        `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Color    uint32        `protobuf:"varint,5,opt,name=color,proto3" json:"color,omitempty"`
+	Posted   int64         `protobuf:"varint,6,opt,name=posted,proto3" json:"posted,omitempty"`
+	Expires  int64         `protobuf:"varint,7,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Alert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Alert) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *Alert) GetPriority() AlertPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return AlertPriority_INFO
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetColor() uint32 {
+	if x != nil {
+		return x.Color
+	}
+	return 0
+}
+
+func (x *Alert) GetPosted() int64 {
+	if x != nil {
+		return x.Posted
+	}
+	return 0
+}
+
+func (x *Alert) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+type Alerts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alerts []*Alert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+}
+
+func (x *Alerts) Reset() {
+	*x = Alerts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alerts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alerts) ProtoMessage() {}
+
+func (x *Alerts) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alerts.ProtoReflect.Descriptor instead.
+func (*Alerts) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Alerts) GetAlerts() []*Alert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type ChimeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoadId       uint64 `protobuf:"varint,1,opt,name=load_id,json=loadId,proto3" json:"load_id,omitempty"`
+	AircraftName string `protobuf:"bytes,2,opt,name=aircraft_name,json=aircraftName,proto3" json:"aircraft_name,omitempty"`
+	LoadNumber   string `protobuf:"bytes,3,opt,name=load_number,json=loadNumber,proto3" json:"load_number,omitempty"`
+	CallMinutes  int32  `protobuf:"varint,4,opt,name=call_minutes,json=callMinutes,proto3" json:"call_minutes,omitempty"`
+	Call         string `protobuf:"bytes,5,opt,name=call,proto3" json:"call,omitempty"`
+}
+
+func (x *ChimeEvent) Reset() {
+	*x = ChimeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChimeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChimeEvent) ProtoMessage() {}
+
+func (x *ChimeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChimeEvent.ProtoReflect.Descriptor instead.
+func (*ChimeEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChimeEvent) GetLoadId() uint64 {
+	if x != nil {
+		return x.LoadId
+	}
+	return 0
+}
+
+func (x *ChimeEvent) GetAircraftName() string {
+	if x != nil {
+		return x.AircraftName
+	}
+	return ""
+}
+
+func (x *ChimeEvent) GetLoadNumber() string {
+	if x != nil {
+		return x.LoadNumber
+	}
+	return ""
+}
+
+func (x *ChimeEvent) GetCallMinutes() int32 {
+	if x != nil {
+		return x.CallMinutes
+	}
+	return 0
+}
+
+func (x *ChimeEvent) GetCall() string {
+	if x != nil {
+		return x.Call
+	}
+	return ""
+}
+
+type Timestamps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BurbleUpdatedAt  int64 `protobuf:"varint,1,opt,name=burble_updated_at,json=burbleUpdatedAt,proto3" json:"burble_updated_at,omitempty"`
+	MetarUpdatedAt   int64 `protobuf:"varint,2,opt,name=metar_updated_at,json=metarUpdatedAt,proto3" json:"metar_updated_at,omitempty"`
+	WindsUpdatedAt   int64 `protobuf:"varint,3,opt,name=winds_updated_at,json=windsUpdatedAt,proto3" json:"winds_updated_at,omitempty"`
+	JumprunUpdatedAt int64 `protobuf:"varint,4,opt,name=jumprun_updated_at,json=jumprunUpdatedAt,proto3" json:"jumprun_updated_at,omitempty"`
+}
+
+func (x *Timestamps) Reset() {
+	*x = Timestamps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timestamps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timestamps) ProtoMessage() {}
+
+func (x *Timestamps) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timestamps.ProtoReflect.Descriptor instead.
+func (*Timestamps) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Timestamps) GetBurbleUpdatedAt() int64 {
+	if x != nil {
+		return x.BurbleUpdatedAt
+	}
+	return 0
+}
+
+func (x *Timestamps) GetMetarUpdatedAt() int64 {
+	if x != nil {
+		return x.MetarUpdatedAt
+	}
+	return 0
+}
+
+func (x *Timestamps) GetWindsUpdatedAt() int64 {
+	if x != nil {
+		return x.WindsUpdatedAt
+	}
+	return 0
+}
+
+func (x *Timestamps) GetJumprunUpdatedAt() int64 {
+	if x != nil {
+		return x.JumprunUpdatedAt
+	}
+	return 0
+}
+
+type Takeover struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active  bool   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Posted  int64  `protobuf:"varint,3,opt,name=posted,proto3" json:"posted,omitempty"`
+	Expires int64  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *Takeover) Reset() {
+	*x = Takeover{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Takeover) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Takeover) ProtoMessage() {}
+
+func (x *Takeover) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Takeover.ProtoReflect.Descriptor instead.
+func (*Takeover) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Takeover) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Takeover) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Takeover) GetPosted() int64 {
+	if x != nil {
+		return x.Posted
+	}
+	return 0
+}
+
+func (x *Takeover) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+type Counter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Value int32  `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Counter) Reset() {
+	*x = Counter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Counter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counter) ProtoMessage() {}
+
+func (x *Counter) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counter.ProtoReflect.Descriptor instead.
+func (*Counter) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Counter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Counter) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Counter) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type Counters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counters []*Counter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
+}
+
+func (x *Counters) Reset() {
+	*x = Counters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Counters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Counters) ProtoMessage() {}
+
+func (x *Counters) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Counters.ProtoReflect.Descriptor instead.
+func (*Counters) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Counters) GetCounters() []*Counter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+// Trace follows an update through the pipeline so that the lag between a
+// change upstream and it appearing on a display can be measured. Times are
+// in milliseconds since the Unix epoch by the server's clock.
+type Trace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq             uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	FetchedAtMs     int64  `protobuf:"varint,2,opt,name=fetched_at_ms,json=fetchedAtMs,proto3" json:"fetched_at_ms,omitempty"` // when the Burble change was fetched; 0 if none
+	ConstructedAtMs int64  `protobuf:"varint,3,opt,name=constructed_at_ms,json=constructedAtMs,proto3" json:"constructed_at_ms,omitempty"`
+	SentAtMs        int64  `protobuf:"varint,4,opt,name=sent_at_ms,json=sentAtMs,proto3" json:"sent_at_ms,omitempty"`
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Trace) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Trace) GetFetchedAtMs() int64 {
+	if x != nil {
+		return x.FetchedAtMs
+	}
+	return 0
+}
+
+func (x *Trace) GetConstructedAtMs() int64 {
+	if x != nil {
+		return x.ConstructedAtMs
+	}
+	return 0
+}
+
+func (x *Trace) GetSentAtMs() int64 {
+	if x != nil {
+		return x.SentAtMs
+	}
+	return 0
+}
+
+type ManifestUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      *Status       `protobuf:"bytes,1,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Options     *Options      `protobuf:"bytes,2,opt,name=options,proto3,oneof" json:"options,omitempty"`
+	Jumprun     *Jumprun      `protobuf:"bytes,3,opt,name=jumprun,proto3,oneof" json:"jumprun,omitempty"`
+	WindsAloft  *WindsAloft   `protobuf:"bytes,4,opt,name=winds_aloft,json=windsAloft,proto3,oneof" json:"winds_aloft,omitempty"`
+	Loads       *Loads        `protobuf:"bytes,5,opt,name=loads,proto3,oneof" json:"loads,omitempty"`
+	Alerts      *Alerts       `protobuf:"bytes,6,opt,name=alerts,proto3,oneof" json:"alerts,omitempty"`
+	ChimeEvents []*ChimeEvent `protobuf:"bytes,7,rep,name=chime_events,json=chimeEvents,proto3" json:"chime_events,omitempty"`
+	Timestamps  *Timestamps   `protobuf:"bytes,8,opt,name=timestamps,proto3,oneof" json:"timestamps,omitempty"`
+	Takeover    *Takeover     `protobuf:"bytes,9,opt,name=takeover,proto3,oneof" json:"takeover,omitempty"`
+	Counters    *Counters     `protobuf:"bytes,10,opt,name=counters,proto3,oneof" json:"counters,omitempty"`
+	Trace       *Trace        `protobuf:"bytes,11,opt,name=trace,proto3,oneof" json:"trace,omitempty"` // only on streamed updates
+}
+
+func (x *ManifestUpdate) Reset() {
+	*x = ManifestUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestUpdate) ProtoMessage() {}
+
+func (x *ManifestUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestUpdate.ProtoReflect.Descriptor instead.
+func (*ManifestUpdate) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ManifestUpdate) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetOptions() *Options {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetJumprun() *Jumprun {
+	if x != nil {
+		return x.Jumprun
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetWindsAloft() *WindsAloft {
+	if x != nil {
+		return x.WindsAloft
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetLoads() *Loads {
+	if x != nil {
+		return x.Loads
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetAlerts() *Alerts {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetChimeEvents() []*ChimeEvent {
+	if x != nil {
+		return x.ChimeEvents
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetTimestamps() *Timestamps {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetTakeover() *Takeover {
+	if x != nil {
+		return x.Takeover
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetCounters() *Counters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *ManifestUpdate) GetTrace() *Trace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+type SignInWithAppleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleId          string `protobuf:"bytes,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Nonce             string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	IdentityToken     string `protobuf:"bytes,3,opt,name=identity_token,json=identityToken,proto3" json:"identity_token,omitempty"`
+	AuthorizationCode string `protobuf:"bytes,4,opt,name=authorization_code,json=authorizationCode,proto3" json:"authorization_code,omitempty"`
+	GivenName         string `protobuf:"bytes,5,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
+	FamilyName        string `protobuf:"bytes,6,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+}
+
+func (x *SignInWithAppleRequest) Reset() {
+	*x = SignInWithAppleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignInWithAppleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInWithAppleRequest) ProtoMessage() {}
+
+func (x *SignInWithAppleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInWithAppleRequest.ProtoReflect.Descriptor instead.
+func (*SignInWithAppleRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SignInWithAppleRequest) GetBundleId() string {
+	if x != nil {
+		return x.BundleId
+	}
+	return ""
+}
+
+func (x *SignInWithAppleRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *SignInWithAppleRequest) GetIdentityToken() string {
+	if x != nil {
+		return x.IdentityToken
+	}
+	return ""
+}
+
+func (x *SignInWithAppleRequest) GetAuthorizationCode() string {
+	if x != nil {
+		return x.AuthorizationCode
+	}
+	return ""
+}
+
+func (x *SignInWithAppleRequest) GetGivenName() string {
+	if x != nil {
+		return x.GivenName
+	}
+	return ""
+}
+
+func (x *SignInWithAppleRequest) GetFamilyName() string {
+	if x != nil {
+		return x.FamilyName
+	}
+	return ""
+}
+
+type SignInResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId         string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	SessionExpiration int64    `protobuf:"varint,2,opt,name=session_expiration,json=sessionExpiration,proto3" json:"session_expiration,omitempty"`
+	IsValid           bool     `protobuf:"varint,3,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	Roles             []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	ErrorMessage      string   `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	SessionDeleted    bool     `protobuf:"varint,6,opt,name=session_deleted,json=sessionDeleted,proto3" json:"session_deleted,omitempty"`
+}
+
+func (x *SignInResponse) Reset() {
+	*x = SignInResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignInResponse) ProtoMessage() {}
+
+func (x *SignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignInResponse.ProtoReflect.Descriptor instead.
+func (*SignInResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SignInResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SignInResponse) GetSessionExpiration() int64 {
+	if x != nil {
+		return x.SessionExpiration
+	}
+	return 0
+}
+
+func (x *SignInResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *SignInResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *SignInResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SignInResponse) GetSessionDeleted() bool {
+	if x != nil {
+		return x.SessionDeleted
+	}
+	return false
+}
+
+type SignOutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *SignOutRequest) Reset() {
+	*x = SignOutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutRequest) ProtoMessage() {}
+
+func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
+func (*SignOutRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SignOutRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type SignOutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *SignOutResponse) Reset() {
+	*x = SignOutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutResponse) ProtoMessage() {}
+
+func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutResponse.ProtoReflect.Descriptor instead.
+func (*SignOutResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SignOutResponse) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type VerifySessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *VerifySessionRequest) Reset() {
+	*x = VerifySessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifySessionRequest) ProtoMessage() {}
+
+func (x *VerifySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifySessionRequest.ProtoReflect.Descriptor instead.
+func (*VerifySessionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VerifySessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ToggleFuelRequestedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *ToggleFuelRequestedRequest) Reset() {
+	*x = ToggleFuelRequestedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleFuelRequestedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFuelRequestedRequest) ProtoMessage() {}
+
+func (x *ToggleFuelRequestedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFuelRequestedRequest.ProtoReflect.Descriptor instead.
+func (*ToggleFuelRequestedRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ToggleFuelRequestedRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ToggleFuelRequestedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *ToggleFuelRequestedResponse) Reset() {
+	*x = ToggleFuelRequestedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToggleFuelRequestedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleFuelRequestedResponse) ProtoMessage() {}
+
+func (x *ToggleFuelRequestedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleFuelRequestedResponse.ProtoReflect.Descriptor instead.
+func (*ToggleFuelRequestedResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ToggleFuelRequestedResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type RestartServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *RestartServerRequest) Reset() {
+	*x = RestartServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestartServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartServerRequest) ProtoMessage() {}
+
+func (x *RestartServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartServerRequest.ProtoReflect.Descriptor instead.
+func (*RestartServerRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RestartServerRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RestartServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *RestartServerResponse) Reset() {
+	*x = RestartServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestartServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestartServerResponse) ProtoMessage() {}
+
+func (x *RestartServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestartServerResponse.ProtoReflect.Descriptor instead.
+func (*RestartServerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RestartServerResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type SimulateWeatherRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId     string              `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Clear         bool                `protobuf:"varint,2,opt,name=clear,proto3" json:"clear,omitempty"`
+	WindSpeed     *float32            `protobuf:"fixed32,3,opt,name=wind_speed,json=windSpeed,proto3,oneof" json:"wind_speed,omitempty"`
+	WindGust      *float32            `protobuf:"fixed32,4,opt,name=wind_gust,json=windGust,proto3,oneof" json:"wind_gust,omitempty"`
+	WindDirection *float32            `protobuf:"fixed32,5,opt,name=wind_direction,json=windDirection,proto3,oneof" json:"wind_direction,omitempty"`
+	Temperature   *float32            `protobuf:"fixed32,6,opt,name=temperature,proto3,oneof" json:"temperature,omitempty"`
+	SkyCover      string              `protobuf:"bytes,7,opt,name=sky_cover,json=skyCover,proto3" json:"sky_cover,omitempty"`
+	Weather       string              `protobuf:"bytes,8,opt,name=weather,proto3" json:"weather,omitempty"`
+	WindsAloft    []*WindsAloftSample `protobuf:"bytes,9,rep,name=winds_aloft,json=windsAloft,proto3" json:"winds_aloft,omitempty"`
+}
+
+func (x *SimulateWeatherRequest) Reset() {
+	*x = SimulateWeatherRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateWeatherRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateWeatherRequest) ProtoMessage() {}
+
+func (x *SimulateWeatherRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateWeatherRequest.ProtoReflect.Descriptor instead.
+func (*SimulateWeatherRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SimulateWeatherRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SimulateWeatherRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+func (x *SimulateWeatherRequest) GetWindSpeed() float32 {
+	if x != nil && x.WindSpeed != nil {
+		return *x.WindSpeed
+	}
+	return 0
+}
+
+func (x *SimulateWeatherRequest) GetWindGust() float32 {
+	if x != nil && x.WindGust != nil {
+		return *x.WindGust
+	}
+	return 0
+}
+
+func (x *SimulateWeatherRequest) GetWindDirection() float32 {
+	if x != nil && x.WindDirection != nil {
+		return *x.WindDirection
+	}
+	return 0
+}
+
+func (x *SimulateWeatherRequest) GetTemperature() float32 {
+	if x != nil && x.Temperature != nil {
+		return *x.Temperature
+	}
+	return 0
+}
+
+func (x *SimulateWeatherRequest) GetSkyCover() string {
+	if x != nil {
+		return x.SkyCover
+	}
+	return ""
+}
+
+func (x *SimulateWeatherRequest) GetWeather() string {
+	if x != nil {
+		return x.Weather
+	}
+	return ""
+}
+
+func (x *SimulateWeatherRequest) GetWindsAloft() []*WindsAloftSample {
+	if x != nil {
+		return x.WindsAloft
+	}
+	return nil
+}
+
+type SimulateWeatherResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *SimulateWeatherResponse) Reset() {
+	*x = SimulateWeatherResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateWeatherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateWeatherResponse) ProtoMessage() {}
+
+func (x *SimulateWeatherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateWeatherResponse.ProtoReflect.Descriptor instead.
+func (*SimulateWeatherResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SimulateWeatherResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type PostAlertRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string        `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Id        string        `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Clear     bool          `protobuf:"varint,3,opt,name=clear,proto3" json:"clear,omitempty"`
+	Class     string        `protobuf:"bytes,4,opt,name=class,proto3" json:"class,omitempty"`
+	Priority  AlertPriority `protobuf:"varint,5,opt,name=priority,proto3,enum=manifest.AlertPriority" json:"priority,omitempty"`
+	Message   string        `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Expires   int64         `protobuf:"varint,7,opt,name=expires,proto3" json:"expires,omitempty"`
+}
+
+func (x *PostAlertRequest) Reset() {
+	*x = PostAlertRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostAlertRequest) ProtoMessage() {}
+
+func (x *PostAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostAlertRequest.ProtoReflect.Descriptor instead.
+func (*PostAlertRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PostAlertRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PostAlertRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PostAlertRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+func (x *PostAlertRequest) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *PostAlertRequest) GetPriority() AlertPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return AlertPriority_INFO
+}
+
+func (x *PostAlertRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PostAlertRequest) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+type PostAlertResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *PostAlertResponse) Reset() {
+	*x = PostAlertResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostAlertResponse) ProtoMessage() {}
+
+func (x *PostAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostAlertResponse.ProtoReflect.Descriptor instead.
+func (*PostAlertResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *PostAlertResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type PostTakeoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Clear      bool   `protobuf:"varint,2,opt,name=clear,proto3" json:"clear,omitempty"`
+	Message    string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	TtlSeconds int32  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+}
+
+func (x *PostTakeoverRequest) Reset() {
+	*x = PostTakeoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostTakeoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostTakeoverRequest) ProtoMessage() {}
+
+func (x *PostTakeoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostTakeoverRequest.ProtoReflect.Descriptor instead.
+func (*PostTakeoverRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PostTakeoverRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PostTakeoverRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+func (x *PostTakeoverRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PostTakeoverRequest) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type PostTakeoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *PostTakeoverResponse) Reset() {
+	*x = PostTakeoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostTakeoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostTakeoverResponse) ProtoMessage() {}
+
+func (x *PostTakeoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostTakeoverResponse.ProtoReflect.Descriptor instead.
+func (*PostTakeoverResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PostTakeoverResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// AcknowledgeRequest is sent by clients once they have rendered a takeover
+// or critical alert.
+type AcknowledgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlertId string `protobuf:"bytes,1,opt,name=alert_id,json=alertId,proto3" json:"alert_id,omitempty"` // empty for the takeover
+	Posted  int64  `protobuf:"varint,2,opt,name=posted,proto3" json:"posted,omitempty"`                 // the posted time of the message that was rendered
+	Display string `protobuf:"bytes,3,opt,name=display,proto3" json:"display,omitempty"`                // the name of the display in registered_displays
+}
+
+func (x *AcknowledgeRequest) Reset() {
+	*x = AcknowledgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeRequest) ProtoMessage() {}
+
+func (x *AcknowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AcknowledgeRequest) GetAlertId() string {
+	if x != nil {
+		return x.AlertId
+	}
+	return ""
+}
+
+func (x *AcknowledgeRequest) GetPosted() int64 {
+	if x != nil {
+		return x.Posted
+	}
+	return 0
+}
+
+func (x *AcknowledgeRequest) GetDisplay() string {
+	if x != nil {
+		return x.Display
+	}
+	return ""
+}
+
+type AcknowledgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *AcknowledgeResponse) Reset() {
+	*x = AcknowledgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcknowledgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeResponse) ProtoMessage() {}
+
+func (x *AcknowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AcknowledgeResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// BuildInfo identifies the build a server is running and how long it has
+// been running.
+type BuildInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version       string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit        string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	BuildDate     string `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	GoVersion     string `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	StartedAt     int64  `protobuf:"varint,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UptimeSeconds int64  `protobuf:"varint,6,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *BuildInfo) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+type ServerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildInfo *BuildInfo `protobuf:"bytes,1,opt,name=build_info,json=buildInfo,proto3" json:"build_info,omitempty"`
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ServerInfo) GetBuildInfo() *BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+// WindHistoryRequest asks for the surface winds reported over a day.
+type WindHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date    string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`       // local date, YYYY-MM-DD; today if empty
+	Station string `protobuf:"bytes,2,opt,name=station,proto3" json:"station,omitempty"` // the most preferred station if empty
+}
+
+func (x *WindHistoryRequest) Reset() {
+	*x = WindHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindHistoryRequest) ProtoMessage() {}
+
+func (x *WindHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindHistoryRequest.ProtoReflect.Descriptor instead.
+func (*WindHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *WindHistoryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *WindHistoryRequest) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+type WindHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Station      string             `protobuf:"bytes,1,opt,name=station,proto3" json:"station,omitempty"`
+	Observations []*WindObservation `protobuf:"bytes,2,rep,name=observations,proto3" json:"observations,omitempty"` // oldest first
+	ErrorMessage string             `protobuf:"bytes,3,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *WindHistory) Reset() {
+	*x = WindHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindHistory) ProtoMessage() {}
+
+func (x *WindHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindHistory.ProtoReflect.Descriptor instead.
+func (*WindHistory) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *WindHistory) GetStation() string {
+	if x != nil {
+		return x.Station
+	}
+	return ""
+}
+
+func (x *WindHistory) GetObservations() []*WindObservation {
+	if x != nil {
+		return x.Observations
+	}
+	return nil
+}
+
+func (x *WindHistory) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type WindObservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time      int64   `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"` // Unix time observed
+	SpeedKt   float32 `protobuf:"fixed32,2,opt,name=speed_kt,json=speedKt,proto3" json:"speed_kt,omitempty"`
+	GustKt    float32 `protobuf:"fixed32,3,opt,name=gust_kt,json=gustKt,proto3" json:"gust_kt,omitempty"` // 0 if no gust was reported
+	Direction int32   `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`          // degrees, true or magnetic per settings; 0 if variable or calm
+	Variable  bool    `protobuf:"varint,5,opt,name=variable,proto3" json:"variable,omitempty"`
+}
+
+func (x *WindObservation) Reset() {
+	*x = WindObservation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WindObservation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindObservation) ProtoMessage() {}
+
+func (x *WindObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindObservation.ProtoReflect.Descriptor instead.
+func (*WindObservation) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WindObservation) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *WindObservation) GetSpeedKt() float32 {
+	if x != nil {
+		return x.SpeedKt
+	}
+	return 0
+}
+
+func (x *WindObservation) GetGustKt() float32 {
+	if x != nil {
+		return x.GustKt
+	}
+	return 0
+}
+
+func (x *WindObservation) GetDirection() int32 {
+	if x != nil {
+		return x.Direction
+	}
+	return 0
+}
+
+func (x *WindObservation) GetVariable() bool {
+	if x != nil {
+		return x.Variable
+	}
+	return false
+}
+
+// JumprunHistoryRequest asks for the jumpruns that have been set, so that
+// one entered by mistake can be undone. Pilots and admins may ask.
+type JumprunHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *JumprunHistoryRequest) Reset() {
+	*x = JumprunHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumprunHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumprunHistoryRequest) ProtoMessage() {}
+
+func (x *JumprunHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumprunHistoryRequest.ProtoReflect.Descriptor instead.
+func (*JumprunHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *JumprunHistoryRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type JumprunHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries      []*JumprunHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"` // newest first
+	ErrorMessage string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *JumprunHistory) Reset() {
+	*x = JumprunHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumprunHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumprunHistory) ProtoMessage() {}
+
+func (x *JumprunHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumprunHistory.ProtoReflect.Descriptor instead.
+func (*JumprunHistory) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *JumprunHistory) GetEntries() []*JumprunHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *JumprunHistory) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// JumprunHistoryEntry is a jumprun as it was set. Headings are relative to
+// magnetic north and distances are in tenths of a mile, as entered.
+type JumprunHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time           int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"` // Unix time set
+	Heading        int32  `protobuf:"varint,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	ExitDistance   int32  `protobuf:"varint,4,opt,name=exit_distance,json=exitDistance,proto3" json:"exit_distance,omitempty"`
+	OffsetHeading  int32  `protobuf:"varint,5,opt,name=offset_heading,json=offsetHeading,proto3" json:"offset_heading,omitempty"`
+	OffsetDistance int32  `protobuf:"varint,6,opt,name=offset_distance,json=offsetDistance,proto3" json:"offset_distance,omitempty"`
+	Latitude       string `protobuf:"bytes,7,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude      string `protobuf:"bytes,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *JumprunHistoryEntry) Reset() {
+	*x = JumprunHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumprunHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumprunHistoryEntry) ProtoMessage() {}
+
+func (x *JumprunHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumprunHistoryEntry.ProtoReflect.Descriptor instead.
+func (*JumprunHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *JumprunHistoryEntry) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *JumprunHistoryEntry) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *JumprunHistoryEntry) GetHeading() int32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *JumprunHistoryEntry) GetExitDistance() int32 {
+	if x != nil {
+		return x.ExitDistance
+	}
+	return 0
+}
+
+func (x *JumprunHistoryEntry) GetOffsetHeading() int32 {
+	if x != nil {
+		return x.OffsetHeading
+	}
+	return 0
+}
+
+func (x *JumprunHistoryEntry) GetOffsetDistance() int32 {
+	if x != nil {
+		return x.OffsetDistance
+	}
+	return 0
+}
+
+func (x *JumprunHistoryEntry) GetLatitude() string {
+	if x != nil {
+		return x.Latitude
+	}
+	return ""
+}
+
+func (x *JumprunHistoryEntry) GetLongitude() string {
+	if x != nil {
+		return x.Longitude
+	}
+	return ""
+}
+
+type RestoreJumprunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Id        int64  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"` // of the history entry to restore
+}
+
+func (x *RestoreJumprunRequest) Reset() {
+	*x = RestoreJumprunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreJumprunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJumprunRequest) ProtoMessage() {}
+
+func (x *RestoreJumprunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJumprunRequest.ProtoReflect.Descriptor instead.
+func (*RestoreJumprunRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RestoreJumprunRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RestoreJumprunRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreJumprunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *RestoreJumprunResponse) Reset() {
+	*x = RestoreJumprunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreJumprunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJumprunResponse) ProtoMessage() {}
+
+func (x *RestoreJumprunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJumprunResponse.ProtoReflect.Descriptor instead.
+func (*RestoreJumprunResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RestoreJumprunResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// SetJumprunRequest sets the jumprun as if it had been entered in the form.
+// Pilots and admins may set it. Headings are relative to magnetic north and
+// distances are in tenths of a mile. The origin, magnetic declination, and
+// camera height are those of the current jumprun unless they're given.
+type SetJumprunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId           string         `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Heading             int32          `protobuf:"varint,2,opt,name=heading,proto3" json:"heading,omitempty"`
+	ExitDistance        int32          `protobuf:"varint,3,opt,name=exit_distance,json=exitDistance,proto3" json:"exit_distance,omitempty"`
+	OffsetHeading       int32          `protobuf:"varint,4,opt,name=offset_heading,json=offsetHeading,proto3" json:"offset_heading,omitempty"`
+	OffsetDistance      int32          `protobuf:"varint,5,opt,name=offset_distance,json=offsetDistance,proto3" json:"offset_distance,omitempty"`
+	HookTurns           []*JumprunTurn `protobuf:"bytes,6,rep,name=hook_turns,json=hookTurns,proto3" json:"hook_turns,omitempty"` // up to 4; only distance and heading are used
+	Latitude            *string        `protobuf:"bytes,7,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude           *string        `protobuf:"bytes,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	MagneticDeclination *int32         `protobuf:"varint,9,opt,name=magnetic_declination,json=magneticDeclination,proto3,oneof" json:"magnetic_declination,omitempty"`
+	CameraHeight        *int32         `protobuf:"varint,10,opt,name=camera_height,json=cameraHeight,proto3,oneof" json:"camera_height,omitempty"`
+}
+
+func (x *SetJumprunRequest) Reset() {
+	*x = SetJumprunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetJumprunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetJumprunRequest) ProtoMessage() {}
+
+func (x *SetJumprunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetJumprunRequest.ProtoReflect.Descriptor instead.
+func (*SetJumprunRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SetJumprunRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetJumprunRequest) GetHeading() int32 {
+	if x != nil {
+		return x.Heading
+	}
+	return 0
+}
+
+func (x *SetJumprunRequest) GetExitDistance() int32 {
+	if x != nil {
+		return x.ExitDistance
+	}
+	return 0
+}
+
+func (x *SetJumprunRequest) GetOffsetHeading() int32 {
+	if x != nil {
+		return x.OffsetHeading
+	}
+	return 0
+}
+
+func (x *SetJumprunRequest) GetOffsetDistance() int32 {
+	if x != nil {
+		return x.OffsetDistance
+	}
+	return 0
+}
+
+func (x *SetJumprunRequest) GetHookTurns() []*JumprunTurn {
+	if x != nil {
+		return x.HookTurns
+	}
+	return nil
+}
+
+func (x *SetJumprunRequest) GetLatitude() string {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return ""
+}
+
+func (x *SetJumprunRequest) GetLongitude() string {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return ""
+}
+
+func (x *SetJumprunRequest) GetMagneticDeclination() int32 {
+	if x != nil && x.MagneticDeclination != nil {
+		return *x.MagneticDeclination
+	}
+	return 0
+}
+
+func (x *SetJumprunRequest) GetCameraHeight() int32 {
+	if x != nil && x.CameraHeight != nil {
+		return *x.CameraHeight
+	}
+	return 0
+}
+
+type SetJumprunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string   `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Warnings     []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"` // if the jumprun strays outside of the boundary
+}
+
+func (x *SetJumprunResponse) Reset() {
+	*x = SetJumprunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetJumprunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetJumprunResponse) ProtoMessage() {}
+
+func (x *SetJumprunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetJumprunResponse.ProtoReflect.Descriptor instead.
+func (*SetJumprunResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SetJumprunResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *SetJumprunResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type ClearJumprunRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *ClearJumprunRequest) Reset() {
+	*x = ClearJumprunRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearJumprunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearJumprunRequest) ProtoMessage() {}
+
+func (x *ClearJumprunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearJumprunRequest.ProtoReflect.Descriptor instead.
+func (*ClearJumprunRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ClearJumprunRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type ClearJumprunResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+}
+
+func (x *ClearJumprunResponse) Reset() {
+	*x = ClearJumprunResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearJumprunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearJumprunResponse) ProtoMessage() {}
+
+func (x *ClearJumprunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearJumprunResponse.ProtoReflect.Descriptor instead.
+func (*ClearJumprunResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ClearJumprunResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type ResyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *ResyncRequest) Reset() {
+	*x = ResyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncRequest) ProtoMessage() {}
+
+func (x *ResyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncRequest.ProtoReflect.Descriptor instead.
+func (*ResyncRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ResyncRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EchoTraceRequest is sent by clients once they have displayed an update,
+// echoing the update's trace.
+type EchoTraceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trace *Trace `protobuf:"bytes,1,opt,name=trace,proto3" json:"trace,omitempty"`
+}
+
+func (x *EchoTraceRequest) Reset() {
+	*x = EchoTraceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EchoTraceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EchoTraceRequest) ProtoMessage() {}
+
+func (x *EchoTraceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EchoTraceRequest.ProtoReflect.Descriptor instead.
+func (*EchoTraceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *EchoTraceRequest) GetTrace() *Trace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Events with sequence numbers after this one are sent, followed by new
+	// events as they happen. Clients resume by passing the last sequence
+	// number that they received.
+	AfterSequence int64 `protobuf:"varint,1,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *StreamEventsRequest) GetAfterSequence() int64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+type ManifestEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence     int64  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Time         int64  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	LoadId       uint64 `protobuf:"varint,4,opt,name=load_id,json=loadId,proto3" json:"load_id,omitempty"`
+	AircraftName string `protobuf:"bytes,5,opt,name=aircraft_name,json=aircraftName,proto3" json:"aircraft_name,omitempty"`
+	LoadNumber   string `protobuf:"bytes,6,opt,name=load_number,json=loadNumber,proto3" json:"load_number,omitempty"`
+	CallMinutes  int32  `protobuf:"varint,7,opt,name=call_minutes,json=callMinutes,proto3" json:"call_minutes,omitempty"`
+	JumperId     string `protobuf:"bytes,8,opt,name=jumper_id,json=jumperId,proto3" json:"jumper_id,omitempty"`
+	JumperName   string `protobuf:"bytes,9,opt,name=jumper_name,json=jumperName,proto3" json:"jumper_name,omitempty"`
+	JumpType     string `protobuf:"bytes,10,opt,name=jump_type,json=jumpType,proto3" json:"jump_type,omitempty"`
+}
+
+func (x *ManifestEvent) Reset() {
+	*x = ManifestEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_server_service_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestEvent) ProtoMessage() {}
+
+func (x *ManifestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_server_service_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestEvent.ProtoReflect.Descriptor instead.
+func (*ManifestEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_server_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ManifestEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ManifestEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ManifestEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ManifestEvent) GetLoadId() uint64 {
+	if x != nil {
+		return x.LoadId
+	}
+	return 0
+}
+
+func (x *ManifestEvent) GetAircraftName() string {
+	if x != nil {
+		return x.AircraftName
+	}
+	return ""
+}
+
+func (x *ManifestEvent) GetLoadNumber() string {
+	if x != nil {
+		return x.LoadNumber
+	}
+	return ""
+}
+
+func (x *ManifestEvent) GetCallMinutes() int32 {
+	if x != nil {
+		return x.CallMinutes
+	}
+	return 0
+}
+
+func (x *ManifestEvent) GetJumperId() string {
+	if x != nil {
+		return x.JumperId
+	}
+	return ""
+}
+
+func (x *ManifestEvent) GetJumperName() string {
+	if x != nil {
+		return x.JumperName
+	}
+	return ""
+}
+
+func (x *ManifestEvent) GetJumpType() string {
+	if x != nil {
+		return x.JumpType
+	}
+	return ""
+}
+
+var File_pkg_server_service_proto protoreflect.FileDescriptor
+
+var file_pkg_server_service_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x53, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x65, 0x64, 0x57, 0x69,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x75, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x67, 0x75, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x67, 0x75,
+	0x73, 0x74, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x67, 0x75, 0x73, 0x74, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xca, 0x10, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64,
+	0x73, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28,
+	0x0a, 0x0f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x65,
+	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68,
+	0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x65,
+	0x64, 0x57, 0x69, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x6d, 0x6f, 0x6f, 0x74, 0x68, 0x65,
+	0x64, 0x57, 0x69, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x69, 0x6e, 0x64,
+	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x75, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x77, 0x69, 0x6e, 0x64,
+	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x67, 0x6e, 0x65,
+	0x74, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x77, 0x69, 0x6e, 0x64, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x52, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x61, 0x6e, 0x6f, 0x70,
+	0x79, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79, 0x41, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x61, 0x6b, 0x5f, 0x67, 0x75, 0x73, 0x74, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x61, 0x6b, 0x47, 0x75, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x65, 0x74, 0x61, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x5f, 0x61, 0x6c,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x79, 0x41, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x77, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x77, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x75, 0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x48, 0x75,
+	0x6d, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x74, 0x69, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x68, 0x67, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x61,
+	0x6c, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x48, 0x67, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x68, 0x70, 0x61, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0c, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x48, 0x70,
+	0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f,
+	0x73, 0x6d, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x53, 0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x32, 0x0a, 0x15, 0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13,
+	0x66, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x5f,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x54, 0x72, 0x65, 0x6e, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12,
+	0x2b, 0x0a, 0x11, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74,
+	0x72, 0x65, 0x6e, 0x64, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x72, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
+	0x65, 0x6e, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x23, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x53, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x70, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x24, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x61, 0x70, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x6e, 0x64, 0x65,
+	0x6d, 0x73, 0x5f, 0x6f, 0x6b, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x61, 0x6e,
+	0x64, 0x65, 0x6d, 0x73, 0x4f, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x75, 0x64, 0x65, 0x6e,
+	0x74, 0x73, 0x5f, 0x6f, 0x6b, 0x18, 0x26, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x74, 0x75,
+	0x64, 0x65, 0x6e, 0x74, 0x73, 0x4f, 0x6b, 0x12, 0x3c, 0x0a, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x01, 0x52, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x5f, 0x64, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x69,
+	0x6e, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2f, 0x0a, 0x11, 0x66, 0x72, 0x65, 0x65, 0x7a,
+	0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x66, 0x74, 0x18, 0x2a, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x02, 0x52, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x69, 0x6e, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x46, 0x74, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x69, 0x74,
+	0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x2b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x65, 0x78, 0x69, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x31, 0x0a, 0x12, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x03, 0x52, 0x10, 0x65, 0x78, 0x69, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x43, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18,
+	0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x44, 0x72, 0x69, 0x66, 0x74, 0x48, 0x04, 0x52, 0x05, 0x64, 0x72, 0x69, 0x66, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79,
+	0x57, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6b, 0x74, 0x18, 0x2f, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x05, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79, 0x57, 0x69, 0x6e,
+	0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x74, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x63,
+	0x61, 0x6e, 0x6f, 0x70, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x30, 0x20, 0x01, 0x28, 0x05, 0x48, 0x06, 0x52, 0x13, 0x63, 0x61,
+	0x6e, 0x6f, 0x70, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x73, 0x5f, 0x61, 0x64, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x18, 0x31, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x68, 0x69, 0x67, 0x68, 0x55, 0x70, 0x70, 0x65, 0x72, 0x73, 0x41, 0x64,
+	0x76, 0x69, 0x73, 0x6f, 0x72, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x6d, 0x6f, 0x6f, 0x74,
+	0x68, 0x65, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x66, 0x72, 0x65,
+	0x65, 0x7a, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x66, 0x74, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x63, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x42,
+	0x17, 0x0a, 0x15, 0x5f, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6b, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x63, 0x61, 0x6e,
+	0x6f, 0x70, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xe9, 0x03, 0x0a, 0x0b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x5f, 0x6b, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x77, 0x69, 0x6e,
+	0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x77,
+	0x69, 0x6e, 0x64, 0x5f, 0x67, 0x75, 0x73, 0x74, 0x5f, 0x6b, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x01, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x47, 0x75, 0x73, 0x74, 0x4b, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x31, 0x0a, 0x12, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02,
+	0x52, 0x10, 0x77, 0x69, 0x6e, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x67, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x0c,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x88, 0x01, 0x01, 0x12,
+	0x22, 0x0a, 0x0a, 0x64, 0x65, 0x77, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x09, 0x64, 0x65, 0x77, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x43,
+	0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x09, 0x63, 0x65, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x46, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x48, 0x06,
+	0x52, 0x0c, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x61, 0x6c, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x5f, 0x68, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x48, 0x07, 0x52, 0x0d, 0x61, 0x6c,
+	0x74, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x49, 0x6e, 0x48, 0x67, 0x88, 0x01, 0x01, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6b, 0x74,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x67, 0x75, 0x73, 0x74, 0x5f, 0x6b,
+	0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65, 0x67, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x64,
+	0x65, 0x77, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x63, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x65,
+	0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x6d, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61,
+	0x6c, 0x74, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x68, 0x67, 0x22, 0xe2,
+	0x01, 0x0a, 0x12, 0x41, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x53, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6b, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0d, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x41, 0x6c, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x22, 0x80, 0x03, 0x0a, 0x05, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x66, 0x72, 0x65, 0x65, 0x66, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x66, 0x61, 0x6c, 0x6c, 0x46, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x66, 0x72, 0x65, 0x65, 0x66, 0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x65, 0x61, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x66, 0x61,
+	0x6c, 0x6c, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x6e,
+	0x6f, 0x70, 0x79, 0x5f, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x63, 0x61,
+	0x6e, 0x6f, 0x70, 0x79, 0x46, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79,
+	0x5f, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x19, 0x0a,
+	0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x07, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x62, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a,
+	0x10, 0x66, 0x72, 0x65, 0x65, 0x66, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x66, 0x72, 0x65, 0x65, 0x66, 0x61, 0x6c,
+	0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6e, 0x6f,
+	0x70, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0d, 0x63, 0x61, 0x6e, 0x6f, 0x70, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f,
+	0x6b, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x64, 0x69, 0x6d, 0x5f, 0x61,
+	0x74, 0x5f, 0x64, 0x75, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x69,
+	0x6d, 0x41, 0x74, 0x44, 0x75, 0x73, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x6d, 0x5f, 0x62,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x64, 0x69, 0x6d, 0x42, 0x72, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x64, 0x69, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x64, 0x69, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x69, 0x6d, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x64, 0x69,
+	0x6d, 0x45, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x65,
+	0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x45,
+	0x6e, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xa2, 0x04, 0x0a, 0x07, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x77, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x6e, 0x73,
+	0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x47, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x10, 0x68,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x3b, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74,
+	0x61, 0x6e, 0x64, 0x62, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72,
+	0x5f, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x65, 0x61,
+	0x74, 0x68, 0x65, 0x72, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x74,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc,
+	0x01, 0x0a, 0x0d, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x61,
+	0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63,
+	0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x6d,
+	0x65, 0x72, 0x61, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x65, 0x77, 0x57, 0x69, 0x64, 0x74, 0x68, 0x22, 0x91, 0x01,
+	0x0a, 0x0b, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x65, 0x48,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74,
+	0x69, 0x63, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x22, 0x64, 0x0a, 0x0c, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x79, 0x22, 0xa5, 0x05, 0x0a, 0x0b, 0x4a, 0x75, 0x6d, 0x70,
+	0x72, 0x75, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a,
+	0x0f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x05, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x50, 0x6f, 0x69, 0x6e,
+	0x74, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x65, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x67,
+	0x6e, 0x65, 0x74, 0x69, 0x63, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x54, 0x72, 0x75, 0x65, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x48, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69,
+	0x6e, 0x64, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x54, 0x72, 0x75, 0x65, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x3c,
+	0x0a, 0x1a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x67, 0x6e,
+	0x65, 0x74, 0x69, 0x63, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x18, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4d, 0x61, 0x67,
+	0x6e, 0x65, 0x74, 0x69, 0x63, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c,
+	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x22,
+	0xf2, 0x01, 0x0a, 0x0b, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x53, 0x70, 0x6f, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x66, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72,
+	0x75, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x74, 0x72, 0x75, 0x65, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a,
+	0x10, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69,
+	0x63, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xac, 0x01, 0x0a, 0x07, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e,
+	0x12, 0x2f, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70,
+	0x72, 0x75, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72,
+	0x75, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x70, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72,
+	0x75, 0x6e, 0x53, 0x70, 0x6f, 0x74, 0x48, 0x01, 0x52, 0x04, 0x73, 0x70, 0x6f, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73,
+	0x70, 0x6f, 0x74, 0x22, 0x9e, 0x05, 0x0a, 0x10, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f,
+	0x66, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x6c, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69,
+	0x63, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0f, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x35, 0x0a, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x66, 0x61, 0x68, 0x72, 0x65, 0x6e, 0x68, 0x65, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x15, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x46, 0x61, 0x68,
+	0x72, 0x65, 0x6e, 0x68, 0x65, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x6c, 0x73,
+	0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x65, 0x65,
+	0x6c, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x5f,
+	0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x66, 0x61, 0x68, 0x72, 0x65, 0x6e, 0x68, 0x65, 0x69, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x66, 0x65, 0x65, 0x6c, 0x73, 0x4c, 0x69, 0x6b, 0x65,
+	0x46, 0x61, 0x68, 0x72, 0x65, 0x6e, 0x68, 0x65, 0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x68, 0x65, 0x61, 0x72, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x68,
+	0x65, 0x61, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x68, 0x65,
+	0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x65, 0x64, 0x53,
+	0x68, 0x65, 0x61, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x65, 0x61, 0x72, 0x5f, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x68, 0x65,
+	0x61, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x70, 0x65, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x10,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x72, 0x65, 0x6e, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x5f, 0x6d, 0x73,
+	0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x4d, 0x73, 0x6c, 0x22, 0xe0, 0x02, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c,
+	0x6f, 0x66, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x65, 0x61, 0x72,
+	0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x68, 0x65, 0x61, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x61, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x41, 0x6c, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x3a, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e,
+	0x57, 0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x09, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x66, 0x65, 0x74, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64,
+	0x65, 0x67, 0x72, 0x61, 0x64, 0x65, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x12, 0x57, 0x69, 0x6e, 0x64,
+	0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x68, 0x6f,
+	0x75, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x68, 0x65, 0x61, 0x72,
+	0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x73, 0x68, 0x65, 0x61, 0x72, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x98, 0x02, 0x0a,
+	0x06, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69,
+	0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69,
+	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x6c, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x75, 0x6c, 0x6c, 0x41,
+	0x6c, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x98, 0x01, 0x0a, 0x0b, 0x4a, 0x75, 0x6d, 0x70,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75,
+	0x6d, 0x70, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x22, 0x6d, 0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x2a,
+	0x0a, 0x06, 0x6a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x06, 0x6a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x05, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x48, 0x00, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x22, 0x59, 0x0a, 0x0a, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x73, 0x70, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x53, 0x70, 0x61, 0x6e, 0x22, 0x9f, 0x01, 0x0a,
+	0x09, 0x45, 0x78, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x69, 0x73, 0x63, 0x69, 0x70, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x63, 0x69, 0x70, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12,
+	0x2d, 0x0a, 0x12, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xf2,
+	0x04, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x69, 0x72, 0x63, 0x72,
+	0x61, 0x66, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
+	0x61, 0x6c, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x6c, 0x6f, 0x74, 0x73,
+	0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x75, 0x65, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x54, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x6e, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x4e, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73,
+	0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05,
+	0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e,
+	0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x76, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x56, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6c, 0x65, 0x12,
+	0x32, 0x0a, 0x0a, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x78, 0x69, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x09, 0x65, 0x78, 0x69, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x11, 0x65, 0x78, 0x69, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x22, 0xa3, 0x01, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52,
+	0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6c, 0x6f, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a,
+	0x07, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x22, 0xc4, 0x01, 0x0a, 0x05, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x22, 0x31, 0x0a, 0x06, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x06, 0x61, 0x6c, 0x65,
+	0x72, 0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0xba, 0x01, 0x0a, 0x0a, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x75, 0x72, 0x62, 0x6c,
+	0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x62, 0x75, 0x72, 0x62, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x61, 0x72, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d,
+	0x65, 0x74, 0x61, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6a, 0x75, 0x6d, 0x70, 0x72,
+	0x75, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6e, 0x0a, 0x08, 0x54, 0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x22, 0x49, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x39, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x05,
+	0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x65, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x66, 0x65, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x73, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x74, 0x41, 0x74, 0x4d, 0x73, 0x22, 0xbf, 0x05, 0x0a, 0x0e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x01, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x6a, 0x75, 0x6d,
+	0x70, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x48, 0x02, 0x52,
+	0x07, 0x6a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x6c, 0x6f, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x48, 0x03, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x41,
+	0x6c, 0x6f, 0x66, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x73, 0x48, 0x04, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x73, 0x48, 0x05, 0x52, 0x06, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x37, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x63, 0x68, 0x69, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x73, 0x48, 0x06, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x61, 0x6b, 0x65, 0x6f, 0x76,
+	0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65, 0x72, 0x48, 0x07, 0x52, 0x08,
+	0x74, 0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x08, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x48, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x48, 0x09, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x6c, 0x6f, 0x66, 0x74, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x61, 0x6c,
+	0x65, 0x72, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x73, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65, 0x72,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x22, 0xe1, 0x01, 0x0a, 0x16, 0x53, 0x69, 0x67, 0x6e,
+	0x49, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x12,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x69, 0x76, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x61,
+	0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x0e,
+	0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a,
+	0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x0e, 0x53,
+	0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x0f,
+	0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x35,
+	0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46,
+	0x75, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x42, 0x0a, 0x1b, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x65, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3c, 0x0a,
+	0x15, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9a, 0x03, 0x0a, 0x16,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x22, 0x0a, 0x0a, 0x77,
+	0x69, 0x6e, 0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x00, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x53, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x67, 0x75, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x01, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x64, 0x47, 0x75, 0x73, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x0d, 0x77, 0x69, 0x6e,
+	0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a,
+	0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x03, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6b, 0x79, 0x5f, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x6b, 0x79, 0x43, 0x6f, 0x76, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x6c, 0x6f, 0x66, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x0a, 0x77, 0x69,
+	0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x5f, 0x67, 0x75, 0x73, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x65, 0x6d,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x3e, 0x0a, 0x17, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd6, 0x01, 0x0a, 0x10, 0x50, 0x6f, 0x73,
+	0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x50, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x22, 0x38, 0x0a, 0x11, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x13,
+	0x50, 0x6f, 0x73, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x74, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x74, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x3b, 0x0a, 0x14, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x6f,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x61, 0x0a, 0x12, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x22, 0x3a, 0x0a, 0x13, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xc1, 0x01, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x32, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x42, 0x0a, 0x12, 0x57, 0x69, 0x6e, 0x64, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x0b, 0x57, 0x69,
+	0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x57, 0x69, 0x6e, 0x64,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x73, 0x70, 0x65, 0x65, 0x64, 0x5f, 0x6b, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x07, 0x73, 0x70, 0x65, 0x65, 0x64, 0x4b, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x75,
+	0x73, 0x74, 0x5f, 0x6b, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x67, 0x75, 0x73,
+	0x74, 0x4b, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x36, 0x0a,
+	0x15, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x6e, 0x0a, 0x0e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x13, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x78, 0x69, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x46, 0x0a, 0x15, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x3d, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x75, 0x6d,
+	0x70, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0xe3, 0x03, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x74, 0x75,
+	0x72, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x54, 0x75, 0x72, 0x6e,
+	0x52, 0x09, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x08, 0x6c,
+	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x36, 0x0a, 0x14, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52,
+	0x13, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x44, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x61, 0x6d, 0x65, 0x72,
+	0x61, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03,
+	0x52, 0x0c, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x17, 0x0a, 0x15,
+	0x5f, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x55, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4a, 0x75,
+	0x6d, 0x70, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x34,
+	0x0a, 0x13, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x14, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4a, 0x75, 0x6d,
+	0x70, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x27, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x10, 0x45, 0x63,
+	0x68, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x05,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0xb0, 0x02, 0x0a, 0x0d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x69, 0x72, 0x63, 0x72,
+	0x61, 0x66, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f,
+	0x61, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c,
+	0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x63, 0x61, 0x6c, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6a,
+	0x75, 0x6d, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x75, 0x6d, 0x70,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6a,
+	0x75, 0x6d, 0x70, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6a, 0x75, 0x6d,
+	0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6a, 0x75,
+	0x6d, 0x70, 0x54, 0x79, 0x70, 0x65, 0x2a, 0x2a, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41,
+	0x47, 0x4e, 0x45, 0x54, 0x49, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x52, 0x55, 0x45,
+	0x10, 0x01, 0x2a, 0x9d, 0x01, 0x0a, 0x0a, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x45, 0x4e, 0x43, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x46, 0x46, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e,
+	0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x55,
+	0x44, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x41, 0x4e, 0x44, 0x45, 0x4d,
+	0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x46,
+	0x46, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x09,
+	0x0a, 0x05, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x4e,
+	0x44, 0x45, 0x4d, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x06,
+	0x12, 0x10, 0x0a, 0x0c, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x45, 0x52,
+	0x10, 0x07, 0x2a, 0x60, 0x0a, 0x09, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x43, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x42, 0x4f,
+	0x41, 0x52, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x49, 0x52, 0x42,
+	0x4f, 0x52, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x4a, 0x55, 0x4d, 0x50, 0x45, 0x52,
+	0x53, 0x5f, 0x41, 0x57, 0x41, 0x59, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x41, 0x4e, 0x44,
+	0x45, 0x44, 0x10, 0x05, 0x2a, 0x34, 0x0a, 0x0d, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x50, 0x72, 0x69,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x02, 0x32, 0xad, 0x0b, 0x0a, 0x0f, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x43,
+	0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x57, 0x69, 0x74,
+	0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x18, 0x2e,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x13, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x57, 0x65, 0x61, 0x74,
+	0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x57, 0x65,
+	0x61, 0x74, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
+	0x09, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x17, 0x2e,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x4d, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65, 0x72,
+	0x12, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x54, 0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x54,
+	0x61, 0x6b, 0x65, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x48, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x3f, 0x0a, 0x09, 0x45, 0x63, 0x68,
+	0x6f, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x63, 0x68, 0x6f, 0x54, 0x72, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4a, 0x0a, 0x0b, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x2e, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x57, 0x69, 0x6e, 0x64,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x4f, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75,
+	0x6d, 0x70, 0x72, 0x75, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a,
+	0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x53, 0x0a,
+	0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x12,
+	0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e,
+	0x12, 0x1b, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4a,
+	0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4a, 0x75, 0x6d, 0x70,
+	0x72, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x12, 0x1d, 0x2e, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4a, 0x75, 0x6d, 0x70,
+	0x72, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x61, 0x6e,
+	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x4a, 0x75, 0x6d, 0x70, 0x72,
+	0x75, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x75, 0x6d, 0x70, 0x74, 0x6f, 0x77,
+	0x6e, 0x2d, 0x73, 0x6b, 0x79, 0x64, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_server_service_proto_rawDescOnce sync.Once
+	file_pkg_server_service_proto_rawDescData = file_pkg_server_service_proto_rawDesc
+)
+
+func file_pkg_server_service_proto_rawDescGZIP() []byte {
+	file_pkg_server_service_proto_rawDescOnce.Do(func() {
+		file_pkg_server_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_server_service_proto_rawDescData)
+	})
+	return file_pkg_server_service_proto_rawDescData
+}
+
+var file_pkg_server_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_pkg_server_service_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_pkg_server_service_proto_goTypes = []interface{}{
+	(HeadingReference)(0),               // 0: manifest.HeadingReference
+	(JumperType)(0),                     // 1: manifest.JumperType
+	(CallState)(0),                      // 2: manifest.CallState
+	(AlertPriority)(0),                  // 3: manifest.AlertPriority
+	(*SmoothedWind)(nil),                // 4: manifest.SmoothedWind
+	(*Status)(nil),                      // 5: manifest.Status
+	(*Observation)(nil),                 // 6: manifest.Observation
+	(*AircraftSeparation)(nil),          // 7: manifest.AircraftSeparation
+	(*Drift)(nil),                       // 8: manifest.Drift
+	(*DisplayHints)(nil),                // 9: manifest.DisplayHints
+	(*Options)(nil),                     // 10: manifest.Options
+	(*JumprunOrigin)(nil),               // 11: manifest.JumprunOrigin
+	(*JumprunTurn)(nil),                 // 12: manifest.JumprunTurn
+	(*JumprunPoint)(nil),                // 13: manifest.JumprunPoint
+	(*JumprunPath)(nil),                 // 14: manifest.JumprunPath
+	(*JumprunSpot)(nil),                 // 15: manifest.JumprunSpot
+	(*Jumprun)(nil),                     // 16: manifest.Jumprun
+	(*WindsAloftSample)(nil),            // 17: manifest.WindsAloftSample
+	(*WindsAloft)(nil),                  // 18: manifest.WindsAloft
+	(*WindsAloftForecast)(nil),          // 19: manifest.WindsAloftForecast
+	(*Jumper)(nil),                      // 20: manifest.Jumper
+	(*JumperGroup)(nil),                 // 21: manifest.JumperGroup
+	(*LoadSlot)(nil),                    // 22: manifest.LoadSlot
+	(*LayoutHint)(nil),                  // 23: manifest.LayoutHint
+	(*ExitGroup)(nil),                   // 24: manifest.ExitGroup
+	(*Load)(nil),                        // 25: manifest.Load
+	(*Loads)(nil),                       // 26: manifest.Loads
+	(*Alert)(nil),                       // 27: manifest.Alert
+	(*Alerts)(nil),                      // 28: manifest.Alerts
+	(*ChimeEvent)(nil),                  // 29: manifest.ChimeEvent
+	(*Timestamps)(nil),                  // 30: manifest.Timestamps
+	(*Takeover)(nil),                    // 31: manifest.Takeover
+	(*Counter)(nil),                     // 32: manifest.Counter
+	(*Counters)(nil),                    // 33: manifest.Counters
+	(*Trace)(nil),                       // 34: manifest.Trace
+	(*ManifestUpdate)(nil),              // 35: manifest.ManifestUpdate
+	(*SignInWithAppleRequest)(nil),      // 36: manifest.SignInWithAppleRequest
+	(*SignInResponse)(nil),              // 37: manifest.SignInResponse
+	(*SignOutRequest)(nil),              // 38: manifest.SignOutRequest
+	(*SignOutResponse)(nil),             // 39: manifest.SignOutResponse
+	(*VerifySessionRequest)(nil),        // 40: manifest.VerifySessionRequest
+	(*ToggleFuelRequestedRequest)(nil),  // 41: manifest.ToggleFuelRequestedRequest
+	(*ToggleFuelRequestedResponse)(nil), // 42: manifest.ToggleFuelRequestedResponse
+	(*RestartServerRequest)(nil),        // 43: manifest.RestartServerRequest
+	(*RestartServerResponse)(nil),       // 44: manifest.RestartServerResponse
+	(*SimulateWeatherRequest)(nil),      // 45: manifest.SimulateWeatherRequest
+	(*SimulateWeatherResponse)(nil),     // 46: manifest.SimulateWeatherResponse
+	(*PostAlertRequest)(nil),            // 47: manifest.PostAlertRequest
+	(*PostAlertResponse)(nil),           // 48: manifest.PostAlertResponse
+	(*PostTakeoverRequest)(nil),         // 49: manifest.PostTakeoverRequest
+	(*PostTakeoverResponse)(nil),        // 50: manifest.PostTakeoverResponse
+	(*AcknowledgeRequest)(nil),          // 51: manifest.AcknowledgeRequest
+	(*AcknowledgeResponse)(nil),         // 52: manifest.AcknowledgeResponse
+	(*BuildInfo)(nil),                   // 53: manifest.BuildInfo
+	(*ServerInfo)(nil),                  // 54: manifest.ServerInfo
+	(*WindHistoryRequest)(nil),          // 55: manifest.WindHistoryRequest
+	(*WindHistory)(nil),                 // 56: manifest.WindHistory
+	(*WindObservation)(nil),             // 57: manifest.WindObservation
+	(*JumprunHistoryRequest)(nil),       // 58: manifest.JumprunHistoryRequest
+	(*JumprunHistory)(nil),              // 59: manifest.JumprunHistory
+	(*JumprunHistoryEntry)(nil),         // 60: manifest.JumprunHistoryEntry
+	(*RestoreJumprunRequest)(nil),       // 61: manifest.RestoreJumprunRequest
+	(*RestoreJumprunResponse)(nil),      // 62: manifest.RestoreJumprunResponse
+	(*SetJumprunRequest)(nil),           // 63: manifest.SetJumprunRequest
+	(*SetJumprunResponse)(nil),          // 64: manifest.SetJumprunResponse
+	(*ClearJumprunRequest)(nil),         // 65: manifest.ClearJumprunRequest
+	(*ClearJumprunResponse)(nil),        // 66: manifest.ClearJumprunResponse
+	(*ResyncRequest)(nil),               // 67: manifest.ResyncRequest
+	(*EchoTraceRequest)(nil),            // 68: manifest.EchoTraceRequest
+	(*StreamEventsRequest)(nil),         // 69: manifest.StreamEventsRequest
+	(*ManifestEvent)(nil),               // 70: manifest.ManifestEvent
+	(*emptypb.Empty)(nil),               // 71: google.protobuf.Empty
+}
+var file_pkg_server_service_proto_depIdxs = []int32{
+	4,  // 0: manifest.Status.smoothed_wind:type_name -> manifest.SmoothedWind
+	7,  // 1: manifest.Status.separations:type_name -> manifest.AircraftSeparation
+	6,  // 2: manifest.Status.observation:type_name -> manifest.Observation
+	8,  // 3: manifest.Status.drift:type_name -> manifest.Drift
+	0,  // 4: manifest.Options.heading_reference:type_name -> manifest.HeadingReference
+	9,  // 5: manifest.Options.display_hints:type_name -> manifest.DisplayHints
+	12, // 6: manifest.JumprunPath.turns:type_name -> manifest.JumprunTurn
+	13, // 7: manifest.JumprunPath.ground_track:type_name -> manifest.JumprunPoint
+	11, // 8: manifest.Jumprun.origin:type_name -> manifest.JumprunOrigin
+	14, // 9: manifest.Jumprun.path:type_name -> manifest.JumprunPath
+	15, // 10: manifest.Jumprun.spot:type_name -> manifest.JumprunSpot
+	17, // 11: manifest.WindsAloft.samples:type_name -> manifest.WindsAloftSample
+	19, // 12: manifest.WindsAloft.forecasts:type_name -> manifest.WindsAloftForecast
+	17, // 13: manifest.WindsAloftForecast.samples:type_name -> manifest.WindsAloftSample
+	1,  // 14: manifest.Jumper.type:type_name -> manifest.JumperType
+	20, // 15: manifest.JumperGroup.leader:type_name -> manifest.Jumper
+	20, // 16: manifest.JumperGroup.members:type_name -> manifest.Jumper
+	20, // 17: manifest.LoadSlot.jumper:type_name -> manifest.Jumper
+	21, // 18: manifest.LoadSlot.group:type_name -> manifest.JumperGroup
+	22, // 19: manifest.Load.slots:type_name -> manifest.LoadSlot
+	2,  // 20: manifest.Load.state:type_name -> manifest.CallState
+	23, // 21: manifest.Load.layout:type_name -> manifest.LayoutHint
+	24, // 22: manifest.Load.exit_order:type_name -> manifest.ExitGroup
+	25, // 23: manifest.Loads.loads:type_name -> manifest.Load
+	20, // 24: manifest.Loads.standby:type_name -> manifest.Jumper
+	3,  // 25: manifest.Alert.priority:type_name -> manifest.AlertPriority
+	27, // 26: manifest.Alerts.alerts:type_name -> manifest.Alert
+	32, // 27: manifest.Counters.counters:type_name -> manifest.Counter
+	5,  // 28: manifest.ManifestUpdate.status:type_name -> manifest.Status
+	10, // 29: manifest.ManifestUpdate.options:type_name -> manifest.Options
+	16, // 30: manifest.ManifestUpdate.jumprun:type_name -> manifest.Jumprun
+	18, // 31: manifest.ManifestUpdate.winds_aloft:type_name -> manifest.WindsAloft
+	26, // 32: manifest.ManifestUpdate.loads:type_name -> manifest.Loads
+	28, // 33: manifest.ManifestUpdate.alerts:type_name -> manifest.Alerts
+	29, // 34: manifest.ManifestUpdate.chime_events:type_name -> manifest.ChimeEvent
+	30, // 35: manifest.ManifestUpdate.timestamps:type_name -> manifest.Timestamps
+	31, // 36: manifest.ManifestUpdate.takeover:type_name -> manifest.Takeover
+	33, // 37: manifest.ManifestUpdate.counters:type_name -> manifest.Counters
+	34, // 38: manifest.ManifestUpdate.trace:type_name -> manifest.Trace
+	17, // 39: manifest.SimulateWeatherRequest.winds_aloft:type_name -> manifest.WindsAloftSample
+	3,  // 40: manifest.PostAlertRequest.priority:type_name -> manifest.AlertPriority
+	53, // 41: manifest.ServerInfo.build_info:type_name -> manifest.BuildInfo
+	57, // 42: manifest.WindHistory.observations:type_name -> manifest.WindObservation
+	60, // 43: manifest.JumprunHistory.entries:type_name -> manifest.JumprunHistoryEntry
+	12, // 44: manifest.SetJumprunRequest.hook_turns:type_name -> manifest.JumprunTurn
+	34, // 45: manifest.EchoTraceRequest.trace:type_name -> manifest.Trace
+	71, // 46: manifest.ManifestService.StreamUpdates:input_type -> google.protobuf.Empty
+	36, // 47: manifest.ManifestService.SignInWithApple:input_type -> manifest.SignInWithAppleRequest
+	38, // 48: manifest.ManifestService.SignOut:input_type -> manifest.SignOutRequest
+	40, // 49: manifest.ManifestService.VerifySessionID:input_type -> manifest.VerifySessionRequest
+	41, // 50: manifest.ManifestService.ToggleFuelRequested:input_type -> manifest.ToggleFuelRequestedRequest
+	43, // 51: manifest.ManifestService.RestartServer:input_type -> manifest.RestartServerRequest
+	45, // 52: manifest.ManifestService.SimulateWeather:input_type -> manifest.SimulateWeatherRequest
+	47, // 53: manifest.ManifestService.PostAlert:input_type -> manifest.PostAlertRequest
+	67, // 54: manifest.ManifestService.Resync:input_type -> manifest.ResyncRequest
+	49, // 55: manifest.ManifestService.PostTakeover:input_type -> manifest.PostTakeoverRequest
+	69, // 56: manifest.ManifestService.StreamEvents:input_type -> manifest.StreamEventsRequest
+	68, // 57: manifest.ManifestService.EchoTrace:input_type -> manifest.EchoTraceRequest
+	51, // 58: manifest.ManifestService.Acknowledge:input_type -> manifest.AcknowledgeRequest
+	71, // 59: manifest.ManifestService.GetServerInfo:input_type -> google.protobuf.Empty
+	55, // 60: manifest.ManifestService.GetWindHistory:input_type -> manifest.WindHistoryRequest
+	58, // 61: manifest.ManifestService.ListJumprunHistory:input_type -> manifest.JumprunHistoryRequest
+	61, // 62: manifest.ManifestService.RestoreJumprun:input_type -> manifest.RestoreJumprunRequest
+	63, // 63: manifest.ManifestService.SetJumprun:input_type -> manifest.SetJumprunRequest
+	65, // 64: manifest.ManifestService.ClearJumprun:input_type -> manifest.ClearJumprunRequest
+	35, // 65: manifest.ManifestService.StreamUpdates:output_type -> manifest.ManifestUpdate
+	37, // 66: manifest.ManifestService.SignInWithApple:output_type -> manifest.SignInResponse
+	39, // 67: manifest.ManifestService.SignOut:output_type -> manifest.SignOutResponse
+	37, // 68: manifest.ManifestService.VerifySessionID:output_type -> manifest.SignInResponse
+	42, // 69: manifest.ManifestService.ToggleFuelRequested:output_type -> manifest.ToggleFuelRequestedResponse
+	44, // 70: manifest.ManifestService.RestartServer:output_type -> manifest.RestartServerResponse
+	46, // 71: manifest.ManifestService.SimulateWeather:output_type -> manifest.SimulateWeatherResponse
+	48, // 72: manifest.ManifestService.PostAlert:output_type -> manifest.PostAlertResponse
+	35, // 73: manifest.ManifestService.Resync:output_type -> manifest.ManifestUpdate
+	50, // 74: manifest.ManifestService.PostTakeover:output_type -> manifest.PostTakeoverResponse
+	70, // 75: manifest.ManifestService.StreamEvents:output_type -> manifest.ManifestEvent
+	71, // 76: manifest.ManifestService.EchoTrace:output_type -> google.protobuf.Empty
+	52, // 77: manifest.ManifestService.Acknowledge:output_type -> manifest.AcknowledgeResponse
+	54, // 78: manifest.ManifestService.GetServerInfo:output_type -> manifest.ServerInfo
+	56, // 79: manifest.ManifestService.GetWindHistory:output_type -> manifest.WindHistory
+	59, // 80: manifest.ManifestService.ListJumprunHistory:output_type -> manifest.JumprunHistory
+	62, // 81: manifest.ManifestService.RestoreJumprun:output_type -> manifest.RestoreJumprunResponse
+	64, // 82: manifest.ManifestService.SetJumprun:output_type -> manifest.SetJumprunResponse
+	66, // 83: manifest.ManifestService.ClearJumprun:output_type -> manifest.ClearJumprunResponse
+	65, // [65:84] is the sub-list for method output_type
+	46, // [46:65] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
+}
+
+func init() { file_pkg_server_service_proto_init() }
+func file_pkg_server_service_proto_init() {
+	if File_pkg_server_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_server_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SmoothedWind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Observation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AircraftSeparation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Drift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisplayHints); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Options); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunOrigin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunTurn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunPath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunSpot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Jumprun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindsAloftSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindsAloft); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindsAloftForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Jumper); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumperGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LayoutHint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExitGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Load); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Loads); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alerts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChimeEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timestamps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Takeover); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Counter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Counters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInWithAppleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignInResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignOutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifySessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleFuelRequestedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleFuelRequestedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RestartServerRequest.ProtoReflect.Descriptor instead.
-func (*RestartServerRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *RestartServerRequest) GetSessionId() string {
-	if x != nil {
-		return x.SessionId
-	}
-	return ""
-}
-
-type RestartServerResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ErrorMessage string `protobuf:"bytes,1,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-}
-
-func (x *RestartServerResponse) Reset() {
-	*x = RestartServerResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_server_service_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RestartServerResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RestartServerResponse) ProtoMessage() {}
-
-func (x *RestartServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_server_service_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_pkg_server_service_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestartServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RestartServerResponse.ProtoReflect.Descriptor instead.
-func (*RestartServerResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_server_service_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *RestartServerResponse) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
-var File_pkg_server_service_proto protoreflect.FileDescriptor
-
-var file_pkg_server_service_proto_rawDesc = []byte{
-	0x0a, 0x18, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xce, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x77, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x77, 0x69, 0x6e,
-	0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c,
-	0x6f, 0x75, 0x64, 0x73, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0b, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x73, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77,
-	0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65,
-	0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x77, 0x65,
-	0x61, 0x74, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x22, 0x9a, 0x02, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b,
-	0x0a, 0x11, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x77, 0x65, 0x61, 0x74, 0x68, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x65, 0x61,
-	0x74, 0x68, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f,
-	0x77, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6e, 0x72, 0x69,
-	0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x75, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x66, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22,
-	0x9d, 0x01, 0x0a, 0x0d, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x4f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d,
-	0x61, 0x67, 0x6e, 0x65, 0x74, 0x69, 0x63, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x67, 0x6e, 0x65, 0x74, 0x69,
-	0x63, 0x44, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61,
-	0x6d, 0x65, 0x72, 0x61, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x63, 0x61, 0x6d, 0x65, 0x72, 0x61, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x43, 0x0a, 0x0b, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61,
-	0x64, 0x69, 0x6e, 0x67, 0x22, 0xc9, 0x01, 0x0a, 0x0b, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x69, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x68, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x44, 0x69, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75,
-	0x6d, 0x70, 0x72, 0x75, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x52, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73,
-	0x22, 0x73, 0x0a, 0x07, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x6f,
-	0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x4f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x50, 0x61, 0x74,
-	0x68, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x22, 0x9c, 0x01, 0x0a, 0x10, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x41,
-	0x6c, 0x6f, 0x66, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x6c,
-	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x6c,
-	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x22, 0x42, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f,
-	0x66, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x57,
-	0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
-	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xd6, 0x01, 0x0a, 0x06, 0x4a, 0x75, 0x6d,
-	0x70, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d,
-	0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x72, 0x65, 0x70, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x69, 0x67, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x69, 0x67, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x63, 0x0a, 0x0b, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x28, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70,
-	0x65, 0x72, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x52, 0x07, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x6c,
-	0x6f, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x6a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75,
-	0x6d, 0x70, 0x65, 0x72, 0x48, 0x00, 0x52, 0x06, 0x6a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x12, 0x2d,
-	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x06, 0x0a,
-	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x22, 0x94, 0x03, 0x0a, 0x04, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x69, 0x72, 0x63, 0x72, 0x61, 0x66, 0x74, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x6d, 0x69, 0x6e,
-	0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
-	0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x6c, 0x6c, 0x5f,
-	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x61, 0x6c, 0x6c, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6c, 0x6f, 0x74, 0x73,
-	0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0e, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x34, 0x0a, 0x16, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x75, 0x65,
-	0x6c, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x46, 0x75,
-	0x65, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x74, 0x75, 0x72, 0x6e,
-	0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x54, 0x75, 0x72,
-	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6e, 0x6f, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4e, 0x6f, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x61,
-	0x64, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x05,
-	0x4c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6c,
-	0x75, 0x6d, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0xc8,
-	0x02, 0x0a, 0x0e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x30, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x48, 0x01, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x6a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4a,
-	0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x48, 0x02, 0x52, 0x07, 0x6a, 0x75, 0x6d, 0x70, 0x72, 0x75,
-	0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x6c,
-	0x6f, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x48,
-	0x03, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x41, 0x6c, 0x6f, 0x66, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x73,
-	0x48, 0x04, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6a, 0x75, 0x6d, 0x70, 0x72, 0x75, 0x6e, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x6c, 0x6f, 0x66, 0x74, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x16, 0x53, 0x69,
-	0x67, 0x6e, 0x49, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d,
-	0x0a, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a,
-	0x0a, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xdd, 0x01,
-	0x0a, 0x0e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x2d, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19,
-	0x0a, 0x08, 0x69, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a,
-	0x0e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x30,
-	0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x22, 0x35, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x46, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x1b, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
-	0x3c, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x9d, 0x01,
-	0x0a, 0x0a, 0x4a, 0x75, 0x6d, 0x70, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b,
-	0x45, 0x58, 0x50, 0x45, 0x52, 0x49, 0x45, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a,
-	0x0b, 0x41, 0x46, 0x46, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x11,
-	0x0a, 0x0d, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x53, 0x54, 0x55, 0x44, 0x45, 0x4e, 0x54, 0x10,
-	0x02, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x41, 0x4e, 0x44, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x55, 0x44,
-	0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x46, 0x46, 0x5f, 0x49, 0x4e, 0x53,
-	0x54, 0x52, 0x55, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4f, 0x41,
-	0x43, 0x48, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x4e, 0x44, 0x45, 0x4d, 0x5f, 0x49,
-	0x4e, 0x53, 0x54, 0x52, 0x55, 0x43, 0x54, 0x4f, 0x52, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x56,
-	0x49, 0x44, 0x45, 0x4f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x45, 0x52, 0x10, 0x07, 0x32, 0xe8, 0x03,
-	0x0a, 0x0f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x43, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x0f, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e,
-	0x57, 0x69, 0x74, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x41,
-	0x70, 0x70, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61,
-	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74,
-	0x12, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e,
-	0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66,
-	0x65, 0x73, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x62, 0x0a, 0x13, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x6e, 0x69,
-	0x66, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x25, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x46, 0x75, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x75, 0x6d, 0x70, 0x74, 0x6f, 0x77, 0x6e, 0x2d,
-	0x73, 0x6b, 0x79, 0x64, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
-	0x73, 0x74, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_pkg_server_service_proto_rawDescOnce sync.Once
-	file_pkg_server_service_proto_rawDescData = file_pkg_server_service_proto_rawDesc
-)
-
-func file_pkg_server_service_proto_rawDescGZIP() []byte {
-	file_pkg_server_service_proto_rawDescOnce.Do(func() {
-		file_pkg_server_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_server_service_proto_rawDescData)
-	})
-	return file_pkg_server_service_proto_rawDescData
-}
-
-var file_pkg_server_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_server_service_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_pkg_server_service_proto_goTypes = []interface{}{
-	(JumperType)(0),                     // 0: manifest.JumperType
-	(*Status)(nil),                      // 1: manifest.Status
-	(*Options)(nil),                     // 2: manifest.Options
-	(*JumprunOrigin)(nil),               // 3: manifest.JumprunOrigin
-	(*JumprunTurn)(nil),                 // 4: manifest.JumprunTurn
-	(*JumprunPath)(nil),                 // 5: manifest.JumprunPath
-	(*Jumprun)(nil),                     // 6: manifest.Jumprun
-	(*WindsAloftSample)(nil),            // 7: manifest.WindsAloftSample
-	(*WindsAloft)(nil),                  // 8: manifest.WindsAloft
-	(*Jumper)(nil),                      // 9: manifest.Jumper
-	(*JumperGroup)(nil),                 // 10: manifest.JumperGroup
-	(*LoadSlot)(nil),                    // 11: manifest.LoadSlot
-	(*Load)(nil),                        // 12: manifest.Load
-	(*Loads)(nil),                       // 13: manifest.Loads
-	(*ManifestUpdate)(nil),              // 14: manifest.ManifestUpdate
-	(*SignInWithAppleRequest)(nil),      // 15: manifest.SignInWithAppleRequest
-	(*SignInResponse)(nil),              // 16: manifest.SignInResponse
-	(*SignOutRequest)(nil),              // 17: manifest.SignOutRequest
-	(*SignOutResponse)(nil),             // 18: manifest.SignOutResponse
-	(*VerifySessionRequest)(nil),        // 19: manifest.VerifySessionRequest
-	(*ToggleFuelRequestedRequest)(nil),  // 20: manifest.ToggleFuelRequestedRequest
-	(*ToggleFuelRequestedResponse)(nil), // 21: manifest.ToggleFuelRequestedResponse
-	(*RestartServerRequest)(nil),        // 22: manifest.RestartServerRequest
-	(*RestartServerResponse)(nil),       // 23: manifest.RestartServerResponse
-	(*emptypb.Empty)(nil),               // 24: google.protobuf.Empty
-}
-var file_pkg_server_service_proto_depIdxs = []int32{
-	4,  // 0: manifest.JumprunPath.turns:type_name -> manifest.JumprunTurn
-	3,  // 1: manifest.Jumprun.origin:type_name -> manifest.JumprunOrigin
-	5,  // 2: manifest.Jumprun.path:type_name -> manifest.JumprunPath
-	7,  // 3: manifest.WindsAloft.samples:type_name -> manifest.WindsAloftSample
-	0,  // 4: manifest.Jumper.type:type_name -> manifest.JumperType
-	9,  // 5: manifest.JumperGroup.leader:type_name -> manifest.Jumper
-	9,  // 6: manifest.JumperGroup.members:type_name -> manifest.Jumper
-	9,  // 7: manifest.LoadSlot.jumper:type_name -> manifest.Jumper
-	10, // 8: manifest.LoadSlot.group:type_name -> manifest.JumperGroup
-	11, // 9: manifest.Load.slots:type_name -> manifest.LoadSlot
-	12, // 10: manifest.Loads.loads:type_name -> manifest.Load
-	1,  // 11: manifest.ManifestUpdate.status:type_name -> manifest.Status
-	2,  // 12: manifest.ManifestUpdate.options:type_name -> manifest.Options
-	6,  // 13: manifest.ManifestUpdate.jumprun:type_name -> manifest.Jumprun
-	8,  // 14: manifest.ManifestUpdate.winds_aloft:type_name -> manifest.WindsAloft
-	13, // 15: manifest.ManifestUpdate.loads:type_name -> manifest.Loads
-	24, // 16: manifest.ManifestService.StreamUpdates:input_type -> google.protobuf.Empty
-	15, // 17: manifest.ManifestService.SignInWithApple:input_type -> manifest.SignInWithAppleRequest
-	17, // 18: manifest.ManifestService.SignOut:input_type -> manifest.SignOutRequest
-	19, // 19: manifest.ManifestService.VerifySessionID:input_type -> manifest.VerifySessionRequest
-	20, // 20: manifest.ManifestService.ToggleFuelRequested:input_type -> manifest.ToggleFuelRequestedRequest
-	22, // 21: manifest.ManifestService.RestartServer:input_type -> manifest.RestartServerRequest
-	14, // 22: manifest.ManifestService.StreamUpdates:output_type -> manifest.ManifestUpdate
-	16, // 23: manifest.ManifestService.SignInWithApple:output_type -> manifest.SignInResponse
-	18, // 24: manifest.ManifestService.SignOut:output_type -> manifest.SignOutResponse
-	16, // 25: manifest.ManifestService.VerifySessionID:output_type -> manifest.SignInResponse
-	21, // 26: manifest.ManifestService.ToggleFuelRequested:output_type -> manifest.ToggleFuelRequestedResponse
-	23, // 27: manifest.ManifestService.RestartServer:output_type -> manifest.RestartServerResponse
-	22, // [22:28] is the sub-list for method output_type
-	16, // [16:22] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
-}
-
-func init() { file_pkg_server_service_proto_init() }
-func file_pkg_server_service_proto_init() {
-	if File_pkg_server_service_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_server_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+		file_pkg_server_service_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateWeatherRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2063,8 +7489,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Options); i {
+		file_pkg_server_service_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateWeatherResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2075,8 +7501,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JumprunOrigin); i {
+		file_pkg_server_service_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostAlertRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2087,8 +7513,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JumprunTurn); i {
+		file_pkg_server_service_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostAlertResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2099,8 +7525,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JumprunPath); i {
+		file_pkg_server_service_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostTakeoverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2111,8 +7537,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Jumprun); i {
+		file_pkg_server_service_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostTakeoverResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2123,8 +7549,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WindsAloftSample); i {
+		file_pkg_server_service_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2135,8 +7561,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WindsAloft); i {
+		file_pkg_server_service_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcknowledgeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2147,8 +7573,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Jumper); i {
+		file_pkg_server_service_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2159,8 +7585,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JumperGroup); i {
+		file_pkg_server_service_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2171,8 +7597,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadSlot); i {
+		file_pkg_server_service_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2183,8 +7609,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Load); i {
+		file_pkg_server_service_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2195,8 +7621,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Loads); i {
+		file_pkg_server_service_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WindObservation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2207,8 +7633,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ManifestUpdate); i {
+		file_pkg_server_service_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2219,8 +7645,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInWithAppleRequest); i {
+		file_pkg_server_service_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2231,8 +7657,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignInResponse); i {
+		file_pkg_server_service_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumprunHistoryEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2243,8 +7669,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutRequest); i {
+		file_pkg_server_service_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreJumprunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2255,8 +7681,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignOutResponse); i {
+		file_pkg_server_service_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreJumprunResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2267,8 +7693,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VerifySessionRequest); i {
+		file_pkg_server_service_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetJumprunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2279,8 +7705,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleFuelRequestedRequest); i {
+		file_pkg_server_service_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetJumprunResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2291,8 +7717,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleFuelRequestedResponse); i {
+		file_pkg_server_service_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearJumprunRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2303,8 +7729,8 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartServerRequest); i {
+		file_pkg_server_service_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearJumprunResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2315,8 +7741,44 @@ func file_pkg_server_service_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_server_service_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartServerResponse); i {
+		file_pkg_server_service_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EchoTraceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_server_service_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2328,19 +7790,23 @@ func file_pkg_server_service_proto_init() {
 			}
 		}
 	}
-	file_pkg_server_service_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_pkg_server_service_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_pkg_server_service_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_pkg_server_service_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_pkg_server_service_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_pkg_server_service_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*LoadSlot_Jumper)(nil),
 		(*LoadSlot_Group)(nil),
 	}
-	file_pkg_server_service_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_pkg_server_service_proto_msgTypes[31].OneofWrappers = []interface{}{}
+	file_pkg_server_service_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_pkg_server_service_proto_msgTypes[59].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_server_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   23,
+			NumEnums:      4,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,19 @@ type ManifestServiceClient interface {
 	VerifySessionID(ctx context.Context, in *VerifySessionRequest, opts ...grpc.CallOption) (*SignInResponse, error)
 	ToggleFuelRequested(ctx context.Context, in *ToggleFuelRequestedRequest, opts ...grpc.CallOption) (*ToggleFuelRequestedResponse, error)
 	RestartServer(ctx context.Context, in *RestartServerRequest, opts ...grpc.CallOption) (*RestartServerResponse, error)
+	SimulateWeather(ctx context.Context, in *SimulateWeatherRequest, opts ...grpc.CallOption) (*SimulateWeatherResponse, error)
+	PostAlert(ctx context.Context, in *PostAlertRequest, opts ...grpc.CallOption) (*PostAlertResponse, error)
+	Resync(ctx context.Context, in *ResyncRequest, opts ...grpc.CallOption) (*ManifestUpdate, error)
+	PostTakeover(ctx context.Context, in *PostTakeoverRequest, opts ...grpc.CallOption) (*PostTakeoverResponse, error)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (ManifestService_StreamEventsClient, error)
+	EchoTrace(ctx context.Context, in *EchoTraceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Acknowledge(ctx context.Context, in *AcknowledgeRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error)
+	GetServerInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerInfo, error)
+	GetWindHistory(ctx context.Context, in *WindHistoryRequest, opts ...grpc.CallOption) (*WindHistory, error)
+	ListJumprunHistory(ctx context.Context, in *JumprunHistoryRequest, opts ...grpc.CallOption) (*JumprunHistory, error)
+	RestoreJumprun(ctx context.Context, in *RestoreJumprunRequest, opts ...grpc.CallOption) (*RestoreJumprunResponse, error)
+	SetJumprun(ctx context.Context, in *SetJumprunRequest, opts ...grpc.CallOption) (*SetJumprunResponse, error)
+	ClearJumprun(ctx context.Context, in *ClearJumprunRequest, opts ...grpc.CallOption) (*ClearJumprunResponse, error)
 }
 
 type manifestServiceClient struct {
@@ -112,6 +125,146 @@ func (c *manifestServiceClient) RestartServer(ctx context.Context, in *RestartSe
 	return out, nil
 }
 
+func (c *manifestServiceClient) SimulateWeather(ctx context.Context, in *SimulateWeatherRequest, opts ...grpc.CallOption) (*SimulateWeatherResponse, error) {
+	out := new(SimulateWeatherResponse)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/SimulateWeather", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) PostAlert(ctx context.Context, in *PostAlertRequest, opts ...grpc.CallOption) (*PostAlertResponse, error) {
+	out := new(PostAlertResponse)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/PostAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) Resync(ctx context.Context, in *ResyncRequest, opts ...grpc.CallOption) (*ManifestUpdate, error) {
+	out := new(ManifestUpdate)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/Resync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) PostTakeover(ctx context.Context, in *PostTakeoverRequest, opts ...grpc.CallOption) (*PostTakeoverResponse, error) {
+	out := new(PostTakeoverResponse)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/PostTakeover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (ManifestService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ManifestService_ServiceDesc.Streams[1], "/manifest.ManifestService/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &manifestServiceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ManifestService_StreamEventsClient interface {
+	Recv() (*ManifestEvent, error)
+	grpc.ClientStream
+}
+
+type manifestServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *manifestServiceStreamEventsClient) Recv() (*ManifestEvent, error) {
+	m := new(ManifestEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *manifestServiceClient) EchoTrace(ctx context.Context, in *EchoTraceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/EchoTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) Acknowledge(ctx context.Context, in *AcknowledgeRequest, opts ...grpc.CallOption) (*AcknowledgeResponse, error) {
+	out := new(AcknowledgeResponse)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/Acknowledge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) GetServerInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServerInfo, error) {
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/GetServerInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) GetWindHistory(ctx context.Context, in *WindHistoryRequest, opts ...grpc.CallOption) (*WindHistory, error) {
+	out := new(WindHistory)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/GetWindHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) ListJumprunHistory(ctx context.Context, in *JumprunHistoryRequest, opts ...grpc.CallOption) (*JumprunHistory, error) {
+	out := new(JumprunHistory)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/ListJumprunHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) RestoreJumprun(ctx context.Context, in *RestoreJumprunRequest, opts ...grpc.CallOption) (*RestoreJumprunResponse, error) {
+	out := new(RestoreJumprunResponse)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/RestoreJumprun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) SetJumprun(ctx context.Context, in *SetJumprunRequest, opts ...grpc.CallOption) (*SetJumprunResponse, error) {
+	out := new(SetJumprunResponse)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/SetJumprun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *manifestServiceClient) ClearJumprun(ctx context.Context, in *ClearJumprunRequest, opts ...grpc.CallOption) (*ClearJumprunResponse, error) {
+	out := new(ClearJumprunResponse)
+	err := c.cc.Invoke(ctx, "/manifest.ManifestService/ClearJumprun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManifestServiceServer is the server API for ManifestService service.
 // All implementations must embed UnimplementedManifestServiceServer
 // for forward compatibility
@@ -122,6 +275,19 @@ type ManifestServiceServer interface {
 	VerifySessionID(context.Context, *VerifySessionRequest) (*SignInResponse, error)
 	ToggleFuelRequested(context.Context, *ToggleFuelRequestedRequest) (*ToggleFuelRequestedResponse, error)
 	RestartServer(context.Context, *RestartServerRequest) (*RestartServerResponse, error)
+	SimulateWeather(context.Context, *SimulateWeatherRequest) (*SimulateWeatherResponse, error)
+	PostAlert(context.Context, *PostAlertRequest) (*PostAlertResponse, error)
+	Resync(context.Context, *ResyncRequest) (*ManifestUpdate, error)
+	PostTakeover(context.Context, *PostTakeoverRequest) (*PostTakeoverResponse, error)
+	StreamEvents(*StreamEventsRequest, ManifestService_StreamEventsServer) error
+	EchoTrace(context.Context, *EchoTraceRequest) (*emptypb.Empty, error)
+	Acknowledge(context.Context, *AcknowledgeRequest) (*AcknowledgeResponse, error)
+	GetServerInfo(context.Context, *emptypb.Empty) (*ServerInfo, error)
+	GetWindHistory(context.Context, *WindHistoryRequest) (*WindHistory, error)
+	ListJumprunHistory(context.Context, *JumprunHistoryRequest) (*JumprunHistory, error)
+	RestoreJumprun(context.Context, *RestoreJumprunRequest) (*RestoreJumprunResponse, error)
+	SetJumprun(context.Context, *SetJumprunRequest) (*SetJumprunResponse, error)
+	ClearJumprun(context.Context, *ClearJumprunRequest) (*ClearJumprunResponse, error)
 	mustEmbedUnimplementedManifestServiceServer()
 }
 
@@ -147,6 +313,45 @@ func (UnimplementedManifestServiceServer) ToggleFuelRequested(context.Context, *
 func (UnimplementedManifestServiceServer) RestartServer(context.Context, *RestartServerRequest) (*RestartServerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartServer not implemented")
 }
+func (UnimplementedManifestServiceServer) SimulateWeather(context.Context, *SimulateWeatherRequest) (*SimulateWeatherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateWeather not implemented")
+}
+func (UnimplementedManifestServiceServer) PostAlert(context.Context, *PostAlertRequest) (*PostAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostAlert not implemented")
+}
+func (UnimplementedManifestServiceServer) Resync(context.Context, *ResyncRequest) (*ManifestUpdate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resync not implemented")
+}
+func (UnimplementedManifestServiceServer) PostTakeover(context.Context, *PostTakeoverRequest) (*PostTakeoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTakeover not implemented")
+}
+func (UnimplementedManifestServiceServer) StreamEvents(*StreamEventsRequest, ManifestService_StreamEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedManifestServiceServer) EchoTrace(context.Context, *EchoTraceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EchoTrace not implemented")
+}
+func (UnimplementedManifestServiceServer) Acknowledge(context.Context, *AcknowledgeRequest) (*AcknowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Acknowledge not implemented")
+}
+func (UnimplementedManifestServiceServer) GetServerInfo(context.Context, *emptypb.Empty) (*ServerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
+func (UnimplementedManifestServiceServer) GetWindHistory(context.Context, *WindHistoryRequest) (*WindHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWindHistory not implemented")
+}
+func (UnimplementedManifestServiceServer) ListJumprunHistory(context.Context, *JumprunHistoryRequest) (*JumprunHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJumprunHistory not implemented")
+}
+func (UnimplementedManifestServiceServer) RestoreJumprun(context.Context, *RestoreJumprunRequest) (*RestoreJumprunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreJumprun not implemented")
+}
+func (UnimplementedManifestServiceServer) SetJumprun(context.Context, *SetJumprunRequest) (*SetJumprunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetJumprun not implemented")
+}
+func (UnimplementedManifestServiceServer) ClearJumprun(context.Context, *ClearJumprunRequest) (*ClearJumprunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearJumprun not implemented")
+}
 func (UnimplementedManifestServiceServer) mustEmbedUnimplementedManifestServiceServer() {}
 
 // UnsafeManifestServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +476,243 @@ func _ManifestService_RestartServer_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManifestService_SimulateWeather_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateWeatherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).SimulateWeather(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/SimulateWeather",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).SimulateWeather(ctx, req.(*SimulateWeatherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_PostAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).PostAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/PostAlert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).PostAlert(ctx, req.(*PostAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_Resync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).Resync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/Resync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).Resync(ctx, req.(*ResyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_PostTakeover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostTakeoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).PostTakeover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/PostTakeover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).PostTakeover(ctx, req.(*PostTakeoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManifestServiceServer).StreamEvents(m, &manifestServiceStreamEventsServer{stream})
+}
+
+type ManifestService_StreamEventsServer interface {
+	Send(*ManifestEvent) error
+	grpc.ServerStream
+}
+
+type manifestServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *manifestServiceStreamEventsServer) Send(m *ManifestEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ManifestService_EchoTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EchoTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).EchoTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/EchoTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).EchoTrace(ctx, req.(*EchoTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_Acknowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).Acknowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/Acknowledge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).Acknowledge(ctx, req.(*AcknowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/GetServerInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).GetServerInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_GetWindHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WindHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).GetWindHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/GetWindHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).GetWindHistory(ctx, req.(*WindHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_ListJumprunHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JumprunHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).ListJumprunHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/ListJumprunHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).ListJumprunHistory(ctx, req.(*JumprunHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_RestoreJumprun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreJumprunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).RestoreJumprun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/RestoreJumprun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).RestoreJumprun(ctx, req.(*RestoreJumprunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_SetJumprun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJumprunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).SetJumprun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/SetJumprun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).SetJumprun(ctx, req.(*SetJumprunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ManifestService_ClearJumprun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearJumprunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManifestServiceServer).ClearJumprun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/manifest.ManifestService/ClearJumprun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManifestServiceServer).ClearJumprun(ctx, req.(*ClearJumprunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ManifestService_ServiceDesc is the grpc.ServiceDesc for ManifestService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -298,6 +740,54 @@ var ManifestService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RestartServer",
 			Handler:    _ManifestService_RestartServer_Handler,
 		},
+		{
+			MethodName: "SimulateWeather",
+			Handler:    _ManifestService_SimulateWeather_Handler,
+		},
+		{
+			MethodName: "PostAlert",
+			Handler:    _ManifestService_PostAlert_Handler,
+		},
+		{
+			MethodName: "Resync",
+			Handler:    _ManifestService_Resync_Handler,
+		},
+		{
+			MethodName: "PostTakeover",
+			Handler:    _ManifestService_PostTakeover_Handler,
+		},
+		{
+			MethodName: "EchoTrace",
+			Handler:    _ManifestService_EchoTrace_Handler,
+		},
+		{
+			MethodName: "Acknowledge",
+			Handler:    _ManifestService_Acknowledge_Handler,
+		},
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _ManifestService_GetServerInfo_Handler,
+		},
+		{
+			MethodName: "GetWindHistory",
+			Handler:    _ManifestService_GetWindHistory_Handler,
+		},
+		{
+			MethodName: "ListJumprunHistory",
+			Handler:    _ManifestService_ListJumprunHistory_Handler,
+		},
+		{
+			MethodName: "RestoreJumprun",
+			Handler:    _ManifestService_RestoreJumprun_Handler,
+		},
+		{
+			MethodName: "SetJumprun",
+			Handler:    _ManifestService_SetJumprun_Handler,
+		},
+		{
+			MethodName: "ClearJumprun",
+			Handler:    _ManifestService_ClearJumprun_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -305,6 +795,11 @@ var ManifestService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ManifestService_StreamUpdates_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _ManifestService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "pkg/server/service.proto",
 }
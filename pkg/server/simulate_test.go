@@ -0,0 +1,104 @@
+// (c) Copyright 2026 Matt Messier
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jumptown-skydiving/manifest-server/internal/fixtures"
+	"github.com/jumptown-skydiving/manifest-server/pkg/core"
+)
+
+// newAdminSession returns a server running against the fixtures harness
+// and the ID of a session for a user with the admin role.
+func newAdminSession(t *testing.T) (*manifestServiceServer, string) {
+	t.Helper()
+	h := fixtures.NewHarness()
+	t.Cleanup(h.Close)
+
+	c, err := h.NewController(core.SystemClock)
+	if err != nil {
+		t.Fatalf("NewController: %v", err)
+	}
+	t.Cleanup(c.Close)
+
+	tx, err := c.BeginDatabaseTransaction()
+	if err != nil {
+		t.Fatalf("BeginDatabaseTransaction: %v", err)
+	}
+	user, err := c.CreateUser(tx, "admin", "Admin", "User", "admin@example.com", false, true)
+	if err != nil {
+		t.Fatalf("CreateUser: %v", err)
+	}
+	session, err := c.NewSession(tx, user, "access", "refresh", "identity", "nonce", "siwa")
+	if err != nil {
+		t.Fatalf("NewSession: %v", err)
+	}
+	if _, err = tx.Exec(`INSERT INTO users_roles (userid, roleid)
+		SELECT users.id, roles.id FROM users, roles
+		WHERE users.userid = $1 AND roles.name = "admin";`, user.ID); err != nil {
+		t.Fatalf("grant admin: %v", err)
+	}
+	if err = c.CommitDatabaseTransaction(tx); err != nil {
+		t.Fatalf("CommitDatabaseTransaction: %v", err)
+	}
+	return newManifestServiceServer(c), session.ID
+}
+
+func TestSimulateWeatherOrdersWindsAloft(t *testing.T) {
+	s, sessionID := newAdminSession(t)
+
+	resp, err := s.SimulateWeather(context.Background(), &SimulateWeatherRequest{
+		SessionId: sessionID,
+		WindsAloft: []*WindsAloftSample{
+			{Altitude: 12000, Heading: 270, Speed: 40},
+			{Altitude: 0, Heading: 240, Speed: 10},
+			{Altitude: 6000, Heading: 250, Speed: 25},
+		},
+	})
+	if err != nil {
+		t.Fatalf("SimulateWeather: %v", err)
+	}
+	if resp.ErrorMessage != "" {
+		t.Fatalf("SimulateWeather: %s", resp.ErrorMessage)
+	}
+
+	samples := s.app.WindsAloftSource().Samples()
+	if len(samples) != 3 {
+		t.Fatalf("got %d samples; want 3", len(samples))
+	}
+	for i, altitude := range []int{0, 6000, 12000} {
+		if samples[i].Altitude != altitude {
+			t.Errorf("samples[%d].Altitude = %d; want %d", i, samples[i].Altitude, altitude)
+		}
+	}
+}
+
+func TestSimulateWeatherRejectsInvalidAltitudes(t *testing.T) {
+	s, sessionID := newAdminSession(t)
+
+	for name, samples := range map[string][]*WindsAloftSample{
+		"duplicate": {
+			{Altitude: 3000, Heading: 240, Speed: 10},
+			{Altitude: 3000, Heading: 250, Speed: 15},
+		},
+		"negative": {
+			{Altitude: -1000, Heading: 240, Speed: 10},
+		},
+	} {
+		resp, err := s.SimulateWeather(context.Background(), &SimulateWeatherRequest{
+			SessionId:  sessionID,
+			WindsAloft: samples,
+		})
+		if err != nil {
+			t.Fatalf("%s: SimulateWeather: %v", name, err)
+		}
+		if resp.ErrorMessage == "" {
+			t.Errorf("%s: SimulateWeather succeeded", name)
+		}
+	}
+	if s.app.WindsAloftSource().IsSimulated() {
+		t.Error("winds aloft are simulated after invalid requests")
+	}
+}
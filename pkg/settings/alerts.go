@@ -0,0 +1,113 @@
+// (c) Copyright 2026 Matt Messier
+
+package settings
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// AlertClasses returns the alert classes shown on the named channel. Nil
+// means all classes are shown.
+func (s *Settings) AlertClasses(channel string) []string {
+	var classes []string
+	for _, class := range s.config.GetStringSlice("alerts." + channel + ".classes") {
+		classes = append(classes, strings.ToLower(strings.TrimSpace(class)))
+	}
+	return classes
+}
+
+// AlertMinimumPriority returns the lowest alert priority shown on the named
+// channel: "info", "warning", or "critical".
+func (s *Settings) AlertMinimumPriority(channel string) string {
+	return strings.ToLower(s.config.GetString("alerts." + channel + ".min_priority"))
+}
+
+// DegradedSources returns the number of data sources that must be failing
+// before a single alert is raised describing them, or 0 to disable it.
+func (s *Settings) DegradedSources() int {
+	return s.config.GetInt("alerts.degraded_sources")
+}
+
+// NotificationRoute routes notifications of some types and priorities to a
+// notification channel, e.g. sms or slack.
+type NotificationRoute struct {
+	Channel     string
+	Types       []string // lower case; a trailing * matches any suffix
+	MinPriority string   // "info", "warning", or "critical"
+	JumpersOnly bool     // only notifications about a particular jumper
+}
+
+// Matches returns true if the route routes notifications of a type.
+func (r NotificationRoute) Matches(typ string) bool {
+	typ = strings.ToLower(strings.TrimSpace(typ))
+	for _, t := range r.Types {
+		if prefix := strings.TrimSuffix(t, "*"); prefix != t {
+			if strings.HasPrefix(typ, prefix) {
+				return true
+			}
+		} else if typ == t {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationRoutes returns the routes configured in notifications.routes.
+func (s *Settings) NotificationRoutes() []NotificationRoute {
+	return s.routes
+}
+
+// NotificationWebhooks returns the URL that notifications routed to each
+// channel are posted to, by channel.
+func (s *Settings) NotificationWebhooks() map[string]string {
+	return s.config.GetStringMapString("notifications.webhooks")
+}
+
+// loadNotificationRoutes parses the routes configured in
+// notifications.routes. A route with an unknown min_priority is an error
+// rather than being treated as "info", so that a typo can't route every
+// notification to a channel meant only for critical ones.
+func (s *Settings) loadNotificationRoutes() ([]NotificationRoute, error) {
+	routes, _ := s.config.Get("notifications.routes").([]interface{})
+	result := make([]NotificationRoute, 0, len(routes))
+	for _, r := range routes {
+		rr, rrok := r.(map[string]interface{})
+		if !rrok {
+			continue
+		}
+		channel, cok := rr["channel"].(string)
+		if !cok {
+			fmt.Fprintf(os.Stderr, "error: missing channel for notifications.routes entry\n")
+			continue
+		}
+		route := NotificationRoute{
+			Channel:     strings.ToLower(channel),
+			Types:       []string{"*"},
+			MinPriority: "info",
+		}
+		if types, tok := rr["types"].([]interface{}); tok {
+			route.Types = nil
+			for _, t := range types {
+				if typ, ok := t.(string); ok {
+					route.Types = append(route.Types, strings.ToLower(strings.TrimSpace(typ)))
+				}
+			}
+		}
+		if priority, pok := rr["min_priority"].(string); pok {
+			route.MinPriority = strings.ToLower(strings.TrimSpace(priority))
+		}
+		switch route.MinPriority {
+		case "info", "warning", "critical":
+		default:
+			return nil, fmt.Errorf("invalid min_priority %q for notifications.routes channel %s",
+				rr["min_priority"], route.Channel)
+		}
+		if jumpers, jok := rr["jumpers_only"].(bool); jok {
+			route.JumpersOnly = jumpers
+		}
+		result = append(result, route)
+	}
+	return result, nil
+}
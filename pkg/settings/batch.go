@@ -0,0 +1,149 @@
+// (c) Copyright 2026 Matt Messier
+
+package settings
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// optionField finds the Options field for a key, which may be either the
+// field's Go name, as used by the settings form, or its JSON name.
+func optionField(t reflect.Type, key string) (reflect.StructField, bool) {
+	if f, ok := t.FieldByName(key); ok {
+		return f, true
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("json")
+		if x := strings.IndexByte(name, ','); x != -1 {
+			name = name[:x]
+		}
+		if name == key {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// setOptionField sets a field from a JSON or form value, which may be either
+// the field's type or a string to be parsed.
+func setOptionField(f reflect.StructField, fv reflect.Value, value interface{}) error {
+	switch fv.Kind() {
+	case reflect.Bool:
+		switch v := value.(type) {
+		case bool:
+			fv.SetBool(v)
+		case string:
+			fv.SetBool(ParseBool(v))
+		default:
+			return fmt.Errorf("expected a boolean")
+		}
+	case reflect.Int:
+		var n int64
+		switch v := value.(type) {
+		case float64:
+			if v != float64(int64(v)) {
+				return fmt.Errorf("expected an integer")
+			}
+			n = int64(v)
+		case string:
+			var err error
+			if n, err = strconv.ParseInt(v, 0, 64); err != nil {
+				return fmt.Errorf("expected an integer")
+			}
+		default:
+			return fmt.Errorf("expected an integer")
+		}
+		if !validInt(f, n) {
+			return fmt.Errorf("%d is out of range", n)
+		}
+		fv.SetInt(n)
+	case reflect.String:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string")
+		}
+		if !validChoice(f, v) {
+			return fmt.Errorf("%q is not one of %s", v, f.Tag.Get("choices"))
+		}
+		if err := validString(f, v); err != nil {
+			return err
+		}
+		fv.SetString(v)
+	default:
+		return fmt.Errorf("cannot be set")
+	}
+	return nil
+}
+
+// ApplyOptions validates a set of option changes and, only if every one of
+// them is valid, applies them all at once. Listeners are notified once for
+// the whole set rather than once per option, so displays don't redraw with
+// half of the changes applied. Keys may be Go field names or JSON names.
+// It returns true if any option changed.
+func (s *Settings) ApplyOptions(changes map[string]interface{}) (bool, error) {
+	keys := make([]string, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	s.lock.Lock()
+	o := s.snapshot()
+	v := reflect.ValueOf(&o).Elem()
+	var problems []string
+	for _, key := range keys {
+		f, ok := optionField(v.Type(), key)
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: unknown option", key))
+			continue
+		}
+		if err := setOptionField(f, v.FieldByIndex(f.Index), changes[key]); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", key, err))
+		}
+	}
+	if len(problems) > 0 {
+		s.lock.Unlock()
+		return false, fmt.Errorf("invalid options: %s", strings.Join(problems, "; "))
+	}
+	changed := s.publish(o)
+	s.lock.Unlock()
+
+	if changed && s.update != nil {
+		s.update(strings.Join(keys, ","))
+	}
+	return changed, nil
+}
+
+// BatchHandler applies the option changes in a JSON object posted to it,
+// e.g. {"display_columns": 5, "message": "Last call"}, atomically.
+func (s *Settings) BatchHandler(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var changes map[string]interface{}
+	if err := json.NewDecoder(req.Body).Decode(&changes); err != nil {
+		http.Error(w, fmt.Sprintf("invalid JSON: %v", err), http.StatusBadRequest)
+		return
+	}
+	changed, err := s.ApplyOptions(changes)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if changed {
+		_ = s.Write()
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	fmt.Fprintf(w, "{\"changed\":%t,\"generation\":%d}\n", changed, s.Generation())
+}
@@ -6,12 +6,93 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 )
 
 func (s *Settings) BurbleDropzoneID() int {
 	return s.config.GetInt("burble.dzid")
 }
 
+// ChimeThresholds returns the call minutes at which chime events are sent,
+// e.g. 15, 10, 5, and 0 for "NOW".
+func (s *Settings) ChimeThresholds() []int {
+	return s.config.GetIntSlice("burble.chime_thresholds")
+}
+
+// Where jumpers with low or high pulls are placed among a load's sport
+// jumpers.
+const (
+	PullOrderFirst = "first"
+	PullOrderLast  = "last"
+	PullOrderNone  = "none" // sorted in with everyone else
+)
+
+// PullAltitudePolicy controls how jumpers are grouped by pull altitude.
+// Altitudes are in feet; an altitude of 0 disables that group.
+type PullAltitudePolicy struct {
+	LowAltitude    int
+	HighAltitude   int
+	StudentsExempt bool
+	LowOrder       string
+	HighOrder      string
+}
+
+// DefaultPullAltitudePolicy is the policy used when none is configured.
+var DefaultPullAltitudePolicy = PullAltitudePolicy{
+	LowAltitude: 3500,
+	LowOrder:    PullOrderLast,
+	HighOrder:   PullOrderNone,
+}
+
+func (s *Settings) PullAltitudePolicy() PullAltitudePolicy {
+	order := func(key string) string {
+		o := strings.ToLower(s.config.GetString(key))
+		switch o {
+		case PullOrderFirst, PullOrderLast, PullOrderNone:
+			return o
+		}
+		fmt.Fprintf(os.Stderr, "error: invalid %s %q\n", key, o)
+		return PullOrderNone
+	}
+	return PullAltitudePolicy{
+		LowAltitude:    s.config.GetInt("burble.pull_altitudes.low"),
+		HighAltitude:   s.config.GetInt("burble.pull_altitudes.high"),
+		StudentsExempt: s.config.GetBool("burble.pull_altitudes.students_exempt"),
+		LowOrder:       order("burble.pull_altitudes.low_order"),
+		HighOrder:      order("burble.pull_altitudes.high_order"),
+	}
+}
+
+// LoadProjectionInterval returns the time between loads assumed for an
+// aircraft when too few of its loads are manifested to measure it.
+func (s *Settings) LoadProjectionInterval() time.Duration {
+	return s.config.GetDuration("burble.projection.interval")
+}
+
+// LoadProjectionSunsetMargin returns how long before sunset the last load
+// must depart.
+func (s *Settings) LoadProjectionSunsetMargin() time.Duration {
+	return s.config.GetDuration("burble.projection.sunset_margin")
+}
+
+// ChurnCallMinutes returns how close to its call time a load must be for
+// jumpers being added or removed to count towards the load being volatile.
+func (s *Settings) ChurnCallMinutes() int {
+	return s.config.GetInt("burble.churn.call_minutes")
+}
+
+// ChurnWindow returns the period over which a load's jumper changes are
+// counted.
+func (s *Settings) ChurnWindow() time.Duration {
+	return s.config.GetDuration("burble.churn.window")
+}
+
+// ChurnThreshold returns the number of jumper changes within the churn
+// window that make a load volatile, or 0 to disable detection.
+func (s *Settings) ChurnThreshold() int {
+	return s.config.GetInt("burble.churn.threshold")
+}
+
 func (s *Settings) OrganizerStrings() []string {
 	o := s.config.GetStringSlice("burble.organizer_strings")
 	if len(o) == 0 {
@@ -62,3 +143,115 @@ func (s *Settings) GroupByJumpTypes() []GroupByJumpType {
 	}
 	return result
 }
+
+// AccountingCategory returns the accounting category configured for a
+// Burble jump type in burble.accounting_categories, or the default category
+// if the jump type has none.
+func (s *Settings) AccountingCategory(jumpType string) string {
+	categories, _ := s.config.Get("burble.accounting_categories").([]interface{})
+	for _, c := range categories {
+		cc, ccok := c.(map[string]interface{})
+		if !ccok {
+			continue
+		}
+		typ, tok := cc["type"].(string)
+		if !tok || !strings.EqualFold(typ, jumpType) {
+			continue
+		}
+		if category, cok := cc["category"].(string); cok {
+			return category
+		}
+		fmt.Fprintf(os.Stderr, "error: missing category for burble.accounting_categories type %s\n", typ)
+	}
+	return s.config.GetString("burble.default_accounting_category")
+}
+
+// FirstLoadCallMinutes returns the call time at which the first load of the
+// day is announced.
+func (s *Settings) FirstLoadCallMinutes() int {
+	return s.config.GetInt("burble.first_load.call_minutes")
+}
+
+// FirstLoadMessage returns the announcement for the first load call of the
+// day. "{minutes}" is replaced by the call time.
+func (s *Settings) FirstLoadMessage() string {
+	return s.config.GetString("burble.first_load.message")
+}
+
+// FirstLoadDuration returns how long the first load announcement is shown.
+func (s *Settings) FirstLoadDuration() time.Duration {
+	return s.config.GetDuration("burble.first_load.duration")
+}
+
+// Counter states select which jumpers a counter counts.
+const (
+	CountManifested = "manifested" // on loads that haven't departed
+	CountDeparted   = "departed"   // on loads that departed today
+	CountAll        = "all"
+)
+
+// Counter is a running count of jumpers of some jump types, e.g. the number
+// of tandems still to go up today.
+type Counter struct {
+	Name      string
+	Label     string
+	JumpTypes []string // lower case; a trailing * matches any suffix
+	Count     string
+}
+
+// Matches returns true if the counter counts jumpers of a jump type.
+func (c Counter) Matches(jumpType string) bool {
+	jumpType = strings.ToLower(strings.TrimSpace(jumpType))
+	for _, t := range c.JumpTypes {
+		if prefix := strings.TrimSuffix(t, "*"); prefix != t {
+			if strings.HasPrefix(jumpType, prefix) {
+				return true
+			}
+		} else if jumpType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Counters returns the counters configured in burble.counters.
+func (s *Settings) Counters() []Counter {
+	counters, _ := s.config.Get("burble.counters").([]interface{})
+	result := make([]Counter, 0, len(counters))
+	for _, c := range counters {
+		cc, ccok := c.(map[string]interface{})
+		if !ccok {
+			continue
+		}
+		name, nok := cc["name"].(string)
+		if !nok {
+			fmt.Fprintf(os.Stderr, "error: missing name for burble.counters entry\n")
+			continue
+		}
+		r := Counter{
+			Name:  name,
+			Label: name,
+			Count: CountAll,
+		}
+		if label, lok := cc["label"].(string); lok {
+			r.Label = label
+		}
+		if count, cok := cc["count"].(string); cok {
+			switch count = strings.ToLower(count); count {
+			case CountManifested, CountDeparted, CountAll:
+				r.Count = count
+			default:
+				fmt.Fprintf(os.Stderr, "error: invalid count %q for burble.counters %s\n", count, name)
+				continue
+			}
+		}
+		types, _ := cc["jump_types"].([]interface{})
+		for _, t := range types {
+			if typ, tok := t.(string); tok {
+				r.JumpTypes = append(r.JumpTypes, strings.ToLower(strings.TrimSpace(typ)))
+			}
+		}
+		result = append(result, r)
+	}
+	return result
+}
@@ -2,6 +2,8 @@
 
 package settings
 
+import "time"
+
 func (s *Settings) DatabaseDriver() string {
 	return s.config.GetString("database.driver")
 }
@@ -9,3 +11,22 @@ func (s *Settings) DatabaseDriver() string {
 func (s *Settings) DatabaseFilename() string {
 	return s.config.GetString("database.filename")
 }
+
+// EventRetention returns how long manifest events are kept in the event log.
+// 0 keeps them forever.
+func (s *Settings) EventRetention() time.Duration {
+	return s.config.GetDuration("database.event_retention")
+}
+
+// SharedState returns true if the options and jumprun are shared through the
+// database with other servers using the same database file. Only the options
+// and jumprun are shared; each server still fetches its own data sources.
+func (s *Settings) SharedState() bool {
+	return s.config.GetBool("database.shared_state")
+}
+
+// SharedStatePollInterval returns how often the database is checked for
+// shared state changed by other servers.
+func (s *Settings) SharedStatePollInterval() time.Duration {
+	return s.config.GetDuration("database.shared_state_poll_interval")
+}
@@ -2,37 +2,127 @@
 
 package settings
 
+import "time"
+
 var defaults = map[string]interface{}{
-	"options_file": "/var/lib/manifest-server/options.json",
-	"timezone":     "America/New_York",
+	"options_file":    "/var/lib/manifest-server/options.json",
+	"timezone":        "America/New_York",
+	"headings":        "magnetic",
+	"field_elevation": 0,
 
 	"server.http_address":  ":http",
 	"server.https_address": ":https",
 	"server.grpc_address":  ":9090",
+	"server.fetch_timeout": 30 * time.Second,
 	"server.cert_file":     nil,
 	"server.key_file":      nil,
 
-	"burble.dzid": 417,
+	"database.shared_state":               false,
+	"database.shared_state_poll_interval": 2 * time.Second,
+	"database.event_retention":            30 * 24 * time.Hour,
+
+	"alerts.display.min_priority": "info",
+	"alerts.degraded_sources":     2,
 
-	"jumprun.enabled":              false,
-	"jumprun.latitude":             "42.5700",
-	"jumprun.longitude":            "-72.2885",
-	"jumprun.magnetic_declination": -14,
-	"jumprun.camera_height":        22000,
-	"jumprun.state_file":           "/var/lib/manifest-server/jumprun.json",
+	"burble.dzid":                        417,
+	"burble.refresh.interval":            10 * time.Second,
+	"burble.refresh.max_backoff":         2 * time.Minute,
+	"burble.refresh.closed_interval":     15 * time.Minute,
+	"burble.chime_thresholds":            []int{15, 10, 5, 0},
+	"burble.default_accounting_category": "Uncategorized",
+	"burble.pull_altitudes.low":          3500,
+	"burble.pull_altitudes.high":         0,
+	"burble.pull_altitudes.low_order":    "last",
+	"burble.pull_altitudes.high_order":   "none",
+	"burble.projection.interval":         30 * time.Minute,
+	"burble.projection.sunset_margin":    30 * time.Minute,
+	"burble.first_load.call_minutes":     20,
+	"burble.first_load.message":          "First load {minutes} minute call!",
+	"burble.first_load.duration":         10 * time.Minute,
+	"burble.churn.call_minutes":          15,
+	"burble.churn.window":                5 * time.Minute,
+	"burble.churn.threshold":             3,
 
-	"metar.enabled": true,
-	"metar.station": "KORE",
+	"jumprun.enabled":               false,
+	"jumprun.latitude":              "42.5700",
+	"jumprun.longitude":             "-72.2885",
+	"jumprun.camera_height":         22000,
+	"jumprun.airspeed":              85,
+	"jumprun.history_file":          "/var/lib/manifest-server/jumprun_history.json",
+	"jumprun.history_size":          20,
+	"jumprun.state_file":            "/var/lib/manifest-server/jumprun.json",
+	"jumprun.exit_order.enabled":    false,
+	"jumprun.exit_order.order":      []string{"hop_and_pop", "belly", "freefly", "tracking", "student", "tandem", "wingsuit"},
+	"jumprun.exit_order.change":     3,
+	"jumprun.spot.opening_altitude": 3000,
+	"jumprun.spot.freefall_rate":    176,
+	"jumprun.spot.canopy_rate":      15,
+	"jumprun.spot.group_spacing":    1000,
 
-	"winds.enabled":   true,
-	"winds.latitude":  "42.5700",
-	"winds.longitude": "-72.2885",
+	"metar.enabled":                 true,
+	"metar.station":                 "KORE",
+	"metar.smoothing_minutes":       60,
+	"metar.max_age":                 90 * time.Minute,
+	"metar.stale_after":             2 * time.Hour,
+	"metar.history_size":            12,
+	"metar.rate_limit_pause":        10 * time.Minute,
+	"metar.no_data_retry":           15 * time.Minute,
+	"metar.field_elevation":         0,
+	"metar.state_file":              "/var/lib/manifest-server/metar.json",
+	"metar.ceilings.tandem":         8000,
+	"metar.ceilings.student":        5500,
+	"metar.hold.enabled":            false,
+	"metar.hold.wind_speed":         0,
+	"metar.hold.gust":               0,
+	"metar.hold.margin":             2,
+	"metar.hold.start_readings":     3,
+	"metar.hold.clear_after":        15 * time.Minute,
+	"metar.refresh.interval":        5 * time.Minute,
+	"metar.refresh.max_backoff":     30 * time.Minute,
+	"metar.refresh.closed_interval": 15 * time.Minute,
+
+	"winds.enabled":                   true,
+	"winds.latitude":                  "42.5700",
+	"winds.longitude":                 "-72.2885",
+	"winds.provider":                  "markschulze",
+	"winds.refresh.interval":          15 * time.Minute,
+	"winds.refresh.max_backoff":       time.Hour,
+	"winds.refresh.closed_interval":   time.Hour,
+	"winds.exit_altitude":             13000,
+	"winds.history_size":              8,
+	"winds.trend_window":              3 * time.Hour,
+	"winds.stale_after":               time.Hour,
+	"winds.state_file":                "/var/lib/manifest-server/winds.json",
+	"winds.shear.direction":           60,
+	"winds.shear.speed":               15,
+	"winds.high_uppers.red_line":      85,
+	"winds.high_uppers.ground_speed":  0,
+	"winds.high_uppers.message":       "Winds are {speed} knots",
+	"winds.raw.rate_limit":            12,
+	"winds.canopy_advisory.altitude":  1000,
+	"winds.canopy_advisory.direction": 90,
+	"winds.canopy_advisory.min_speed": 5,
+	"winds.canopy_advisory.message":   "Expect downwind setups at pattern altitude",
 }
 
 var defaultOptions = Options{
 	DisplayWeather: true,
 	DisplayWinds:   true,
+	DisplayStandby: false,
+	Units:          UnitsImperial,
 	DisplayColumns: 5,
+	DisplayRows:    0,
 	MinCallMinutes: -10,
+	FirstLoadCall:  false,
+	Recurring:      "",
 	FuelRequested:  false,
+	AutomaticHold:  false,
+	ApparentTemp:   false,
+
+	WindsYellowSpeed:      14,
+	WindsYellowGust:       17,
+	WindsYellowGustFactor: 7,
+	WindsRedSpeed:         17,
+	WindsRedGust:          25,
+	WindsRedGustFactor:    12,
 }
@@ -0,0 +1,51 @@
+// (c) Copyright 2026 Matt Messier
+
+package settings
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// NewDemoSettings returns settings for running in demo mode without a
+// config file.
+func NewDemoSettings() *Settings {
+	s := newSettings()
+	s.config.Set("server.http_address", ":8080")
+	s.EnableDemoMode()
+	return s
+}
+
+// EnableDemoMode switches to canned data sources that make no outbound
+// network requests. State is kept in a temporary directory so that demo
+// mode never touches a real installation's files, and Sign In With Apple
+// is disabled since it requires credentials.
+func (s *Settings) EnableDemoMode() {
+	dir := filepath.Join(os.TempDir(), "manifest-server-demo")
+	_ = os.MkdirAll(dir, 0700)
+
+	s.config.Set("demo", true)
+	s.config.Set("options_file", filepath.Join(dir, "options.json"))
+	s.config.Set("database.driver", "sqlite3")
+	s.config.Set("database.filename", filepath.Join(dir, "database.sqlite3"))
+	s.config.Set("jumprun.enabled", true)
+	s.config.Set("jumprun.state_file", filepath.Join(dir, "jumprun.json"))
+	s.config.Set("jumprun.history_file", filepath.Join(dir, "jumprun_history.json"))
+	s.config.Set("metar.state_file", filepath.Join(dir, "metar.json"))
+	s.config.Set("winds.state_file", filepath.Join(dir, "winds.json"))
+	s.config.Set("metar.enabled", true)
+	s.config.Set("winds.enabled", true)
+	s.config.Set("server.cert_file", "")
+	s.config.Set("server.key_file", "")
+	s.config.Set("server.https_address", "")
+	s.config.Set("operating_hours.start", "")
+	s.config.Set("operating_hours.days", []string{})
+	if err := s.parseConfig(); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid config: %v\n", err)
+	}
+}
+
+func (s *Settings) DemoMode() bool {
+	return s.config.GetBool("demo")
+}
@@ -0,0 +1,134 @@
+// (c) Copyright 2026 Matt Messier
+
+package settings
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type formField struct {
+	ID      string
+	Label   string
+	Type    string
+	Value   interface{}
+	Checked bool
+	Min     string
+	Max     string
+	Size    string
+	Rows    string
+	Choices []string
+}
+
+type formGroup struct {
+	Name   string
+	Fields []formField
+}
+
+// formGroups builds the settings form from the Options struct tags. Groups
+// appear in the order of their first field.
+func formGroups(o *Options) []formGroup {
+	var groups []formGroup
+	index := make(map[string]int)
+
+	v := reflect.ValueOf(o).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		label, ok := f.Tag.Lookup("label")
+		if !ok {
+			continue
+		}
+
+		field := formField{
+			ID:    f.Name,
+			Label: label,
+			Value: v.Field(i).Interface(),
+			Min:   f.Tag.Get("min"),
+			Max:   f.Tag.Get("max"),
+			Size:  f.Tag.Get("size"),
+			Rows:  f.Tag.Get("rows"),
+		}
+		switch f.Type.Kind() {
+		case reflect.Bool:
+			field.Type = "checkbox"
+			field.Checked = v.Field(i).Bool()
+		case reflect.Int:
+			field.Type = "number"
+		default:
+			field.Type = "text"
+			if choices := fieldChoices(f); len(choices) > 0 {
+				field.Type = "select"
+				field.Choices = choices
+			} else if field.Rows != "" {
+				field.Type = "textarea"
+			}
+		}
+
+		name := f.Tag.Get("group")
+		x, ok := index[name]
+		if !ok {
+			x = len(groups)
+			index[name] = x
+			groups = append(groups, formGroup{Name: name})
+		}
+		groups[x].Fields = append(groups[x].Fields, field)
+	}
+	return groups
+}
+
+// fieldChoices returns the values that the field's struct tags allow, or nil
+// if any value is allowed.
+func fieldChoices(f reflect.StructField) []string {
+	tag := f.Tag.Get("choices")
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+// validChoice returns true if s is one of the choices specified by the
+// field's struct tags, or if the field doesn't specify any.
+func validChoice(f reflect.StructField, s string) bool {
+	choices := fieldChoices(f)
+	if choices == nil {
+		return true
+	}
+	for _, choice := range choices {
+		if s == choice {
+			return true
+		}
+	}
+	return false
+}
+
+// validators check the values of string fields that name them with the
+// validate struct tag.
+var validators = map[string]func(string) error{
+	"recurring_messages": func(s string) error {
+		_, err := ParseRecurringMessages(s)
+		return err
+	},
+}
+
+// validString returns an error if s fails the validate function named by
+// the field's struct tags.
+func validString(f reflect.StructField, s string) error {
+	if validate, ok := validators[f.Tag.Get("validate")]; ok {
+		return validate(s)
+	}
+	return nil
+}
+
+// validInt returns true if n is within the min and max specified by the
+// field's struct tags.
+func validInt(f reflect.StructField, n int64) bool {
+	if min, err := strconv.ParseInt(f.Tag.Get("min"), 0, 64); err == nil && n < min {
+		return false
+	}
+	if max, err := strconv.ParseInt(f.Tag.Get("max"), 0, 64); err == nil && n > max {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,21 @@
+// (c) Copyright 2026 Matt Messier
+
+package settings
+
+import "strings"
+
+const (
+	MagneticHeadingReference = "magnetic"
+	TrueHeadingReference     = "true"
+)
+
+func (s *Settings) HeadingReference() string {
+	if strings.ToLower(s.config.GetString("headings")) == TrueHeadingReference {
+		return TrueHeadingReference
+	}
+	return MagneticHeadingReference
+}
+
+func (s *Settings) MagneticHeadings() bool {
+	return s.HeadingReference() == MagneticHeadingReference
+}
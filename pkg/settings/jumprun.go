@@ -2,6 +2,18 @@
 
 package settings
 
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/geomag"
+	"github.com/spf13/cast"
+)
+
 func (s *Settings) JumprunEnabled() bool {
 	return s.config.GetBool("jumprun.enabled")
 }
@@ -14,14 +26,205 @@ func (s *Settings) JumprunLongitude() string {
 	return s.config.GetString("jumprun.longitude")
 }
 
+// ExitOrderEnabled returns true if a suggested exit order is published with
+// each load.
+func (s *Settings) ExitOrderEnabled() bool {
+	return s.config.GetBool("jumprun.exit_order.enabled")
+}
+
+// ExitOrderDisciplines returns the disciplines in the order they exit.
+func (s *Settings) ExitOrderDisciplines() []string {
+	var disciplines []string
+	for _, d := range s.config.GetStringSlice("jumprun.exit_order.order") {
+		disciplines = append(disciplines, strings.ToLower(strings.TrimSpace(d)))
+	}
+	return disciplines
+}
+
+// ExitOrderChangeSeconds returns the extra separation in seconds between
+// groups of different disciplines.
+func (s *Settings) ExitOrderChangeSeconds() int {
+	return s.config.GetInt("jumprun.exit_order.change")
+}
+
+// SpotOpeningAltitude returns the altitude in feet AGL at which jumpers are
+// taken to open when computing their drift.
+func (s *Settings) SpotOpeningAltitude() int {
+	return s.config.GetInt("jumprun.spot.opening_altitude")
+}
+
+// SpotFreefallRate returns the freefall descent rate in feet per second.
+func (s *Settings) SpotFreefallRate() float64 {
+	return s.config.GetFloat64("jumprun.spot.freefall_rate")
+}
+
+// SpotCanopyRate returns the canopy descent rate in feet per second.
+func (s *Settings) SpotCanopyRate() float64 {
+	return s.config.GetFloat64("jumprun.spot.canopy_rate")
+}
+
+// SpotGroupSpacing returns the distance in feet over the ground between
+// groups exiting on the same pass.
+func (s *Settings) SpotGroupSpacing() int {
+	return s.config.GetInt("jumprun.spot.group_spacing")
+}
+
 func (s *Settings) JumprunStateFile() string {
 	return s.config.GetString("jumprun.state_file")
 }
 
-func (s *Settings) JumprunMagneticDeclination() int {
-	return s.config.GetInt("jumprun.magnetic_declination")
+// JumprunHistoryFile returns the file in which the history of jumpruns that
+// have been set is kept, or an empty string to not keep it across restarts.
+func (s *Settings) JumprunHistoryFile() string {
+	return s.config.GetString("jumprun.history_file")
+}
+
+// JumprunHistorySize returns how many jumpruns that have been set are kept
+// so that they can be restored.
+func (s *Settings) JumprunHistorySize() int {
+	return s.config.GetInt("jumprun.history_size")
+}
+
+// MagneticDeclination returns the magnetic declination at the DZ in whole
+// degrees, east positive, so that adding it to a magnetic heading gives the
+// true heading.
+func (s *Settings) MagneticDeclination() int {
+	return s.declination
+}
+
+// loadMagneticDeclination computes the magnetic declination from the World
+// Magnetic Model at the jumprun coordinates, or the winds aloft coordinates
+// if those aren't valid, unless jumprun.magnetic_declination overrides it.
+func (s *Settings) loadMagneticDeclination() (int, error) {
+	if s.config.IsSet("jumprun.magnetic_declination") {
+		return s.config.GetInt("jumprun.magnetic_declination"), nil
+	}
+	latitude, latok := toFloat(s.JumprunLatitude())
+	longitude, lonok := toFloat(s.JumprunLongitude())
+	if !latok || !lonok {
+		latitude, latok = toFloat(s.WindsLatitude())
+		longitude, lonok = toFloat(s.WindsLongitude())
+	}
+	if !latok || !lonok {
+		return 0, fmt.Errorf("cannot compute magnetic declination without valid jumprun or winds coordinates")
+	}
+	return int(math.Round(geomag.Declination(latitude, longitude, 0, time.Now()))), nil
 }
 
 func (s *Settings) JumprunCameraHeight() int {
 	return s.config.GetInt("jumprun.camera_height")
 }
+
+// JumprunAirspeed returns the true airspeed in knots at which jump run is
+// flown.
+func (s *Settings) JumprunAirspeed() int {
+	return s.config.GetInt("jumprun.airspeed")
+}
+
+// loadAircraft parses jumprun.aircraft. Each aircraft is either its
+// airspeed or a map of its settings. The result maps each lower case
+// aircraft name to its settings.
+func (s *Settings) loadAircraft() (map[string]map[string]int, error) {
+	result := make(map[string]map[string]int)
+	for name, v := range s.config.GetStringMap("jumprun.aircraft") {
+		settings := make(map[string]int)
+		m, err := cast.ToStringMapE(v)
+		if err != nil {
+			m = map[string]interface{}{"airspeed": v}
+		}
+		for setting, v := range m {
+			value, err := strconv.Atoi(fmt.Sprint(v))
+			if err != nil || value <= 0 {
+				return nil, fmt.Errorf("invalid %s %v for jumprun.aircraft %q", setting, v, name)
+			}
+			settings[strings.ToLower(setting)] = value
+		}
+		result[strings.ToLower(strings.TrimSpace(name))] = settings
+	}
+	return result, nil
+}
+
+// aircraftSetting returns the named setting of the named aircraft from
+// jumprun.aircraft. ok is false if the aircraft doesn't have the setting.
+func (s *Settings) aircraftSetting(aircraft, setting string) (value int, ok bool) {
+	value, ok = s.aircraft[strings.ToLower(strings.TrimSpace(aircraft))][setting]
+	return value, ok
+}
+
+// AircraftAirspeed returns the true airspeed in knots at which the named
+// aircraft flies jump run. Aircraft that aren't configured use the jump run
+// airspeed.
+func (s *Settings) AircraftAirspeed(aircraft string) int {
+	if airspeed, ok := s.aircraftSetting(aircraft, "airspeed"); ok {
+		return airspeed
+	}
+	return s.JumprunAirspeed()
+}
+
+// AircraftExitAltitude returns the altitude in feet AGL at which jumpers
+// exit the named aircraft. Aircraft that aren't configured use the winds
+// aloft exit altitude.
+func (s *Settings) AircraftExitAltitude(aircraft string) int {
+	if altitude, ok := s.aircraftSetting(aircraft, "exit_altitude"); ok {
+		return altitude
+	}
+	return s.WindsExitAltitude()
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+type Coordinate struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// JumprunBoundary returns the polygon that jumprun ground tracks must stay
+// within, or nil if no boundary is configured.
+func (s *Settings) JumprunBoundary() []Coordinate {
+	points, ok := s.config.Get("jumprun.boundary").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]Coordinate, 0, len(points))
+	for _, p := range points {
+		pp, ppok := p.(map[string]interface{})
+		if !ppok {
+			continue
+		}
+		latitude, latok := toFloat(pp["latitude"])
+		longitude, lonok := toFloat(pp["longitude"])
+		if !latok || !lonok {
+			fmt.Fprintf(os.Stderr, "error: invalid point in jumprun.boundary: %v\n", p)
+			continue
+		}
+		result = append(result, Coordinate{
+			Latitude:  latitude,
+			Longitude: longitude,
+		})
+	}
+	if len(result) < 3 {
+		if len(result) > 0 {
+			fmt.Fprintf(os.Stderr, "error: jumprun.boundary needs at least 3 points\n")
+		}
+		return nil
+	}
+	return result
+}
+
+// JumprunMaxLength returns the maximum total length of a jumprun including
+// hook turns in tenths of a mile, or 0 for no limit.
+func (s *Settings) JumprunMaxLength() int {
+	return s.config.GetInt("jumprun.max_length")
+}
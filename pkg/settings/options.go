@@ -2,53 +2,188 @@
 
 package settings
 
+// Options are described by their struct tags, from which the settings form
+// is generated. Fields without a label are not shown in the form. Integer
+// fields may specify min and max, which are enforced when set from the
+// form. String fields may specify a comma-separated list of choices, which
+// are shown as a menu and are the only values accepted, or rows, which are
+// shown as a multi-line text area. String fields may also name a validate
+// function that the value must pass.
 type Options struct {
-	DisplayWeather bool   `json:"display_weather"`
-	DisplayWinds   bool   `json:"display_winds"`
-	DisplayColumns int    `json:"display_columns"`
-	MinCallMinutes int    `json:"min_call_minutes"`
-	Message        string `json:"message"`
+	DisplayWeather bool   `json:"display_weather" group:"Display" label:"Display weather information"`
+	DisplayWinds   bool   `json:"display_winds" group:"Display" label:"Display winds aloft information"`
+	DisplayStandby bool   `json:"display_standby" group:"Display" label:"Display the standby list"`
+	Units          string `json:"units" group:"Display" label:"Units" choices:"imperial,metric,both"`
+	DisplayColumns int    `json:"display_columns" group:"Manifest" label:"# Manifest loads to display" min:"1" max:"10"`
+	DisplayRows    int    `json:"display_rows" group:"Manifest" label:"Rows per column (0 for no limit)" min:"0" max:"100"`
+	MinCallMinutes int    `json:"min_call_minutes" group:"Manifest" label:"Minimum call time to display" min:"-60" max:"0"`
+	Message        string `json:"message" group:"Message" label:"Message" size:"80"`
+	FirstLoadCall  bool   `json:"first_load_call" group:"Message" label:"Announce the first load call of the day"`
+	Recurring      string `json:"recurring_messages" group:"Message" label:"Recurring messages, one per line (e.g. Sat-Sun 10/21-10/29: Last weekend of the season!)" rows:"4" size:"80" validate:"recurring_messages"`
+	WeatherHold    bool   `json:"weather_hold" group:"Weather" label:"Weather hold"`
+	ApparentTemp   bool   `json:"display_apparent_temperature" group:"Weather" label:"Display wind chill / heat index"`
 	FuelRequested  bool   `json:"fuel_requested"`
+	AutomaticHold  bool   `json:"weather_hold_automatic"` // the weather hold was started automatically
+
+	// Winds are shown in yellow or red when any of the sustained speed,
+	// gust speed, or gust factor reaches its threshold in MPH.
+	WindsYellowSpeed      int `json:"winds_yellow_speed" group:"Wind colors" label:"Yellow sustained wind MPH (0 to disable)" min:"0" max:"100"`
+	WindsYellowGust       int `json:"winds_yellow_gust" group:"Wind colors" label:"Yellow gust MPH (0 to disable)" min:"0" max:"100"`
+	WindsYellowGustFactor int `json:"winds_yellow_gust_factor" group:"Wind colors" label:"Yellow gust factor MPH (0 to disable)" min:"0" max:"100"`
+	WindsRedSpeed         int `json:"winds_red_speed" group:"Wind colors" label:"Red sustained wind MPH (0 to disable)" min:"0" max:"100"`
+	WindsRedGust          int `json:"winds_red_gust" group:"Wind colors" label:"Red gust MPH (0 to disable)" min:"0" max:"100"`
+	WindsRedGustFactor    int `json:"winds_red_gust_factor" group:"Wind colors" label:"Red gust factor MPH (0 to disable)" min:"0" max:"100"`
 }
 
-func (s *Settings) Message() string {
+// WindThresholds are the wind speeds in MPH at which winds are shown in a
+// color. Zero thresholds are disabled.
+type WindThresholds struct {
+	Speed      int
+	Gust       int
+	GustFactor int
+}
+
+// Exceeded returns true if any of the winds reach their threshold.
+func (t WindThresholds) Exceeded(speed, gust float64) bool {
+	if t.Speed > 0 && speed >= float64(t.Speed) {
+		return true
+	}
+	if gust <= speed {
+		return false
+	}
+	return (t.Gust > 0 && gust >= float64(t.Gust)) ||
+		(t.GustFactor > 0 && gust-speed >= float64(t.GustFactor))
+}
+
+// SetOptions replaces all of the options, returning true if they changed.
+func (s *Settings) SetOptions(o Options) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	return s.options.Message
+	return s.publish(o)
+}
+
+func (s *Settings) Message() string {
+	return s.snapshot().Message
 }
 
 func (s *Settings) DisplayWeather() bool {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	return s.options.DisplayWeather
+	return s.snapshot().DisplayWeather
 }
 
 func (s *Settings) DisplayWinds() bool {
+	return s.snapshot().DisplayWinds
+}
+
+func (s *Settings) DisplayStandby() bool {
+	return s.snapshot().DisplayStandby
+}
+
+// Units for displaying temperatures, speeds, altitudes, and distances.
+const (
+	UnitsImperial = "imperial"
+	UnitsMetric   = "metric"
+	UnitsBoth     = "both"
+)
+
+// Units returns the units in which measurements are displayed: UnitsImperial,
+// UnitsMetric, or UnitsBoth.
+func (s *Settings) Units() string {
+	switch units := s.snapshot().Units; units {
+	case UnitsMetric, UnitsBoth:
+		return units
+	}
+	return UnitsImperial
+}
+
+// DisplayApparentTemperature returns true if the wind chill or heat index is
+// shown on the status display.
+func (s *Settings) DisplayApparentTemperature() bool {
+	return s.snapshot().ApparentTemp
+}
+
+func (s *Settings) FirstLoadCall() bool {
+	return s.snapshot().FirstLoadCall
+}
+
+func (s *Settings) WeatherHold() bool {
+	return s.snapshot().WeatherHold
+}
+
+// SetWeatherHold sets or clears the weather hold by hand, returning true if
+// the options changed. A hold set by hand isn't cleared automatically.
+func (s *Settings) SetWeatherHold(b bool) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	return s.options.DisplayWinds
+	o := s.snapshot()
+	o.WeatherHold = b
+	o.AutomaticHold = false
+	return s.publish(o)
 }
 
-func (s *Settings) DisplayColumns() int {
+// AutomaticWeatherHold returns true if the weather hold was started
+// automatically, so that it's cleared automatically too.
+func (s *Settings) AutomaticWeatherHold() bool {
+	o := s.snapshot()
+	return o.WeatherHold && o.AutomaticHold
+}
+
+// SetAutomaticWeatherHold starts or clears an automatic weather hold.
+func (s *Settings) SetAutomaticWeatherHold(b bool) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	return s.options.DisplayColumns
+	o := s.snapshot()
+	o.WeatherHold = b
+	o.AutomaticHold = b
+	s.publish(o)
+}
+
+// WindsYellowThresholds returns the wind speeds at which winds are shown in
+// yellow.
+func (o Options) WindsYellowThresholds() WindThresholds {
+	return WindThresholds{
+		Speed:      o.WindsYellowSpeed,
+		Gust:       o.WindsYellowGust,
+		GustFactor: o.WindsYellowGustFactor,
+	}
+}
+
+// WindsRedThresholds returns the wind speeds at which winds are shown in red.
+func (o Options) WindsRedThresholds() WindThresholds {
+	return WindThresholds{
+		Speed:      o.WindsRedSpeed,
+		Gust:       o.WindsRedGust,
+		GustFactor: o.WindsRedGustFactor,
+	}
+}
+
+func (s *Settings) WindsYellowThresholds() WindThresholds {
+	return s.snapshot().WindsYellowThresholds()
+}
+
+func (s *Settings) WindsRedThresholds() WindThresholds {
+	return s.snapshot().WindsRedThresholds()
+}
+
+func (s *Settings) DisplayColumns() int {
+	return s.snapshot().DisplayColumns
+}
+
+func (s *Settings) DisplayRows() int {
+	return s.snapshot().DisplayRows
 }
 
 func (s *Settings) MinCallMinutes() int {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	return s.options.MinCallMinutes
+	return s.snapshot().MinCallMinutes
 }
 
 func (s *Settings) FuelRequested() bool {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	return s.options.FuelRequested
+	return s.snapshot().FuelRequested
 }
 
 func (s *Settings) SetFuelRequested(b bool) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	s.options.FuelRequested = b
+	o := s.snapshot()
+	o.FuelRequested = b
+	s.publish(o)
 }
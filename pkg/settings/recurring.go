@@ -0,0 +1,158 @@
+// (c) Copyright 2026 Matt Messier
+
+package settings
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurringMessage is a message shown on a schedule of days of the week and
+// dates of the year, e.g. "Sat: Free coaching 9-11" or "10/21-10/29 Sat-Sun:
+// Last weekend of the season!". A schedule without days is shown every day
+// of the week, and one without dates is shown all year.
+type RecurringMessage struct {
+	Days    map[time.Weekday]bool // nil for every day of the week
+	Dates   [][2]monthDay         // inclusive ranges; empty for every date
+	Message string
+}
+
+// monthDay is a date of the year, as month*100 + day.
+type monthDay int
+
+func (d monthDay) within(start, end monthDay) bool {
+	if start <= end {
+		return d >= start && d <= end
+	}
+	// The range spans the new year.
+	return d >= start || d <= end
+}
+
+// Applies returns true if the message is shown on the day of t.
+func (m RecurringMessage) Applies(t time.Time) bool {
+	if m.Days != nil && !m.Days[t.Weekday()] {
+		return false
+	}
+	if len(m.Dates) == 0 {
+		return true
+	}
+	today := monthDay(int(t.Month())*100 + t.Day())
+	for _, r := range m.Dates {
+		if today.within(r[0], r[1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Text returns the message as shown on the day of t, with {weekday} and
+// {date} replaced by e.g. "Saturday" and "October 21".
+func (m RecurringMessage) Text(t time.Time) string {
+	return strings.NewReplacer(
+		"{weekday}", t.Weekday().String(),
+		"{date}", t.Format("January 2"),
+	).Replace(m.Message)
+}
+
+// ParseRecurringMessages parses recurring messages, one per line, each a
+// schedule followed by a colon and the message. A schedule is any number of
+// days of the week (Sat), lists of them (Sat,Sun), or ranges (Fri-Sun), and
+// dates of the year (10/29) or ranges of them (10/21-10/29). Blank lines
+// are ignored.
+func ParseRecurringMessages(s string) ([]RecurringMessage, error) {
+	var messages []RecurringMessage
+	for i, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		x := strings.IndexByte(line, ':')
+		if x == -1 {
+			return nil, fmt.Errorf("line %d: expected a schedule and a message separated by a colon", i+1)
+		}
+		m := RecurringMessage{Message: strings.TrimSpace(line[x+1:])}
+		if m.Message == "" {
+			return nil, fmt.Errorf("line %d: the message is empty", i+1)
+		}
+		for _, field := range strings.Fields(strings.ReplaceAll(line[:x], ",", " ")) {
+			if err := m.parseSchedule(field); err != nil {
+				return nil, fmt.Errorf("line %d: %v", i+1, err)
+			}
+		}
+		messages = append(messages, m)
+	}
+	return messages, nil
+}
+
+func (m *RecurringMessage) parseSchedule(field string) error {
+	first, last := field, field
+	if x := strings.IndexByte(field, '-'); x != -1 {
+		first, last = field[:x], field[x+1:]
+	}
+
+	if start, ok := parseWeekday(first); ok {
+		end, ok := parseWeekday(last)
+		if !ok {
+			return fmt.Errorf("%q is not a day of the week", last)
+		}
+		if m.Days == nil {
+			m.Days = make(map[time.Weekday]bool)
+		}
+		for day := start; ; day = (day + 1) % 7 {
+			m.Days[day] = true
+			if day == end {
+				break
+			}
+		}
+		return nil
+	}
+
+	start, err := parseMonthDay(first)
+	if err != nil {
+		return err
+	}
+	end, err := parseMonthDay(last)
+	if err != nil {
+		return err
+	}
+	m.Dates = append(m.Dates, [2]monthDay{start, end})
+	return nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	s = strings.ToLower(s)
+	if len(s) < 3 {
+		return time.Sunday, false
+	}
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.HasPrefix(strings.ToLower(day.String()), s) {
+			return day, true
+		}
+	}
+	return time.Sunday, false
+}
+
+func parseMonthDay(s string) (monthDay, error) {
+	x := strings.IndexByte(s, '/')
+	if x == -1 {
+		return 0, fmt.Errorf("%q is not a day of the week or a month/day date", s)
+	}
+	month, err := strconv.Atoi(s[:x])
+	if err != nil || month < 1 || month > 12 {
+		return 0, fmt.Errorf("%q has an invalid month", s)
+	}
+	day, err := strconv.Atoi(s[x+1:])
+	if err != nil || day < 1 || day > 31 {
+		return 0, fmt.Errorf("%q has an invalid day", s)
+	}
+	return monthDay(month*100 + day), nil
+}
+
+// RecurringMessages returns the recurring messages set on the settings page.
+// They are validated when set, so any that can't be parsed are ignored.
+func (s *Settings) RecurringMessages() []RecurringMessage {
+	messages, _ := ParseRecurringMessages(s.snapshot().Recurring)
+	return messages
+}
@@ -0,0 +1,107 @@
+// (c) Copyright 2026 Matt Messier
+
+package settings
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RefreshPolicy controls how often a data source is refreshed.
+type RefreshPolicy struct {
+	// Interval is the time between successful refreshes.
+	Interval time.Duration
+
+	// MaxBackoff is the longest time to wait between failed refreshes.
+	// The interval doubles with each consecutive failure up to this
+	// limit. Zero disables backoff.
+	MaxBackoff time.Duration
+
+	// Jitter is the maximum random amount of time added to or removed
+	// from each interval.
+	Jitter time.Duration
+
+	// ClosedInterval is the time between refreshes outside of operating
+	// hours. Zero means that the source is not refreshed at all until
+	// operating hours begin again.
+	ClosedInterval time.Duration
+}
+
+// RefreshPolicy returns the refresh policy for the named data source, which
+// is the name of the source's configuration section (e.g., "burble").
+func (s *Settings) RefreshPolicy(source string) RefreshPolicy {
+	return RefreshPolicy{
+		Interval:       s.config.GetDuration(source + ".refresh.interval"),
+		MaxBackoff:     s.config.GetDuration(source + ".refresh.max_backoff"),
+		Jitter:         s.config.GetDuration(source + ".refresh.jitter"),
+		ClosedInterval: s.config.GetDuration(source + ".refresh.closed_interval"),
+	}
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// operatingHours are the parsed operating_hours settings.
+type operatingHours struct {
+	start, end time.Duration
+	ok         bool
+	days       []time.Weekday
+}
+
+// OperatingHours returns the daily operating hours as offsets from midnight.
+// If operating hours are not configured, ok is false.
+func (s *Settings) OperatingHours() (start, end time.Duration, ok bool) {
+	return s.hours.start, s.hours.end, s.hours.ok
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// OperatingDays returns the days of the week on which the DZ operates. Nil
+// means every day.
+func (s *Settings) OperatingDays() []time.Weekday {
+	return s.hours.days
+}
+
+// loadOperatingHours parses operating_hours.
+func (s *Settings) loadOperatingHours() (operatingHours, error) {
+	var hours operatingHours
+	startString := s.config.GetString("operating_hours.start")
+	endString := s.config.GetString("operating_hours.end")
+	if startString != "" && endString != "" {
+		var err error
+		if hours.start, err = parseTimeOfDay(startString); err != nil {
+			return hours, fmt.Errorf("invalid operating_hours.start: %w", err)
+		}
+		if hours.end, err = parseTimeOfDay(endString); err != nil {
+			return hours, fmt.Errorf("invalid operating_hours.end: %w", err)
+		}
+		hours.ok = true
+	}
+
+	for _, day := range s.config.GetStringSlice("operating_hours.days") {
+		day = strings.ToLower(strings.TrimSpace(day))
+		weekday, ok := time.Weekday(0), false
+		if len(day) >= 3 {
+			weekday, ok = weekdays[day[:3]]
+		}
+		if !ok {
+			return hours, fmt.Errorf("invalid operating_hours.days entry: %q", day)
+		}
+		hours.days = append(hours.days, weekday)
+	}
+	return hours, nil
+}
@@ -2,16 +2,29 @@
 
 package settings
 
-func (s *Settings) WebServerAddress() string {
-	return s.config.GetString("server.http_address")
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Listener addresses may be configured either as a single address or as a
+// list of addresses, e.g. to listen on both IPv4 and IPv6 or on several
+// interfaces.
+
+func (s *Settings) WebServerAddresses() []string {
+	return s.config.GetStringSlice("server.http_address")
 }
 
-func (s *Settings) WebServerSecureAddress() string {
-	return s.config.GetString("server.https_address")
+func (s *Settings) WebServerSecureAddresses() []string {
+	return s.config.GetStringSlice("server.https_address")
 }
 
-func (s *Settings) WebServerGRPCAddress() string {
-	return s.config.GetString("server.grpc_address")
+func (s *Settings) WebServerGRPCAddresses() []string {
+	return s.config.GetStringSlice("server.grpc_address")
 }
 
 func (s *Settings) ServerCertFile() string {
@@ -21,3 +34,176 @@ func (s *Settings) ServerCertFile() string {
 func (s *Settings) ServerKeyFile() string {
 	return s.config.GetString("server.key_file")
 }
+
+func (s *Settings) ZoneAddresses(zone string) []string {
+	return s.config.GetStringSlice("server.zones." + zone + ".address")
+}
+
+// ZoneAllowedNetworks returns the networks allowed to access a zone's
+// endpoints. Entries may be CIDRs or single addresses. Nil means all
+// networks are allowed.
+func (s *Settings) ZoneAllowedNetworks(zone string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, entry := range s.config.GetStringSlice("server.zones." + zone + ".allow") {
+		entry = strings.TrimSpace(entry)
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid address in server.zones.%s.allow: %q", zone, entry)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 32
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network in server.zones.%s.allow: %w", zone, err)
+		}
+		networks = append(networks, n)
+	}
+	return networks, nil
+}
+
+// FetchTimeout returns how long a request to Burble or a weather service may
+// take before it's abandoned.
+func (s *Settings) FetchTimeout() time.Duration {
+	return s.config.GetDuration("server.fetch_timeout")
+}
+
+// NewHTTPClient returns a client for requests to Burble and the weather
+// services. It shares the default client's cookie jar and transport and
+// times out requests after the fetch timeout.
+func (s *Settings) NewHTTPClient() *http.Client {
+	return &http.Client{
+		Jar:       http.DefaultClient.Jar,
+		Transport: http.DefaultClient.Transport,
+		Timeout:   s.FetchTimeout(),
+	}
+}
+
+// Name privacy modes for channels.
+const (
+	NamesFull   = "full"   // full names
+	NamesShort  = "short"  // first name and last initial
+	NamesHidden = "hidden" // no names; groups are shown by size
+)
+
+// NamePrivacy returns how jumper names are shown on the named channel.
+// Channels without a mode of their own show names as the display channel
+// does, so that naming an unconfigured channel reveals nothing more.
+func (s *Settings) NamePrivacy(channel string) string {
+	key := "privacy." + channel + ".names"
+	if !s.config.IsSet(key) {
+		key = "privacy.display.names"
+	}
+	switch mode := strings.ToLower(s.config.GetString(key)); mode {
+	case "", NamesFull:
+		return NamesFull
+	case NamesShort, NamesHidden:
+		return mode
+	default:
+		fmt.Fprintf(os.Stderr, "error: invalid %s %q\n", key, mode)
+		return NamesHidden
+	}
+}
+
+// DisplayHints tell the kiosk clients on a channel how to manage their
+// displays, so that they don't need to be configured individually.
+type DisplayHints struct {
+	DimAtDusk     bool   // dim the display between sunset and sunrise
+	DimBrightness int    // brightness when dimmed, in percent
+	SleepStart    string // local time to turn the display off (HH:MM)
+	SleepEnd      string // local time to turn the display back on (HH:MM)
+	RotateSeconds int    // time to show each page of content; 0 to not rotate
+	TeamNames     bool   // show team names as group headers
+
+	// SeparationAircraft is the aircraft whose separation is shown, or
+	// empty for the aircraft flying the next departing load.
+	SeparationAircraft string
+}
+
+// DisplayHints returns the display hints for the named channel.
+func (s *Settings) DisplayHints(channel string) DisplayHints {
+	prefix := "displays." + channel + "."
+	h := DisplayHints{
+		DimAtDusk:     s.config.GetBool(prefix + "dim_at_dusk"),
+		DimBrightness: 30,
+		SleepStart:    s.config.GetString(prefix + "sleep_start"),
+		SleepEnd:      s.config.GetString(prefix + "sleep_end"),
+		RotateSeconds: s.config.GetInt(prefix + "rotate_seconds"),
+		TeamNames:     true,
+
+		SeparationAircraft: s.config.GetString(prefix + "separation_aircraft"),
+	}
+	if s.config.IsSet(prefix + "dim_brightness") {
+		h.DimBrightness = s.config.GetInt(prefix + "dim_brightness")
+	}
+	if s.config.IsSet(prefix + "team_names") {
+		h.TeamNames = s.config.GetBool(prefix + "team_names")
+	}
+	if h.SleepStart != "" || h.SleepEnd != "" {
+		_, startErr := time.Parse("15:04", h.SleepStart)
+		_, endErr := time.Parse("15:04", h.SleepEnd)
+		if startErr != nil || endErr != nil {
+			fmt.Fprintf(os.Stderr, "error: invalid %ssleep_start %q or sleep_end %q\n",
+				prefix, h.SleepStart, h.SleepEnd)
+			h.SleepStart, h.SleepEnd = "", ""
+		}
+	}
+	return h
+}
+
+// RegisteredDisplays returns the displays that must acknowledge takeovers
+// and critical alerts, mapped to the channels they show.
+func (s *Settings) RegisteredDisplays() map[string]string {
+	displays := make(map[string]string)
+	for display, channel := range s.config.GetStringMapString("registered_displays") {
+		displays[display] = strings.ToLower(channel)
+	}
+	return displays
+}
+
+type Route struct {
+	Path     string
+	Content  string
+	Disabled bool
+}
+
+// Routes returns the configured changes to the web server's default
+// routing table. Each route either maps a path to a named content
+// producer or disables the path.
+func (s *Settings) Routes() []Route {
+	routes, ok := s.config.Get("server.routes").([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]Route, 0, len(routes))
+	for _, r := range routes {
+		rr, rrok := r.(map[string]interface{})
+		if !rrok {
+			continue
+		}
+
+		path, pok := rr["path"].(string)
+		if !pok || path == "" {
+			fmt.Fprintf(os.Stderr, "error: missing path for server.routes\n")
+			continue
+		}
+		content, _ := rr["content"].(string)
+		disabled, _ := rr["disabled"].(bool)
+		if content == "" && !disabled {
+			fmt.Fprintf(os.Stderr, "error: missing content for server.routes path %s\n", path)
+			continue
+		}
+		result = append(result, Route{
+			Path:     path,
+			Content:  content,
+			Disabled: disabled,
+		})
+	}
+	return result
+}
@@ -10,16 +10,16 @@ import (
 	"fmt"
 	"html/template"
 	"io"
-	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"reflect"
-	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/jumptown-skydiving/manifest-server/pkg/statefile"
 	"github.com/spf13/viper"
 )
 
@@ -32,19 +32,34 @@ type UpdateFunc func(string)
 
 // Settings are configurable options that may be changed via the web interface
 // while the server is running.
+//
+// The configuration is read once when the settings are created and never
+// changes after that, so it may be read from any goroutine. The options are
+// kept as an immutable snapshot that is replaced whenever they change, so
+// readers never see a partially applied change. Code that reads several
+// options that must agree should read them from one Options snapshot.
 type Settings struct {
-	update   UpdateFunc
-	lock     sync.Mutex
-	config   *viper.Viper
-	options  Options
-	template *template.Template
+	update      UpdateFunc
+	lock        sync.Mutex // serializes changes to options and guards template
+	config      *viper.Viper
+	options     atomic.Value // *optionsSnapshot
+	template    *template.Template
+	routes      []NotificationRoute       // parsed once when the config is read
+	aircraft    map[string]map[string]int // parsed once when the config is read
+	declination int                       // computed once when the config is read
+	hours       operatingHours            // parsed once when the config is read
+}
+
+type optionsSnapshot struct {
+	options    Options
+	generation uint64
 }
 
 func newSettings() *Settings {
 	s := &Settings{
-		config:  viper.New(),
-		options: defaultOptions,
+		config: viper.New(),
 	}
+	s.options.Store(&optionsSnapshot{options: defaultOptions})
 
 	for key, value := range defaults {
 		v := reflect.ValueOf(value)
@@ -66,12 +81,41 @@ func (s *Settings) loadConfig() error {
 	if err := s.config.ReadInConfig(); err != nil {
 		return fmt.Errorf("Could not read config: %w\n", err)
 	}
+	if err := s.parseConfig(); err != nil {
+		return fmt.Errorf("Invalid config: %w", err)
+	}
 	if err := s.restore(); err != nil {
 		fmt.Fprintf(os.Stderr, "Could not read options: %v\n", err)
 	}
 	return nil;
 }
 
+// parseConfig parses and validates the parts of the config that are too
+// costly to parse each time they're used.
+func (s *Settings) parseConfig() error {
+	routes, err := s.loadNotificationRoutes()
+	if err != nil {
+		return err
+	}
+	s.routes = routes
+	aircraft, err := s.loadAircraft()
+	if err != nil {
+		return err
+	}
+	s.aircraft = aircraft
+	declination, err := s.loadMagneticDeclination()
+	if err != nil {
+		return err
+	}
+	s.declination = declination
+	hours, err := s.loadOperatingHours()
+	if err != nil {
+		return err
+	}
+	s.hours = hours
+	return nil;
+}
+
 func NewSettings() (*Settings, error) {
 	s := newSettings()
 	s.config.AddConfigPath("/etc/manifest-server")
@@ -99,7 +143,7 @@ func (s *Settings) SetUpdateFunc(update UpdateFunc) {
 }
 
 func (s *Settings) restore() error {
-	dataBytes, err := ioutil.ReadFile(s.config.GetString("options_file"))
+	dataBytes, err := statefile.Read(s.config.GetString("options_file"))
 	if err != nil {
 		return err
 	}
@@ -135,27 +179,20 @@ func (s *Settings) restore() error {
 
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	s.options = newOptions
+	s.publish(newOptions)
 
 	return nil
 }
 
 func (s *Settings) Write() error {
-	s.lock.Lock()
-	o := s.options
-	s.lock.Unlock()
+	o := s.snapshot()
 
 	dataBytes, err := json.Marshal(&o)
 	if err != nil {
 		return err
 	}
 
-	filename := s.config.GetString("options_file")
-	tempFilename := filename + ".tmp"
-	if err = ioutil.WriteFile(tempFilename, dataBytes, 0600); err == nil {
-		_ = os.Rename(tempFilename, filename)
-	}
-	return err
+	return statefile.Write(s.config.GetString("options_file"), dataBytes, 0600)
 }
 
 func (s *Settings) NewRequestWithContext(
@@ -181,10 +218,38 @@ func (s *Settings) NewHTTPRequest(
 	return s.NewRequestWithContext(context.Background(), method, url, body)
 }
 
+// Options returns a snapshot of the options.
 func (s *Settings) Options() Options {
-	s.lock.Lock()
-	defer s.lock.Unlock()
-	return s.options
+	return s.snapshot()
+}
+
+// OptionsGeneration returns a snapshot of the options and its generation,
+// which increases whenever the options change, so that clients can tell
+// whether their copy is current.
+func (s *Settings) OptionsGeneration() (Options, uint64) {
+	snapshot := s.options.Load().(*optionsSnapshot)
+	return snapshot.options, snapshot.generation
+}
+
+// Generation returns the generation of the current options.
+func (s *Settings) Generation() uint64 {
+	_, generation := s.OptionsGeneration()
+	return generation
+}
+
+func (s *Settings) snapshot() Options {
+	return s.options.Load().(*optionsSnapshot).options
+}
+
+// publish replaces the options with o, returning true if they changed. The
+// caller must hold the lock.
+func (s *Settings) publish(o Options) bool {
+	current, generation := s.OptionsGeneration()
+	if o == current {
+		return false
+	}
+	s.options.Store(&optionsSnapshot{options: o, generation: generation + 1})
+	return true
 }
 
 func (s *Settings) Location() (*time.Location, error) {
@@ -192,48 +257,16 @@ func (s *Settings) Location() (*time.Location, error) {
 	return time.LoadLocation(timezone)
 }
 
-func (s *Settings) SetFromURLValues(values url.Values) bool {
-	changed := false
-	sv := reflect.ValueOf(&s.options).Elem()
+// SetFromURLValues applies the option changes in values, which are keyed by
+// field name, atomically. Nothing is changed if any value is invalid.
+func (s *Settings) SetFromURLValues(values url.Values) (bool, error) {
+	changes := make(map[string]interface{}, len(values))
 	for k, v := range values {
-		if len(v) != 1 {
-			continue
-		}
-		fv := sv.FieldByName(k)
-		switch fv.Kind() {
-		case reflect.Bool:
-			o := fv.Bool()
-			n := ParseBool(v[0])
-			if o != n {
-				changed = true
-				fv.SetBool(n)
-				if s.update != nil {
-					s.update(k)
-				}
-			}
-		case reflect.Int:
-			o := fv.Int()
-			n, err := strconv.ParseInt(v[0], 0, 64)
-			if err == nil && o != n {
-				changed = true
-				fv.SetInt(n)
-				if s.update != nil {
-					s.update(k)
-				}
-			}
-		case reflect.String:
-			o := fv.String()
-			n := v[0]
-			if o != n {
-				changed = true
-				fv.SetString(n)
-				if s.update != nil {
-					s.update(k)
-				}
-			}
+		if len(v) == 1 {
+			changes[k] = v[0]
 		}
 	}
-	return changed
+	return s.ApplyOptions(changes)
 }
 
 func (s *Settings) initializeTemplate() *template.Template {
@@ -251,8 +284,8 @@ func (s *Settings) initializeTemplate() *template.Template {
 }
 
 func (s *Settings) HTML(w http.ResponseWriter, req *http.Request) {
+	o := s.snapshot()
 	s.lock.Lock()
-	o := s.options
 	tmpl := s.initializeTemplate()
 	s.lock.Unlock()
 
@@ -262,7 +295,7 @@ func (s *Settings) HTML(w http.ResponseWriter, req *http.Request) {
 	}
 
 	b := &bytes.Buffer{}
-	if err := tmpl.Execute(b, &o); err != nil {
+	if err := tmpl.Execute(b, formGroups(&o)); err != nil {
 		http.NotFound(w, req)
 		return
 	}
@@ -277,7 +310,12 @@ func (s *Settings) FormHandler(w http.ResponseWriter, req *http.Request) {
 		http.NotFound(w, req)
 		return
 	}
-	if s.SetFromURLValues(req.Form) {
+	changed, err := s.SetFromURLValues(req.Form)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if changed {
 		_ = s.Write()
 	}
 }
@@ -287,9 +325,10 @@ const settingsHTML = `<html>
 	<title>Manifest Settings</title>
 	<script>
 	function change(id) {
-		var v = document.getElementById(id).checked
+		var e = document.getElementById(id)
+		var v = e.type == "checkbox" ? e.checked : e.value
 		var xmlhttp = new XMLHttpRequest();
-		xmlhttp.open("GET", "/setconfig?" + id + "=" + v, true);
+		xmlhttp.open("GET", "/setconfig?" + id + "=" + encodeURIComponent(v), true);
 		xmlhttp.send();
 	}
 	</script>
@@ -301,26 +340,33 @@ const settingsHTML = `<html>
 			<hr>
 			<br>
 		</div>
-		<div>
-			<input type="checkbox" id="DisplayWeather" onchange="change('DisplayWeather');" {{if .DisplayWeather}}checked{{end}}>
-			<label>Display weather information</label>
-		</div>
-		<div>
-			<input type="checkbox" id="DisplayWinds" onchange="change('DisplayWinds');" {{if .DisplayWinds}}checked{{end}}>
-			<label>Display winds aloft information<label>
-		</div>
-		<div>
-			<label># Manifest loads to display:<label>
-			<input type="text" id="DisplayColumns" onchange="change('DisplayColumns');" value="{{.DispalyColumns}}">
-		</div>
-		<div>
-			<label>Minimum call time to display:<label>
-			<input type="text" id="MinCallMinutes" onchange="change('MinCallMinutes');" value="{{.MinCallMinutes}}">
-		</div>
-		<div>
-			<label>Message:</label>
-			<input type="text" id="Message" size="80" onchange="change('Message');" value="{{.Message}}">
-		</div>
+{{- range .}}
+		<fieldset>
+			<legend>{{.Name}}</legend>
+{{- range .Fields}}
+			<div>
+{{- if eq .Type "checkbox"}}
+				<input type="checkbox" id="{{.ID}}" onchange="change('{{.ID}}');" {{if .Checked}}checked{{end}}>
+				<label for="{{.ID}}">{{.Label}}</label>
+{{- else if eq .Type "select"}}
+				<label for="{{.ID}}">{{.Label}}:</label>
+				<select id="{{.ID}}" onchange="change('{{.ID}}');">
+{{- $value := .Value}}
+{{- range .Choices}}
+					<option value="{{.}}"{{if eq . $value}} selected{{end}}>{{.}}</option>
+{{- end}}
+				</select>
+{{- else if eq .Type "textarea"}}
+				<label for="{{.ID}}">{{.Label}}:</label><br>
+				<textarea id="{{.ID}}" onchange="change('{{.ID}}');" rows="{{.Rows}}"{{if .Size}} cols="{{.Size}}"{{end}}>{{.Value}}</textarea>
+{{- else}}
+				<label for="{{.ID}}">{{.Label}}:</label>
+				<input type="{{.Type}}" id="{{.ID}}" onchange="change('{{.ID}}');" value="{{.Value}}"{{if .Min}} min="{{.Min}}"{{end}}{{if .Max}} max="{{.Max}}"{{end}}{{if .Size}} size="{{.Size}}"{{end}}>
+{{- end}}
+			</div>
+{{- end}}
+		</fieldset>
+{{- end}}
 	</form>
 </body>
 </html>
@@ -9,7 +9,7 @@ import (
 )
 
 func (s *Settings) NewSignInWithAppleManager() (*siwa.Manager, error) {
-	if s.config.Get("siwa") == nil {
+	if s.config.Get("siwa") == nil || s.DemoMode() {
 		return nil, nil
 	}
 
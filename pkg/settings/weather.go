@@ -2,6 +2,11 @@
 
 package settings
 
+import (
+	"strings"
+	"time"
+)
+
 func (s *Settings) WindsEnabled() bool {
 	return s.config.GetBool("winds.enabled")
 }
@@ -18,10 +23,245 @@ func (s *Settings) WindsReferrer() string {
 	return s.config.GetString("winds.referrer")
 }
 
+// WindsProvider returns the name of the source of winds aloft forecasts.
+func (s *Settings) WindsProvider() string {
+	return s.config.GetString("winds.provider")
+}
+
+// WindsEndpoint returns the URL from which winds aloft forecasts are
+// requested, or an empty string to use the provider's own.
+func (s *Settings) WindsEndpoint() string {
+	return s.config.GetString("winds.endpoint")
+}
+
+// WindsShearDirectionThreshold returns the change in wind direction in
+// degrees between adjacent winds aloft samples that raises a shear warning.
+func (s *Settings) WindsShearDirectionThreshold() int {
+	return s.config.GetInt("winds.shear.direction")
+}
+
+// WindsShearSpeedThreshold returns the change in wind speed in knots
+// between adjacent winds aloft samples that raises a shear warning.
+func (s *Settings) WindsShearSpeedThreshold() int {
+	return s.config.GetInt("winds.shear.speed")
+}
+
+// WindsExitAltitude returns the altitude in feet above the ground at which
+// jumpers exit, i.e. the altitude at which jump run is flown.
+func (s *Settings) WindsExitAltitude() int {
+	return s.config.GetInt("winds.exit_altitude")
+}
+
+// WindsForecastHours returns the hours after now for which winds aloft
+// forecasts are retrieved in addition to the current one.
+func (s *Settings) WindsForecastHours() []int {
+	return s.config.GetIntSlice("winds.forecast_hours")
+}
+
+// WindsHistorySize returns how many refreshes that changed the winds aloft
+// are kept for computing trends.
+func (s *Settings) WindsHistorySize() int {
+	return s.config.GetInt("winds.history_size")
+}
+
+// WindsTrendWindow returns how far back the refreshes used for trends may
+// go. Zero keeps refreshes regardless of their age.
+func (s *Settings) WindsTrendWindow() time.Duration {
+	return s.config.GetDuration("winds.trend_window")
+}
+
+// WindsStateFile returns the file in which the winds aloft history is kept
+// so that it survives restarts, or an empty string to not keep it.
+func (s *Settings) WindsStateFile() string {
+	return s.config.GetString("winds.state_file")
+}
+
+// WindsStaleAfter returns how long after they were last retrieved the winds
+// aloft are shown as stale. Zero never shows them as stale.
+func (s *Settings) WindsStaleAfter() time.Duration {
+	return s.config.GetDuration("winds.stale_after")
+}
+
+// WindsAltitudes returns the altitudes in feet of the winds aloft samples
+// sent to displays, or nil to send all of them.
+func (s *Settings) WindsAltitudes() []int {
+	return s.config.GetIntSlice("winds.altitudes")
+}
+
+// CanopyAdvisoryAltitude returns the winds aloft altitude in feet that is
+// compared with the surface winds for canopy traffic advisories, i.e. the
+// altitude at which canopy patterns are set up.
+func (s *Settings) CanopyAdvisoryAltitude() int {
+	return s.config.GetInt("winds.canopy_advisory.altitude")
+}
+
+// CanopyAdvisoryDirection returns the difference in degrees between the
+// surface and pattern altitude wind directions that raises a canopy
+// traffic advisory, or 0 to disable advisories.
+func (s *Settings) CanopyAdvisoryDirection() int {
+	return s.config.GetInt("winds.canopy_advisory.direction")
+}
+
+// CanopyAdvisoryMinimumSpeed returns the speed in knots that both the
+// surface and pattern altitude winds must reach before their directions are
+// compared.
+func (s *Settings) CanopyAdvisoryMinimumSpeed() int {
+	return s.config.GetInt("winds.canopy_advisory.min_speed")
+}
+
+func (s *Settings) CanopyAdvisoryMessage() string {
+	return s.config.GetString("winds.canopy_advisory.message")
+}
+
+// HighUppersRedLine returns the wind speed in knots at exit altitude at or
+// above which jump run is red-lined, or 0 to only red-line it when the
+// aircraft can't make progress into the wind.
+func (s *Settings) HighUppersRedLine() int {
+	return s.config.GetInt("winds.high_uppers.red_line")
+}
+
+// HighUppersGroundSpeed returns the ground speed in knots on jump run below
+// which a high uppers advisory is shown, or 0 to not advise slow jump runs.
+func (s *Settings) HighUppersGroundSpeed() int {
+	return s.config.GetInt("winds.high_uppers.ground_speed")
+}
+
+// HighUppersMessage returns the high uppers advisory. "{speed}" is replaced
+// by the wind speed at exit altitude and "{ground_speed}" by the ground
+// speed on jump run, both in knots.
+func (s *Settings) HighUppersMessage() string {
+	return s.config.GetString("winds.high_uppers.message")
+}
+
+// WindsRawRateLimit returns the number of requests per minute each client
+// may make for raw winds aloft data, or 0 for no limit.
+func (s *Settings) WindsRawRateLimit() int {
+	return s.config.GetInt("winds.raw.rate_limit")
+}
+
 func (s *Settings) METAREnabled() bool {
 	return s.config.GetBool("metar.enabled")
 }
 
+// METARStations returns the METAR stations to use in order of preference.
+// The station setting may be a single station or a list.
+func (s *Settings) METARStations() []string {
+	var stations []string
+	for _, station := range s.config.GetStringSlice("metar.station") {
+		if station = strings.ToUpper(strings.TrimSpace(station)); station != "" {
+			stations = append(stations, station)
+		}
+	}
+	return stations
+}
+
+// METARStation returns the most preferred METAR station.
 func (s *Settings) METARStation() string {
-	return s.config.GetString("metar.station")
+	if stations := s.METARStations(); len(stations) > 0 {
+		return stations[0]
+	}
+	return ""
+}
+
+// METARFieldElevation returns the field elevation in feet used to compute
+// density altitude, or 0 to use the reporting station's elevation. It
+// defaults to the DZ's field elevation.
+func (s *Settings) METARFieldElevation() int {
+	if elevation := s.config.GetInt("metar.field_elevation"); elevation != 0 {
+		return elevation
+	}
+	return s.FieldElevation()
+}
+
+// FieldElevation returns the elevation of the DZ in feet MSL, from which
+// altitudes AGL are converted to MSL.
+func (s *Settings) FieldElevation() int {
+	return s.config.GetInt("field_elevation")
+}
+
+// WeatherHoldAutomatic returns true if weather holds are started and
+// cleared automatically from the surface winds.
+func (s *Settings) WeatherHoldAutomatic() bool {
+	return s.config.GetBool("metar.hold.enabled")
+}
+
+// WeatherHoldWindSpeed returns the sustained wind speed in knots above which
+// a weather hold is started, or 0 if sustained winds don't start holds.
+func (s *Settings) WeatherHoldWindSpeed() int {
+	return s.config.GetInt("metar.hold.wind_speed")
+}
+
+// WeatherHoldGust returns the gust speed in knots above which a weather hold
+// is started, or 0 if gusts don't start holds.
+func (s *Settings) WeatherHoldGust() int {
+	return s.config.GetInt("metar.hold.gust")
+}
+
+// WeatherHoldMargin returns how many knots below each limit the winds must
+// be before a weather hold starts to clear.
+func (s *Settings) WeatherHoldMargin() int {
+	return s.config.GetInt("metar.hold.margin")
+}
+
+// WeatherHoldStartReadings returns the number of consecutive reports over a
+// limit that start a weather hold.
+func (s *Settings) WeatherHoldStartReadings() int {
+	return s.config.GetInt("metar.hold.start_readings")
+}
+
+// WeatherHoldClearAfter returns how long the winds must stay below the
+// limits before a weather hold is cleared.
+func (s *Settings) WeatherHoldClearAfter() time.Duration {
+	return s.config.GetDuration("metar.hold.clear_after")
+}
+
+// TandemCeilingMinimum returns the lowest ceiling in feet AGL under which
+// tandems may jump, or 0 if tandems aren't limited by the ceiling.
+func (s *Settings) TandemCeilingMinimum() int {
+	return s.config.GetInt("metar.ceilings.tandem")
+}
+
+// StudentCeilingMinimum returns the lowest ceiling in feet AGL under which
+// students may jump, or 0 if students aren't limited by the ceiling.
+func (s *Settings) StudentCeilingMinimum() int {
+	return s.config.GetInt("metar.ceilings.student")
+}
+
+// METARStateFile returns the file in which the last good report is kept so
+// that it survives restarts, or an empty string to not keep it.
+func (s *Settings) METARStateFile() string {
+	return s.config.GetString("metar.state_file")
+}
+
+// METARMaxAge returns how old a station's latest report may be before the
+// next station is tried instead.
+func (s *Settings) METARMaxAge() time.Duration {
+	return s.config.GetDuration("metar.max_age")
+}
+
+// METARStaleAfter returns how old the current report may be before the
+// weather it reports is shown as stale. Zero never shows it as stale.
+func (s *Settings) METARStaleAfter() time.Duration {
+	return s.config.GetDuration("metar.stale_after")
+}
+
+// METARHistorySize returns how many reports are kept for computing trends.
+func (s *Settings) METARHistorySize() int {
+	return s.config.GetInt("metar.history_size")
+}
+
+// METARRateLimitPause returns how long to stop requesting reports after the
+// weather service refuses a request without saying how long to wait.
+func (s *Settings) METARRateLimitPause() time.Duration {
+	return s.config.GetDuration("metar.rate_limit_pause")
+}
+
+// METARNoDataRetry returns how long to wait before asking a station that had
+// no report again.
+func (s *Settings) METARNoDataRetry() time.Duration {
+	return s.config.GetDuration("metar.no_data_retry")
+}
+
+func (s *Settings) METARSmoothingWindow() time.Duration {
+	return time.Duration(s.config.GetInt("metar.smoothing_minutes")) * time.Minute
 }
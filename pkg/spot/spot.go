@@ -0,0 +1,185 @@
+// (c) Copyright 2026 Matt Messier
+
+// Package spot computes how far jumpers drift under the winds aloft from
+// exit to landing, and how far apart in time groups must exit to be
+// separated over the ground.
+package spot
+
+import (
+	"math"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/winds"
+)
+
+// feetPerSecondPerKnot converts a speed in knots to feet per second.
+const feetPerSecondPerKnot = 1852.0 / 3600.0 / 0.3048
+
+// step is the altitude in feet over which the wind is taken as constant when
+// integrating drift through the profile.
+const step = 100
+
+// Parameters describe the jump for which drift and separation are computed.
+type Parameters struct {
+	ExitAltitude    int     // feet AGL
+	OpeningAltitude int     // feet AGL
+	FreefallRate    float64 // feet per second
+	CanopyRate      float64 // feet per second
+
+	// Airspeed is the aircraft's true airspeed on jump run in knots, and
+	// Track its ground track in degrees true. If HasTrack is false, jump
+	// run is taken to be flown directly into the wind at exit altitude.
+	Airspeed float64
+	Track    float64
+	HasTrack bool
+
+	// GroupSpacing is the distance in feet over the ground between groups,
+	// and MinimumGroundSpeed the lowest ground speed in knots used to
+	// compute their separation, so that strong uppers don't produce absurd
+	// separations. MinimumGroundSpeed may be 0.
+	GroupSpacing       int
+	MinimumGroundSpeed float64
+}
+
+// Vector is a distance over the ground and the direction it is in.
+type Vector struct {
+	Distance float64 // feet
+	Bearing  float64 // degrees true
+}
+
+// Result is the drift of a jumper and the separation between groups.
+type Result struct {
+	Freefall Vector // from exit to opening
+	Canopy   Vector // from opening to landing
+	Total    Vector
+
+	FreefallSeconds float64
+	CanopySeconds   float64
+
+	// GroundSpeed is the aircraft's ground speed on jump run at exit
+	// altitude in knots. It may be 0 or negative if the aircraft can't
+	// make progress into the wind.
+	GroundSpeed float64
+
+	// Separation is the time in seconds between groups exiting, or 0 if
+	// the ground speed isn't positive.
+	Separation int
+}
+
+// displacement is a distance in feet east and north.
+type displacement struct {
+	east, north float64
+}
+
+func (d displacement) add(o displacement) displacement {
+	return displacement{east: d.east + o.east, north: d.north + o.north}
+}
+
+func (d displacement) vector() Vector {
+	v := Vector{Distance: math.Hypot(d.east, d.north)}
+	if v.Distance > 0 {
+		v.Bearing = math.Mod(math.Atan2(d.east, d.north)*180.0/math.Pi+360.0, 360.0)
+	}
+	return v
+}
+
+// windAt returns the velocity in knots east and north toward which the wind
+// is blowing at altitude, interpolated between the samples either side of
+// it. Samples must be ordered by altitude. Altitudes outside the samples use
+// the nearest sample, and the air is calm if there are none.
+func windAt(samples []winds.Sample, altitude float64) displacement {
+	velocity := func(s winds.Sample) displacement {
+		if s.LightAndVariable {
+			return displacement{}
+		}
+		r := float64(s.Heading) * math.Pi / 180.0
+		return displacement{
+			east:  -float64(s.Speed) * math.Sin(r),
+			north: -float64(s.Speed) * math.Cos(r),
+		}
+	}
+	if len(samples) == 0 {
+		return displacement{}
+	}
+	if altitude <= float64(samples[0].Altitude) {
+		return velocity(samples[0])
+	}
+	for i := 1; i < len(samples); i++ {
+		upper := samples[i]
+		if float64(upper.Altitude) < altitude {
+			continue
+		}
+		lower := samples[i-1]
+		f := (altitude - float64(lower.Altitude)) / float64(upper.Altitude-lower.Altitude)
+		l, u := velocity(lower), velocity(upper)
+		return displacement{
+			east:  l.east + f*(u.east-l.east),
+			north: l.north + f*(u.north-l.north),
+		}
+	}
+	return velocity(samples[len(samples)-1])
+}
+
+// drift integrates the wind from top down to bottom feet AGL while
+// descending at rate feet per second. It returns the displacement and the
+// time taken.
+func drift(samples []winds.Sample, top, bottom int, rate float64) (displacement, float64) {
+	var d displacement
+	if rate <= 0 || top <= bottom {
+		return d, 0
+	}
+	seconds := 0.0
+	for altitude := top; altitude > bottom; altitude -= step {
+		height := step
+		if altitude-bottom < step {
+			height = altitude - bottom
+		}
+		dt := float64(height) / rate
+		w := windAt(samples, float64(altitude)-float64(height)/2.0)
+		d.east += w.east * feetPerSecondPerKnot * dt
+		d.north += w.north * feetPerSecondPerKnot * dt
+		seconds += dt
+	}
+	return d, seconds
+}
+
+// Calculate returns the drift of a jumper through the winds aloft samples
+// and the separation between groups. If there are no samples the air is
+// taken to be calm and ok is false.
+func Calculate(samples []winds.Sample, p Parameters) (r Result, ok bool) {
+	freefall, freefallSeconds := drift(samples, p.ExitAltitude, p.OpeningAltitude, p.FreefallRate)
+	canopy, canopySeconds := drift(samples, p.OpeningAltitude, 0, p.CanopyRate)
+	r = Result{
+		Freefall:        freefall.vector(),
+		Canopy:          canopy.vector(),
+		Total:           freefall.add(canopy).vector(),
+		FreefallSeconds: freefallSeconds,
+		CanopySeconds:   canopySeconds,
+	}
+
+	// The ground speed on jump run is the airspeed less the headwind
+	// component of the wind at exit altitude, after correcting the heading
+	// for the crosswind.
+	w := windAt(samples, float64(p.ExitAltitude))
+	windSpeed := math.Hypot(w.east, w.north)
+	if !p.HasTrack {
+		r.GroundSpeed = p.Airspeed - windSpeed
+	} else {
+		// A crosswind stronger than the airspeed leaves no ground speed
+		// along the track.
+		track := p.Track * math.Pi / 180.0
+		tailwind := w.east*math.Sin(track) + w.north*math.Cos(track)
+		crosswind := w.east*math.Cos(track) - w.north*math.Sin(track)
+		if math.Abs(crosswind) < p.Airspeed {
+			r.GroundSpeed = math.Sqrt(p.Airspeed*p.Airspeed-crosswind*crosswind) + tailwind
+		}
+	}
+
+	speed := r.GroundSpeed
+	if speed < p.MinimumGroundSpeed {
+		speed = p.MinimumGroundSpeed
+	}
+	if speed > 0 {
+		r.Separation = int(math.Ceil(float64(p.GroupSpacing) / (speed * feetPerSecondPerKnot)))
+	}
+	return r, len(samples) > 0
+}
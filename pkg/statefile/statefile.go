@@ -0,0 +1,139 @@
+// (c) Copyright 2026 Matt Messier
+
+// Package statefile reads and writes small state files so that they survive
+// crashes and power loss. Files are written to a temporary file and renamed
+// into place, carry a checksum of their contents, and the previous good copy
+// is kept as a backup to fall back on if the current copy is damaged.
+package statefile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// envelope is the on-disk format of a state file. Files written before
+// checksums were introduced contain only the data and are still accepted.
+type envelope struct {
+	Checksum string          `json:"checksum"`
+	Data     json.RawMessage `json:"data"`
+}
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// BackupFilename returns the name of the file in which the previous good
+// copy of filename is kept.
+func BackupFilename(filename string) string {
+	return filename + ".bak"
+}
+
+func decode(raw []byte) ([]byte, error) {
+	var e envelope
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return nil, err
+	}
+	if e.Checksum == "" && e.Data == nil {
+		// Written before checksums; the whole file is the data
+		return raw, nil
+	}
+	if checksum(e.Data) != e.Checksum {
+		return nil, ErrChecksumMismatch
+	}
+	return e.Data, nil
+}
+
+func readFile(filename string) ([]byte, error) {
+	raw, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return decode(raw)
+}
+
+// Read returns the JSON data stored in filename. If the file is missing or
+// damaged, the backup copy is used instead.
+func Read(filename string) ([]byte, error) {
+	data, err := readFile(filename)
+	if err == nil {
+		return data, nil
+	}
+
+	backup := BackupFilename(filename)
+	data, backupErr := readFile(backup)
+	if backupErr != nil {
+		return nil, err
+	}
+	if !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "%s is damaged (%v); using %s\n", filename, err, backup)
+	}
+	return data, nil
+}
+
+// Write atomically replaces the contents of filename with data, which must
+// be valid JSON. The file being replaced is kept as the backup copy if it is
+// intact.
+func Write(filename string, data []byte, perm os.FileMode) error {
+	if !json.Valid(data) {
+		return errors.New("state data is not valid JSON")
+	}
+
+	// Build the envelope by hand rather than with json.Marshal, which
+	// would reformat data and invalidate the checksum.
+	raw := []byte(fmt.Sprintf(`{"checksum":%q,"data":`, checksum(data)))
+	raw = append(raw, data...)
+	raw = append(raw, '}')
+
+	dir := filepath.Dir(filename)
+	f, err := ioutil.TempFile(dir, filepath.Base(filename)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tempFilename := f.Name()
+	defer os.Remove(tempFilename)
+
+	if _, err = f.Write(raw); err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempFilename, perm)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Only keep the current copy as the backup if it's good; otherwise
+	// a damaged file would replace the last good copy.
+	if _, err = readFile(filename); err == nil {
+		if err = os.Rename(filename, BackupFilename(filename)); err != nil {
+			return err
+		}
+	}
+	if err = os.Rename(tempFilename, filename); err != nil {
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes directory entries so that renames survive power loss.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	_ = d.Sync() // not supported everywhere; best effort
+	return nil
+}
@@ -0,0 +1,33 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import "time"
+
+// FetchTime returns when the winds aloft were last retrieved from the data
+// source, or false if they never have been.
+func (c *Controller) FetchTime() (time.Time, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.rawTime, !c.rawTime.IsZero()
+}
+
+// StaleTime returns when the winds aloft become stale, maxAge after they
+// were last retrieved. Simulated winds, winds that have never been
+// retrieved, and a maxAge of zero never become stale, so ok is false.
+func (c *Controller) StaleTime(maxAge time.Duration) (t time.Time, ok bool) {
+	if maxAge <= 0 || c.IsSimulated() {
+		return time.Time{}, false
+	}
+	if t, ok = c.FetchTime(); !ok {
+		return time.Time{}, false
+	}
+	return t.Add(maxAge), true
+}
+
+// IsStale returns true if the winds aloft were last retrieved more than
+// maxAge ago.
+func (c *Controller) IsStale(maxAge time.Duration) bool {
+	t, ok := c.StaleTime(maxAge)
+	return ok && c.now().After(t)
+}
@@ -3,153 +3,307 @@
 package winds
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"os"
 	"reflect"
-	"strconv"
 	"sync"
 	"time"
 
-	"github.com/jumptown-skydiving/manifest-server/pkg/decode"
 	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
 )
 
+// Fetcher retrieves raw winds aloft data for the forecast hour hours from
+// now, which also covers the hours before it if the provider's forecasts
+// cover many hours.
+type Fetcher interface {
+	Fetch(hour int) ([]byte, error)
+}
+
 type Controller struct {
 	settings *settings.Settings
+	fetcher  Fetcher
+	provider Provider
+	now      func() time.Time
 
 	// samples is a simple array of information for each altitude from 0 to
 	// len(Samples) * 1000 feet. Each index position is 1000 feet.
 	samples []Sample
 
+	// raw is the data most recently received from the data source, and
+	// rawTime is when it was received. rawRequests tracks requests for it
+	// by client address for rate limiting.
+	raw         []byte
+	rawTime     time.Time
+	rawRequests map[string]*rawRateCount
+
+	// simulated replaces samples when non-nil.
+	simulated []Sample
+
 	// validTime is the time at which the sample data becomes valid. It's
 	// valid for an hour.
 	validTime time.Time
 
-	// url is the full url used to request winds aloft data.
-	url string
+	// degraded is true if samples are the last good ones because the latest
+	// refresh failed or they were restored after a restart.
+	degraded bool
+
+	// forecasts are the samples for the configured hours after now.
+	forecasts []Forecast
+
+	// history is the samples from the most recent refreshes that changed
+	// them, oldest first, for computing trends.
+	history []refresh
 
 	lock sync.Mutex
 }
 
-// Winds Aloft data requires a referral code from Mark Schulze. Please contact
-// him and configure the referrer code in your config.yaml
-const windsAloftURL = "https://markschulze.net/winds/winds.php?hourOffset=0"
-
 func NewController(settings *settings.Settings) *Controller {
-	latitude := settings.WindsLatitude()
-	longitude := settings.WindsLongitude()
-	referrer := settings.WindsReferrer()
-	wa := &Controller{
+	return NewControllerWithFetcher(settings, nil, nil, nil)
+}
+
+// NewControllerWithFetcher creates a controller that retrieves forecasts
+// using fetcher and interprets them relative to the times returned by now.
+// Forecasts are requested from and parsed by the configured provider. Nil
+// values use the provider's endpoint and the system clock. The default
+// fetcher makes its requests with client, or a client from settings if
+// client is nil.
+func NewControllerWithFetcher(
+	settings *settings.Settings,
+	fetcher Fetcher,
+	client *http.Client,
+	now func() time.Time,
+) *Controller {
+	provider, ok := ProviderByName(settings.WindsProvider())
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown winds aloft provider %q; using %s\n",
+			settings.WindsProvider(), DefaultProvider)
+		provider = providers[DefaultProvider]
+	}
+	if fetcher == nil {
+		if client == nil {
+			client = settings.NewHTTPClient()
+		}
+		fetcher = &windsFetcher{
+			settings: settings,
+			client:   client,
+			provider: provider,
+		}
+	}
+	if now == nil {
+		now = time.Now
+	}
+	c := &Controller{
 		settings: settings,
-		url: fmt.Sprintf("%s&lat=%s&lon=%s&referrer=%s", windsAloftURL,
-			latitude, longitude, referrer),
+		fetcher:  fetcher,
+		provider: provider,
+		now:      now,
 	}
+	if err := c.restore(); err != nil && !os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "cannot restore winds aloft state: %v\n", err)
+	}
+	return c
+}
 
-	return wa
+type windsFetcher struct {
+	settings *settings.Settings
+	client   *http.Client
+	provider Provider
 }
 
-func (c *Controller) Refresh() (bool, error) {
-	request, err := c.settings.NewHTTPRequest(http.MethodGet, c.url, nil)
+func (f *windsFetcher) Fetch(hour int) ([]byte, error) {
+	request, err := f.provider.NewRequest(f.settings, f.settings.WindsEndpoint(), hour)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	request.Header.Set("Referer", "https://markschulze.net/winds/")
 
-	resp, err := http.DefaultClient.Do(request)
+	resp, err := f.client.Do(request)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	defer resp.Body.Close()
 
-	data, err := ioutil.ReadAll(resp.Body)
-	if err != nil || len(data) == 0 {
-		return false, err
-	}
+	return ioutil.ReadAll(resp.Body)
+}
 
-	// It would be nicer to parse the data into structs, but it's actually
-	// easier to just work it out manually because JSON sucks.
+// parse parses the forecast in data for hour hours after now. The samples
+// are nil if there was no data.
+func (c *Controller) parse(data []byte, hour int, now time.Time) ([]Sample, time.Time, error) {
+	if len(data) == 0 {
+		return nil, time.Time{}, nil
+	}
 
-	var rawWindsAloftData interface{}
-	if err = json.Unmarshal(data, &rawWindsAloftData); err != nil {
+	samples, validTime, err := c.provider.Parse(data, now.Add(time.Duration(hour)*time.Hour))
+	if err != nil {
 		// If we get unparseable data, dump it to a file so we can
 		// review it later to see what the problem is.
 		_ = ioutil.WriteFile("winds.json", data, 0644)
-		return false, err
+		return nil, time.Time{}, err
 	}
-	windsAloftData, ok := rawWindsAloftData.(map[string]interface{})
-	if !ok {
-		return false, errors.New("winds aloft data is invalid")
+	return c.withElevation(samples), validTime, nil
+}
+
+// forecastHours returns the configured hours after now to retrieve
+// forecasts for, and the latest of them.
+func (c *Controller) forecastHours() (hours []int, last int) {
+	for _, hour := range c.settings.WindsForecastHours() {
+		if hour <= 0 {
+			continue
+		}
+		hours = append(hours, hour)
+		if hour > last {
+			last = hour
+		}
 	}
+	return hours, last
+}
 
-	now := time.Now()
-	validHour := int(decode.Int("validtime", windsAloftData["validtime"]))
-	validTime := time.Date(now.Year(), now.Month(), now.Day(),
-		validHour, 0, 0, 0, time.UTC)
-	if validHour < now.Hour() {
-		validTime = validTime.Add(24 * time.Hour)
+// withElevation sets the altitude MSL of each of samples from the configured
+// field elevation, so that displays can show either.
+func (c *Controller) withElevation(samples []Sample) []Sample {
+	elevation := c.settings.FieldElevation()
+	for i := range samples {
+		samples[i].AltitudeMSL = samples[i].Altitude + elevation
 	}
+	return samples
+}
 
-	// Parse out the data that we want. We care about "direction", "speed",
-	// and "temp".
+// Refresh retrieves the current forecast and the forecasts for each of the
+// configured hours after now. A forecast hour that can't be retrieved keeps
+// its previous samples. If the current forecast can't be retrieved, the last
+// good samples are kept and marked as degraded until a refresh succeeds. If
+// the provider's forecasts cover many hours, they are retrieved only once.
+func (c *Controller) Refresh() (bool, error) {
+	now := c.now()
+	hours, last := c.forecastHours()
+	if !c.provider.MultiHour() {
+		last = 0
+	}
 	var (
-		direction map[string]interface{}
-		speed     map[string]interface{}
-		temp      map[string]interface{}
+		samples   []Sample
+		validTime time.Time
 	)
-	if direction, ok = windsAloftData["direction"].(map[string]interface{}); !ok {
-		return false, errors.New("direction information missing from winds aloft data")
+	data, err := c.fetcher.Fetch(last)
+	if err == nil {
+		samples, validTime, err = c.parse(data, 0, now)
 	}
-	if speed, ok = windsAloftData["speed"].(map[string]interface{}); !ok {
-		return false, errors.New("speed data missing from winds aloft data")
+	if err == nil && samples == nil {
+		err = errors.New("No winds aloft data received")
 	}
-	if temp, ok = windsAloftData["temp"].(map[string]interface{}); !ok {
-		return false, errors.New("temperature data missing from winds aloft data")
+	if err != nil {
+		c.lock.Lock()
+		c.degraded = c.samples != nil
+		c.lock.Unlock()
+		return false, err
 	}
 
-	maxAltitude := len(direction)
-	if len(speed) < maxAltitude {
-		maxAltitude = len(speed)
-	}
-	if len(temp) < maxAltitude {
-		maxAltitude = len(speed)
+	var forecasts []Forecast
+	for _, hour := range hours {
+		f := Forecast{Hour: hour}
+		hourData := data
+		if !c.provider.MultiHour() {
+			hourData, err = c.fetcher.Fetch(hour)
+		}
+		if err == nil {
+			f.Samples, f.ValidTime, err = c.parse(hourData, hour, now)
+		}
+		if err != nil || f.Samples == nil {
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Cannot refresh the +%dh winds aloft forecast: %v\n", hour, err)
+			}
+			previous, ok := c.Forecast(hour)
+			if !ok {
+				continue
+			}
+			f = previous
+		}
+		forecasts = append(forecasts, f)
 	}
 
-	samples := make([]Sample, maxAltitude)
-	for i := 0; i < maxAltitude; i++ {
-		key := strconv.FormatInt(int64(i*1000), 10)
-		samples[i].Altitude = i * 1000
-		samples[i].Heading = int(decode.Int(key, direction[key]))
-		samples[i].Speed = int(decode.Int(key, speed[key]))
-		samples[i].Temperature = int(decode.Int(key, temp[key]))
-		samples[i].LightAndVariable = (samples[i].Speed <= 0)
-	}
+	// The state is saved once the lock is released.
+	var s state
+	defer func() {
+		if err := c.save(s); err != nil {
+			fmt.Fprintf(os.Stderr, "cannot save winds aloft state: %v\n", err)
+		}
+	}()
 
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	changed := false
+	c.raw = data
+	c.rawTime = now
+
+	changed := c.degraded
+	c.degraded = false
 	if !reflect.DeepEqual(c.samples, samples) {
 		c.samples = samples
+		c.recordRefresh(samples, now)
 		changed = true
 	}
 	if c.validTime != validTime {
 		c.validTime = validTime
 		changed = true
 	}
+	if !reflect.DeepEqual(c.forecasts, forecasts) {
+		c.forecasts = forecasts
+		changed = true
+	}
 
+	s = state{
+		FetchedAt: now,
+		ValidTime: c.validTime,
+		Samples:   c.samples,
+		History:   c.history,
+	}
 	return changed, nil
 }
 
-// Samples returns the samples most recently loaded from the data source.
+// Samples returns the samples most recently loaded from the data source, or
+// the simulated samples if a simulation is in effect.
 func (c *Controller) Samples() []Sample {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	if c.simulated != nil {
+		return c.simulated
+	}
 	return c.samples
 }
 
+// SampleAt returns the sample at the given altitude in feet. ok is false if
+// there is no sample at exactly that altitude.
+func (c *Controller) SampleAt(altitude int) (sample Sample, ok bool) {
+	for _, s := range c.Samples() {
+		if s.Altitude == altitude {
+			return s, true
+		}
+	}
+	return Sample{}, false
+}
+
+// ExitSample returns the sample at the configured exit altitude, which is
+// interpolated if it isn't a multiple of 1000 ft.
+func (c *Controller) ExitSample() (Sample, bool) {
+	return c.Interpolate(c.settings.WindsExitAltitude())
+}
+
+// IsDisplayed returns true if the sample at the given altitude is one of the
+// configured altitudes sent to displays.
+func (c *Controller) IsDisplayed(altitude int) bool {
+	altitudes := c.settings.WindsAltitudes()
+	if len(altitudes) == 0 {
+		return true
+	}
+	for _, a := range altitudes {
+		if a == altitude {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidTime returns the time that the samples are valid until.
 func (c *Controller) ValidTime() time.Time {
 	c.lock.Lock()
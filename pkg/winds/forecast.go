@@ -0,0 +1,31 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import "time"
+
+// Forecast is the winds aloft forecast for an hour after now.
+type Forecast struct {
+	Hour      int // hours after now
+	ValidTime time.Time
+	Samples   []Sample
+}
+
+// Forecasts returns the forecasts for the configured hours after now, in the
+// order they are configured.
+func (c *Controller) Forecasts() []Forecast {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.forecasts
+}
+
+// Forecast returns the forecast for hour hours after now. ok is false if it
+// hasn't been retrieved.
+func (c *Controller) Forecast(hour int) (f Forecast, ok bool) {
+	for _, f := range c.Forecasts() {
+		if f.Hour == hour {
+			return f, true
+		}
+	}
+	return Forecast{}, false
+}
@@ -0,0 +1,117 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import "time"
+
+// Trends in the wind at an altitude.
+const (
+	Steady   = "steady"
+	Building = "building" // the wind is strengthening
+	Easing   = "easing"   // the wind is weakening
+	Veering  = "veering"  // the wind is turning clockwise
+	Backing  = "backing"  // the wind is turning counterclockwise
+)
+
+// Changes smaller than these between the oldest and newest refreshes in the
+// history are reported as steady.
+const (
+	speedTrendThreshold     = 5  // knots
+	directionTrendThreshold = 20 // degrees
+)
+
+// Trend is how the wind at an altitude has changed over the refreshes in the
+// history.
+type Trend struct {
+	Altitude        int
+	SpeedChange     int    // knots; positive is stronger
+	DirectionChange int    // degrees; positive is clockwise
+	Speed           string // Steady, Building, or Easing
+	Direction       string // Steady, Veering, or Backing
+}
+
+// refresh is the samples retrieved by a refresh.
+type refresh struct {
+	Time    time.Time `json:"time"`
+	Samples []Sample  `json:"samples"`
+}
+
+// recordRefresh adds samples to the history, dropping the oldest refreshes
+// beyond the configured size. The caller must hold the controller lock.
+func (c *Controller) recordRefresh(samples []Sample, now time.Time) {
+	c.history = c.trimHistory(append(c.history, refresh{Time: now, Samples: samples}), now)
+}
+
+// trimHistory returns the last refreshes in history that are within the
+// configured size and trend window of now.
+func (c *Controller) trimHistory(history []refresh, now time.Time) []refresh {
+	size := c.settings.WindsHistorySize()
+	if size <= 0 {
+		return nil
+	}
+	if len(history) > size {
+		history = history[len(history)-size:]
+	}
+	if window := c.settings.WindsTrendWindow(); window > 0 {
+		for len(history) > 0 && now.Sub(history[0].Time) > window {
+			history = history[1:]
+		}
+	}
+	return append([]refresh(nil), history...)
+}
+
+// Trends returns the trend at each altitude, comparing the newest refresh in
+// the history with the oldest one within the trend window. It returns nil if
+// there are fewer than two such refreshes.
+func (c *Controller) Trends() map[int]Trend {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	history := c.trimHistory(c.history, c.now())
+	if len(history) < 2 {
+		return nil
+	}
+
+	oldest := make(map[int]Sample)
+	for _, s := range history[0].Samples {
+		oldest[s.Altitude] = s
+	}
+	trends := make(map[int]Trend)
+	for _, newer := range history[len(history)-1].Samples {
+		older, ok := oldest[newer.Altitude]
+		if !ok {
+			continue
+		}
+		t := Trend{
+			Altitude:    newer.Altitude,
+			SpeedChange: newer.Speed - older.Speed,
+			Speed:       Steady,
+			Direction:   Steady,
+		}
+		if newer.LightAndVariable {
+			t.SpeedChange = -older.Speed
+		}
+		if older.LightAndVariable {
+			t.SpeedChange = newer.Speed
+		}
+		switch {
+		case t.SpeedChange >= speedTrendThreshold:
+			t.Speed = Building
+		case t.SpeedChange <= -speedTrendThreshold:
+			t.Speed = Easing
+		}
+
+		// Direction is only meaningful in winds strong enough to have one.
+		if !newer.LightAndVariable && !older.LightAndVariable &&
+			newer.Speed >= minShearSpeed && older.Speed >= minShearSpeed {
+			t.DirectionChange = ((newer.Heading-older.Heading)%360+540)%360 - 180
+			switch {
+			case t.DirectionChange >= directionTrendThreshold:
+				t.Direction = Veering
+			case t.DirectionChange <= -directionTrendThreshold:
+				t.Direction = Backing
+			}
+		}
+		trends[newer.Altitude] = t
+	}
+	return trends
+}
@@ -0,0 +1,149 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+)
+
+// components returns the eastward and northward components of the wind in a
+// sample.
+func (s Sample) components() (u, v float64) {
+	if s.LightAndVariable {
+		return 0, 0
+	}
+	r := float64(s.Heading) * math.Pi / 180.0
+	return -float64(s.Speed) * math.Sin(r), -float64(s.Speed) * math.Cos(r)
+}
+
+// interpolate returns the components of the wind and the temperature at
+// altitude feet, interpolated between the samples either side of it. Samples
+// must be ordered by altitude. ok is false if the altitude is outside of the
+// samples.
+func interpolate(samples []Sample, altitude float64) (u, v, temperature float64, ok bool) {
+	for i, upper := range samples {
+		if float64(upper.Altitude) < altitude {
+			continue
+		}
+		uu, uv := upper.components()
+		if float64(upper.Altitude) == altitude {
+			return uu, uv, float64(upper.Temperature), true
+		}
+		if i == 0 {
+			break
+		}
+		lower := samples[i-1]
+		lu, lv := lower.components()
+		f := (altitude - float64(lower.Altitude)) / float64(upper.Altitude-lower.Altitude)
+		return lu + f*(uu-lu), lv + f*(uv-lv),
+			float64(lower.Temperature) + f*float64(upper.Temperature-lower.Temperature), true
+	}
+	return 0, 0, 0, false
+}
+
+// direction returns the direction in degrees true that a wind with the given
+// components blows from.
+func direction(u, v float64) float64 {
+	return math.Mod(math.Atan2(-u, -v)*180.0/math.Pi+360.0, 360.0)
+}
+
+// InterpolateSamples returns the wind and temperature at altitude feet,
+// interpolated between the samples either side of it. Samples must be
+// ordered by altitude. Wind is interpolated by its components so that
+// directions either side of north average correctly. ok is false if the
+// altitude is outside of the samples.
+func InterpolateSamples(samples []Sample, altitude int) (sample Sample, ok bool) {
+	for _, s := range samples {
+		if s.Altitude == altitude {
+			return s, true
+		}
+	}
+	u, v, temperature, ok := interpolate(samples, float64(altitude))
+	if !ok {
+		return Sample{}, false
+	}
+	s := Sample{
+		Altitude:    altitude,
+		AltitudeMSL: altitude + samples[0].AltitudeMSL - samples[0].Altitude,
+		Speed:       int(math.Round(math.Hypot(u, v))),
+		Temperature: int(math.Round(temperature)),
+	}
+	if s.Speed > 0 {
+		s.Heading = int(math.Round(direction(u, v))) % 360
+	}
+	s.LightAndVariable = (s.Speed <= 0)
+	return s, true
+}
+
+// Interpolate returns the wind and temperature at altitude feet AGL,
+// interpolated between the current samples.
+func (c *Controller) Interpolate(altitude int) (Sample, bool) {
+	return InterpolateSamples(c.Samples(), altitude)
+}
+
+// AtHandler serves the wind and temperature at the altitude in feet AGL
+// given by the alt query parameter as JSON, e.g. /winds/at?alt=9500, for
+// altitudes that aren't in the fixed samples.
+func (c *Controller) AtHandler(w http.ResponseWriter, req *http.Request) {
+	altitude, err := strconv.Atoi(req.URL.Query().Get("alt"))
+	if err != nil {
+		http.Error(w, "Altitude must be given in feet, e.g. ?alt=9500", http.StatusBadRequest)
+		return
+	}
+	sample, ok := c.Interpolate(altitude)
+	if !ok {
+		http.Error(w, "Winds aloft are not available at that altitude", http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(sample)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", "no-cache")
+	_, _ = w.Write(data)
+}
+
+// averageStep is the altitude in feet over which the wind is taken as
+// constant when averaging it through a layer.
+const averageStep = 100
+
+// AverageWind returns the vector average of the wind from bottom to top feet
+// AGL, weighted by altitude, as the direction in degrees true it blows from
+// and its speed in knots. Samples must be ordered by altitude. ok is false if
+// the layer isn't within the samples.
+func AverageWind(samples []Sample, bottom, top int) (heading, speed float64, ok bool) {
+	if len(samples) == 0 || top <= bottom ||
+		bottom < samples[0].Altitude || top > samples[len(samples)-1].Altitude {
+		return 0, 0, false
+	}
+	var u, v, height float64
+	for altitude := bottom; altitude < top; altitude += averageStep {
+		h := averageStep
+		if top-altitude < averageStep {
+			h = top - altitude
+		}
+		su, sv, _, _ := interpolate(samples, float64(altitude)+float64(h)/2.0)
+		u += su * float64(h)
+		v += sv * float64(h)
+		height += float64(h)
+	}
+	u, v = u/height, v/height
+	speed = math.Hypot(u, v)
+	if speed > 0 {
+		heading = direction(u, v)
+	}
+	return heading, speed, true
+}
+
+// CanopyWind returns the average wind from the configured opening altitude to
+// the ground, which is what jumpers fly their canopies in.
+func (c *Controller) CanopyWind() (heading, speed float64, ok bool) {
+	return AverageWind(c.Samples(), 0, c.settings.SpotOpeningAltitude())
+}
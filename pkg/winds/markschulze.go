@@ -0,0 +1,99 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/decode"
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// Winds Aloft data requires a referral code from Mark Schulze. Please contact
+// him and configure the referrer code in your config.yaml
+const windsAloftURL = "https://markschulze.net/winds/winds.php"
+
+// markSchulzeProvider requests forecasts from markschulze.net, which serves
+// them already in 1000 ft samples.
+type markSchulzeProvider struct{}
+
+func (markSchulzeProvider) NewRequest(s *settings.Settings, endpoint string, hour int) (*http.Request, error) {
+	if endpoint == "" {
+		endpoint = windsAloftURL
+	}
+	url := fmt.Sprintf("%s?hourOffset=%d&lat=%s&lon=%s&referrer=%s", endpoint,
+		hour, s.WindsLatitude(), s.WindsLongitude(), s.WindsReferrer())
+	request, err := s.NewHTTPRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Referer", "https://markschulze.net/winds/")
+	return request, nil
+}
+
+func (markSchulzeProvider) MultiHour() bool {
+	return false
+}
+
+func (markSchulzeProvider) Parse(data []byte, now time.Time) ([]Sample, time.Time, error) {
+	// It would be nicer to parse the data into structs, but it's actually
+	// easier to just work it out manually because JSON sucks.
+
+	var rawWindsAloftData interface{}
+	if err := json.Unmarshal(data, &rawWindsAloftData); err != nil {
+		return nil, time.Time{}, err
+	}
+	windsAloftData, ok := rawWindsAloftData.(map[string]interface{})
+	if !ok {
+		return nil, time.Time{}, errors.New("winds aloft data is invalid")
+	}
+
+	validHour := int(decode.Int("validtime", windsAloftData["validtime"]))
+	validTime := time.Date(now.Year(), now.Month(), now.Day(),
+		validHour, 0, 0, 0, time.UTC)
+	if validHour < now.Hour() {
+		validTime = validTime.Add(24 * time.Hour)
+	}
+
+	// Parse out the data that we want. We care about "direction", "speed",
+	// and "temp".
+	var (
+		direction map[string]interface{}
+		speed     map[string]interface{}
+		temp      map[string]interface{}
+	)
+	if direction, ok = windsAloftData["direction"].(map[string]interface{}); !ok {
+		return nil, time.Time{}, errors.New("direction information missing from winds aloft data")
+	}
+	if speed, ok = windsAloftData["speed"].(map[string]interface{}); !ok {
+		return nil, time.Time{}, errors.New("speed data missing from winds aloft data")
+	}
+	if temp, ok = windsAloftData["temp"].(map[string]interface{}); !ok {
+		return nil, time.Time{}, errors.New("temperature data missing from winds aloft data")
+	}
+
+	maxAltitude := len(direction)
+	if len(speed) < maxAltitude {
+		maxAltitude = len(speed)
+	}
+	if len(temp) < maxAltitude {
+		maxAltitude = len(speed)
+	}
+
+	samples := make([]Sample, maxAltitude)
+	for i := 0; i < maxAltitude; i++ {
+		key := strconv.FormatInt(int64(i*1000), 10)
+		samples[i].Altitude = i * 1000
+		samples[i].Heading = int(decode.Int(key, direction[key]))
+		samples[i].Speed = int(decode.Int(key, speed[key]))
+		samples[i].Temperature = int(decode.Int(key, temp[key]))
+		samples[i].LightAndVariable = (samples[i].Speed <= 0)
+	}
+
+	return samples, validTime, nil
+}
@@ -2,10 +2,40 @@
 
 package winds
 
+import "math"
+
+// Sample is the wind and temperature at an altitude. Altitude is in feet
+// AGL, and AltitudeMSL the same altitude in feet MSL.
 type Sample struct {
 	Altitude         int  `json:"altitude"`
+	AltitudeMSL      int  `json:"altitude_msl"`
 	Heading          int  `json:"heading"`
 	Speed            int  `json:"speed"`
 	Temperature      int  `json:"temperature"`
 	LightAndVariable bool `json:"is_variable,omitempty"`
 }
+
+// TemperatureFahrenheit returns the sample's temperature in Fahrenheit.
+func (s Sample) TemperatureFahrenheit() int {
+	return int(math.Round(float64(s.Temperature)*9.0/5.0 + 32.0))
+}
+
+// FeelsLike returns the wind chill in Celsius at the sample's altitude
+// from its temperature and wind speed. Wind chill is only defined for
+// temperatures at or below 50℉ and winds above 3 mph; outside of that
+// range the actual temperature is returned.
+func (s Sample) FeelsLike() int {
+	t := float64(s.Temperature)*9.0/5.0 + 32.0
+	mph := float64(s.Speed) * 1.151
+	if s.LightAndVariable || t > 50.0 || mph <= 3.0 {
+		return s.Temperature
+	}
+	v := math.Pow(mph, 0.16)
+	f := 35.74 + 0.6215*t - 35.75*v + 0.4275*t*v
+	return int(math.Round((f - 32.0) * 5.0 / 9.0))
+}
+
+// FeelsLikeFahrenheit returns the wind chill in Fahrenheit.
+func (s Sample) FeelsLikeFahrenheit() int {
+	return int(math.Round(float64(s.FeelsLike())*9.0/5.0 + 32.0))
+}
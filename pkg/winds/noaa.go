@@ -0,0 +1,138 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+const noaaSoundingsURL = "https://rucsoundings.noaa.gov/get_soundings.cgi"
+
+// noaaMissing is the value of a missing field in a GSD format sounding.
+const noaaMissing = 99999
+
+// noaaProvider requests forecast soundings for a model from NOAA's Global
+// Systems Laboratory, e.g. the Rapid Refresh (RAP) or its 40 km grid (Op40).
+type noaaProvider struct {
+	source string
+}
+
+// NewRequest requests the soundings for each hour from now through hour.
+func (p noaaProvider) NewRequest(s *settings.Settings, endpoint string, hour int) (*http.Request, error) {
+	if endpoint == "" {
+		endpoint = noaaSoundingsURL
+	}
+	url := fmt.Sprintf("%s?data_source=%s&latest=latest&start_year=&n_hrs=%d.0"+
+		"&fcst_len=shortest&airport=%s,%s&text=Ascii%%20text%%20%%28GSD%%20format%%29",
+		endpoint, p.source, hour+1, s.WindsLatitude(), s.WindsLongitude())
+	return s.NewHTTPRequest(http.MethodGet, url, nil)
+}
+
+func (noaaProvider) MultiHour() bool {
+	return true
+}
+
+// noaaSounding is a sounding for a single hour.
+type noaaSounding struct {
+	validTime time.Time
+	elevation float64
+	surface   bool
+	levels    []level
+}
+
+// Parse reads soundings in GSD format and uses the latest one valid at or
+// before at. Each sounding begins with a header naming the model and the
+// valid time. Each line after that begins with its type: type 3 has the wind
+// speed units, and types 4 through 9 a level with its pressure, height in
+// meters MSL, temperature and dewpoint in tenths of a degree Celsius, and
+// wind direction and speed. Type 9 is the surface.
+func (p noaaProvider) Parse(data []byte, at time.Time) ([]Sample, time.Time, error) {
+	var (
+		soundings []*noaaSounding
+		sounding  *noaaSounding
+	)
+	knots := 1.0
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		lineType, err := strconv.Atoi(fields[0])
+		if err != nil {
+			// The header is e.g. "Op40 23 16 Oct 2026"; the line before it
+			// also begins with the model, but not with a time.
+			if !strings.EqualFold(fields[0], p.source) || len(fields) != 5 {
+				continue
+			}
+			t, err := time.Parse("15 2 Jan 2006", strings.Join(fields[1:], " "))
+			if err != nil {
+				continue
+			}
+			sounding = &noaaSounding{validTime: t}
+			soundings = append(soundings, sounding)
+			continue
+		}
+		if sounding == nil {
+			continue
+		}
+		switch {
+		case lineType == 3:
+			if strings.EqualFold(fields[len(fields)-1], "ms") {
+				knots = 1.943844
+			}
+		case lineType >= 4 && lineType <= 9 && len(fields) >= 7:
+			var values [6]int
+			for i := range values {
+				if values[i], err = strconv.Atoi(fields[i+1]); err != nil {
+					return nil, time.Time{}, fmt.Errorf("invalid sounding level: %w", err)
+				}
+			}
+			height, temperature, direction, speed := values[1], values[2], values[4], values[5]
+			if height == noaaMissing || temperature == noaaMissing ||
+				direction == noaaMissing || speed == noaaMissing {
+				continue
+			}
+			l := level{
+				height:      float64(height),
+				temperature: float64(temperature) / 10.0,
+				direction:   float64(direction),
+				speed:       float64(speed) * knots,
+			}
+			if lineType == 9 {
+				sounding.elevation, sounding.surface = l.height, true
+			}
+			sounding.levels = append(sounding.levels, l)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(soundings) == 0 {
+		return nil, time.Time{}, errors.New("no soundings found")
+	}
+
+	sounding = soundings[0]
+	for _, s := range soundings[1:] {
+		if !s.validTime.After(at) {
+			sounding = s
+		}
+	}
+	if !sounding.surface {
+		return nil, time.Time{}, errors.New("sounding is missing its surface level")
+	}
+	samples := samplesFromLevels(sounding.levels, sounding.elevation)
+	if len(samples) == 0 {
+		return nil, time.Time{}, errors.New("sounding has no levels above the ground")
+	}
+	return samples, sounding.validTime, nil
+}
@@ -0,0 +1,149 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+const openMeteoURL = "https://api.open-meteo.com/v1/forecast"
+
+// openMeteoLevels are the pressure levels in hPa requested from Open-Meteo,
+// from the surface to about 18,000 ft.
+var openMeteoLevels = []int{1000, 975, 950, 925, 900, 850, 800, 700, 600, 500}
+
+// openMeteoProvider requests forecasts from Open-Meteo, which combines the
+// national weather models. It reports winds at pressure levels, which are
+// interpolated to 1000 ft samples by their geopotential heights.
+type openMeteoProvider struct{}
+
+// NewRequest ignores hour because every forecast has each of the hours.
+func (openMeteoProvider) NewRequest(s *settings.Settings, endpoint string, hour int) (*http.Request, error) {
+	if endpoint == "" {
+		endpoint = openMeteoURL
+	}
+	variables := []string{"temperature_2m", "wind_speed_10m", "wind_direction_10m"}
+	for _, l := range openMeteoLevels {
+		for _, v := range []string{"temperature", "wind_speed", "wind_direction", "geopotential_height"} {
+			variables = append(variables, fmt.Sprintf("%s_%dhPa", v, l))
+		}
+	}
+	url := fmt.Sprintf("%s?latitude=%s&longitude=%s&hourly=%s"+
+		"&wind_speed_unit=kn&timeformat=unixtime&forecast_days=2", endpoint,
+		s.WindsLatitude(), s.WindsLongitude(), strings.Join(variables, ","))
+	return s.NewHTTPRequest(http.MethodGet, url, nil)
+}
+
+func (openMeteoProvider) MultiHour() bool {
+	return true
+}
+
+type openMeteoForecast struct {
+	Elevation float64                    `json:"elevation"` // meters MSL
+	Hourly    map[string]json.RawMessage `json:"hourly"`
+}
+
+// series returns the hourly values of the named variable. Missing values are
+// nil.
+func (f *openMeteoForecast) series(name string) ([]*float64, error) {
+	raw, ok := f.Hourly[name]
+	if !ok {
+		return nil, fmt.Errorf("%s missing from Open-Meteo forecast", name)
+	}
+	var values []*float64
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return values, nil
+}
+
+// level returns the level at hour index i from the named variables, or false
+// if any of them are missing.
+func (f *openMeteoForecast) level(i int, height *float64, temperature, speed, direction string) (level, bool, error) {
+	var values [3]*float64
+	for j, name := range []string{temperature, speed, direction} {
+		series, err := f.series(name)
+		if err != nil {
+			return level{}, false, err
+		}
+		if i >= len(series) || series[i] == nil {
+			return level{}, false, nil
+		}
+		values[j] = series[i]
+	}
+	if height == nil {
+		return level{}, false, nil
+	}
+	return level{
+		height:      *height,
+		temperature: *values[0],
+		speed:       *values[1],
+		direction:   *values[2],
+	}, true, nil
+}
+
+func (openMeteoProvider) Parse(data []byte, at time.Time) ([]Sample, time.Time, error) {
+	var f openMeteoForecast
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, time.Time{}, err
+	}
+	var times []int64
+	if err := json.Unmarshal(f.Hourly["time"], &times); err != nil {
+		return nil, time.Time{}, errors.New("times missing from Open-Meteo forecast")
+	}
+
+	// Use the latest forecast hour that has begun by at.
+	index := -1
+	for i, t := range times {
+		if t <= at.Unix() {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, time.Time{}, errors.New("Open-Meteo forecast doesn't cover the hour")
+	}
+
+	elevation := f.Elevation
+	var levels []level
+	surface, ok, err := f.level(index, &elevation,
+		"temperature_2m", "wind_speed_10m", "wind_direction_10m")
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if ok {
+		levels = append(levels, surface)
+	}
+	for _, l := range openMeteoLevels {
+		heights, err := f.series(fmt.Sprintf("geopotential_height_%dhPa", l))
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		var height *float64
+		if index < len(heights) {
+			height = heights[index]
+		}
+		pl, ok, err := f.level(index, height,
+			fmt.Sprintf("temperature_%dhPa", l),
+			fmt.Sprintf("wind_speed_%dhPa", l),
+			fmt.Sprintf("wind_direction_%dhPa", l))
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		if ok {
+			levels = append(levels, pl)
+		}
+	}
+
+	samples := samplesFromLevels(levels, elevation)
+	if len(samples) == 0 {
+		return nil, time.Time{}, errors.New("Open-Meteo forecast has no levels above the ground")
+	}
+	return samples, time.Unix(times[index], 0).UTC(), nil
+}
@@ -0,0 +1,120 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/settings"
+)
+
+// A Provider is a source of winds aloft forecasts. Each source has its own
+// format and resolution; providers normalize them to a sample every 1000 ft
+// above the ground.
+type Provider interface {
+	// NewRequest returns the request for the forecast hour hours from now
+	// at the configured location from endpoint, or from the provider's own
+	// endpoint if endpoint is empty.
+	NewRequest(s *settings.Settings, endpoint string, hour int) (*http.Request, error)
+
+	// MultiHour returns true if the forecast requested for an hour also
+	// covers each of the hours before it, so that it can be retrieved once
+	// and parsed for each of them.
+	MultiHour() bool
+
+	// Parse returns the samples in a forecast for the time at, lowest
+	// first, and the time at which they become valid.
+	Parse(data []byte, at time.Time) ([]Sample, time.Time, error)
+}
+
+// providers are the available providers by the name used in settings.
+var providers = map[string]Provider{
+	"markschulze": markSchulzeProvider{},
+	"open-meteo":  openMeteoProvider{},
+	"rap":         noaaProvider{source: "RAP"},
+	"op40":        noaaProvider{source: "Op40"},
+}
+
+// DefaultProvider is the name of the provider used when none is configured.
+const DefaultProvider = "markschulze"
+
+// ProviderByName returns the named provider. ok is false if there is no such
+// provider.
+func ProviderByName(name string) (p Provider, ok bool) {
+	p, ok = providers[strings.ToLower(name)]
+	return p, ok
+}
+
+// level is the wind and temperature reported by a forecast at a height in
+// meters above mean sea level.
+type level struct {
+	height      float64 // meters MSL
+	temperature float64 // degrees Celsius
+	direction   float64 // degrees true the wind is blowing from
+	speed       float64 // knots
+}
+
+// components returns the eastward and northward components of the wind.
+func (l level) components() (u, v float64) {
+	r := l.direction * math.Pi / 180.0
+	return -l.speed * math.Sin(r), -l.speed * math.Cos(r)
+}
+
+// samplesFromLevels interpolates forecast levels to a sample every 1000 ft
+// above ground at elevation meters MSL, from the ground up to the highest
+// level. Levels below the ground are ignored. Wind is interpolated by its
+// components so that directions either side of north average correctly.
+func samplesFromLevels(levels []level, elevation float64) []Sample {
+	var above []level
+	for _, l := range levels {
+		if l.height >= elevation {
+			above = append(above, l)
+		}
+	}
+	sort.Slice(above, func(i, j int) bool {
+		return above[i].height < above[j].height
+	})
+	if len(above) < 2 {
+		return nil
+	}
+
+	var samples []Sample
+	i := 0
+	for altitude := 0; ; altitude += 1000 {
+		height := elevation + float64(altitude)*0.3048
+		if height < above[0].height {
+			continue
+		}
+		for i < len(above)-2 && above[i+1].height < height {
+			i++
+		}
+		lower, upper := above[i], above[i+1]
+		if height > upper.height {
+			break
+		}
+
+		f := 0.0
+		if upper.height > lower.height {
+			f = (height - lower.height) / (upper.height - lower.height)
+		}
+		lu, lv := lower.components()
+		uu, uv := upper.components()
+		u, v := lu+f*(uu-lu), lv+f*(uv-lv)
+
+		s := Sample{
+			Altitude:    altitude,
+			Speed:       int(math.Round(math.Hypot(u, v))),
+			Temperature: int(math.Round(lower.temperature + f*(upper.temperature-lower.temperature))),
+		}
+		if s.Speed > 0 {
+			s.Heading = int(math.Round(math.Mod(math.Atan2(-u, -v)*180.0/math.Pi+360.0, 360.0))) % 360
+		}
+		s.LightAndVariable = (s.Speed <= 0)
+		samples = append(samples, s)
+	}
+	return samples
+}
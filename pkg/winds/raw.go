@@ -0,0 +1,77 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// rawRateWindow is the period over which requests for raw data are counted
+// against a client's rate limit.
+const rawRateWindow = time.Minute
+
+type rawRateCount struct {
+	start time.Time
+	count int
+}
+
+// allowRawRequest returns true if the client at addr has not exceeded its
+// rate limit. The caller must hold the controller lock.
+func (c *Controller) allowRawRequest(addr string, now time.Time) bool {
+	limit := c.settings.WindsRawRateLimit()
+	if limit <= 0 {
+		return true
+	}
+	if c.rawRequests == nil {
+		c.rawRequests = make(map[string]*rawRateCount)
+	}
+	for a, r := range c.rawRequests {
+		if now.Sub(r.start) >= rawRateWindow {
+			delete(c.rawRequests, a)
+		}
+	}
+
+	r, ok := c.rawRequests[addr]
+	if !ok {
+		r = &rawRateCount{start: now}
+		c.rawRequests[addr] = r
+	}
+	r.count++
+	return r.count <= limit
+}
+
+// RawHandler serves the winds aloft data exactly as it was last received
+// from the upstream provider, so that staff tools on the DZ network can use
+// it without each of them hitting the provider. Requests are answered from
+// the cache filled by the regular refresh and never go upstream.
+func (c *Controller) RawHandler(w http.ResponseWriter, req *http.Request) {
+	addr, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		addr = req.RemoteAddr
+	}
+	now := c.now()
+
+	c.lock.Lock()
+	allowed := c.allowRawRequest(addr, now)
+	data, fetched := c.raw, c.rawTime
+	c.lock.Unlock()
+
+	if !allowed {
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", int(rawRateWindow.Seconds())))
+		http.Error(w, "Too many requests", http.StatusTooManyRequests)
+		return
+	}
+	if data == nil {
+		http.Error(w, "Winds aloft data is not available", http.StatusServiceUnavailable)
+		return
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "application/json; charset=utf-8")
+	h.Set("Cache-Control", "public, max-age=60")
+	http.ServeContent(w, req, "", fetched, bytes.NewReader(data))
+}
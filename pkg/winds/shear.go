@@ -0,0 +1,78 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+// minShearSpeed is the lowest wind speed in knots for which direction is
+// meaningful. Direction changes between lighter winds are ignored.
+const minShearSpeed = 5
+
+// Shear describes the change in wind between a sample and the sample
+// immediately below it.
+type Shear struct {
+	Altitude  int  // altitude of the upper sample
+	Direction int  // change in direction in degrees, 0-180
+	Speed     int  // change in speed in knots, always positive
+	Exceeds   bool // true if either change exceeds the configured threshold
+}
+
+func directionDelta(a, b int) int {
+	d := (a - b) % 360
+	if d < 0 {
+		d += 360
+	}
+	if d > 180 {
+		d = 360 - d
+	}
+	return d
+}
+
+// ComputeShear returns the shear between each pair of adjacent samples.
+// The result has one entry per sample; the first sample has no shear.
+func ComputeShear(samples []Sample, maxDirection, maxSpeed int) []Shear {
+	shear := make([]Shear, len(samples))
+	for i := range samples {
+		shear[i].Altitude = samples[i].Altitude
+		if i == 0 {
+			continue
+		}
+		upper, lower := samples[i], samples[i-1]
+
+		speed := upper.Speed - lower.Speed
+		if upper.LightAndVariable && lower.LightAndVariable {
+			speed = 0
+		}
+		if speed < 0 {
+			speed = -speed
+		}
+		shear[i].Speed = speed
+
+		if !upper.LightAndVariable && !lower.LightAndVariable &&
+			upper.Speed >= minShearSpeed && lower.Speed >= minShearSpeed {
+			shear[i].Direction = directionDelta(upper.Heading, lower.Heading)
+		}
+
+		shear[i].Exceeds = (maxDirection > 0 && shear[i].Direction > maxDirection) ||
+			(maxSpeed > 0 && shear[i].Speed > maxSpeed)
+	}
+	return shear
+}
+
+// Shear returns the shear between each of the current samples using the
+// configured thresholds.
+func (c *Controller) Shear() []Shear {
+	return ComputeShear(c.Samples(),
+		c.settings.WindsShearDirectionThreshold(),
+		c.settings.WindsShearSpeedThreshold())
+}
+
+// ShearWarning returns true if shear between any adjacent samples exceeds
+// the configured thresholds. The lowest altitude at which the thresholds
+// are exceeded is also returned.
+func (c *Controller) ShearWarning() (bool, int) {
+	for _, s := range c.Shear() {
+		if s.Exceeds {
+			return true, s.Altitude
+		}
+	}
+	return false, 0
+}
@@ -0,0 +1,33 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import "sort"
+
+// Simulate replaces the samples loaded from the data source with simulated
+// samples until ClearSimulation is called. The samples are ordered by
+// altitude like the samples from a data source.
+func (c *Controller) Simulate(samples []Sample) {
+	simulated := c.withElevation(append([]Sample{}, samples...))
+	sort.SliceStable(simulated, func(i, j int) bool {
+		return simulated[i].Altitude < simulated[j].Altitude
+	})
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.simulated = simulated
+}
+
+// ClearSimulation restores the use of samples loaded from the data source.
+func (c *Controller) ClearSimulation() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.simulated = nil
+}
+
+// IsSimulated returns true if simulated samples are in effect.
+func (c *Controller) IsSimulated() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.simulated != nil
+}
@@ -0,0 +1,70 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/jumptown-skydiving/manifest-server/pkg/statefile"
+)
+
+// state is the last good samples and the history as saved to disk, so that
+// winds aloft and their trends can be shown after a restart until the data
+// source can be reached.
+type state struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	ValidTime time.Time `json:"valid_time"`
+	Samples   []Sample  `json:"samples"`
+	History   []refresh `json:"history"`
+}
+
+// save writes s to the state file, if one is configured.
+func (c *Controller) save(s state) error {
+	filename := c.settings.WindsStateFile()
+	if filename == "" {
+		return nil
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return statefile.Write(filename, data, 0600)
+}
+
+// restore loads the last good samples and the history from the state file,
+// if one is configured. The samples are degraded until a refresh succeeds.
+func (c *Controller) restore() error {
+	filename := c.settings.WindsStateFile()
+	if filename == "" {
+		return nil
+	}
+	data, err := statefile.Read(filename)
+	if err != nil {
+		return err
+	}
+	var s state
+	if err = json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if s.Samples != nil {
+		c.samples = c.withElevation(s.Samples)
+		c.rawTime = s.FetchedAt
+		c.validTime = s.ValidTime
+		c.degraded = true
+	}
+	c.history = c.trimHistory(s.History, c.now())
+	return nil
+}
+
+// Degraded returns true if the samples are the last good ones because the
+// data source couldn't be reached, either since the latest refresh failed or
+// since the samples were restored after a restart.
+func (c *Controller) Degraded() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.degraded
+}
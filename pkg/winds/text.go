@@ -0,0 +1,88 @@
+// (c) Copyright 2026 Matt Messier
+
+package winds
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// textField is a column of the plaintext winds aloft format.
+type textField struct {
+	name  string
+	value func(Sample) string
+}
+
+// textFields are the columns of the plaintext winds aloft format, in order.
+// The legacy format is positional and only ever has the first four columns,
+// so new columns must only be added to the end and are only written in the
+// versioned format, which names each column in a header row.
+var textFields = []textField{
+	{"altitude", func(s Sample) string { return strconv.Itoa(s.Altitude) }},
+	{"heading", func(s Sample) string { return strconv.Itoa(s.Heading) }},
+	{"speed", func(s Sample) string { return strconv.Itoa(s.Speed) }},
+	{"temperature", func(s Sample) string { return strconv.Itoa(s.Temperature) }},
+	{"light_and_variable", func(s Sample) string { return strconv.FormatBool(s.LightAndVariable) }},
+	{"altitude_msl", func(s Sample) string { return strconv.Itoa(s.AltitudeMSL) }},
+}
+
+// legacyTextFields is the number of columns in the legacy format.
+const legacyTextFields = 4
+
+// TextFormatVersion is the current version of the plaintext format. Version
+// 1 is the legacy positional format.
+const TextFormatVersion = 2
+
+// FormatText formats samples as plaintext, one sample per line with the
+// columns separated by spaces. Version 1 is the legacy positional format;
+// later versions begin with a header row naming each column.
+func FormatText(samples []Sample, version int) string {
+	fields := textFields
+	if version <= 1 {
+		fields = fields[:legacyTextFields]
+	}
+
+	var b strings.Builder
+	if version > 1 {
+		for i, f := range fields {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(f.name)
+		}
+		b.WriteByte('\n')
+	}
+	for _, s := range samples {
+		for i, f := range fields {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(f.value(s))
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// TextHandler serves the winds aloft samples as plaintext. Existing displays
+// get the legacy positional format; the versioned format with a header row is
+// served when requested with the version query parameter. The version served
+// is reported in the X-Winds-Format-Version header.
+func (c *Controller) TextHandler(w http.ResponseWriter, req *http.Request) {
+	version := 1
+	if v := req.URL.Query().Get("version"); v != "" {
+		var err error
+		version, err = strconv.Atoi(v)
+		if err != nil || version < 1 || version > TextFormatVersion {
+			http.Error(w, "Unsupported winds format version", http.StatusBadRequest)
+			return
+		}
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/plain; charset=utf-8")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("X-Winds-Format-Version", strconv.Itoa(version))
+	_, _ = w.Write([]byte(FormatText(c.Samples(), version)))
+}